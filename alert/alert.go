@@ -22,6 +22,7 @@ type Alert struct {
 	Summary   string    `json:"summary"`
 	Details   string    `json:"details"`
 	Source    Source    `json:"source"`
+	Severity  Severity  `json:"severity"`
 	ServiceID string    `json:"service_id"`
 	CreatedAt time.Time `json:"created_at"`
 	Dedup     *DedupID  `json:"dedup"`
@@ -45,7 +46,7 @@ func (a *Alert) DedupKey() *DedupID {
 }
 
 func (a *Alert) scanFrom(scanFn func(...interface{}) error) error {
-	return scanFn(&a.ID, &a.Summary, &a.Details, &a.ServiceID, &a.Source, &a.Status, &a.CreatedAt, &a.Dedup)
+	return scanFn(&a.ID, &a.Summary, &a.Details, &a.ServiceID, &a.Source, &a.Status, &a.CreatedAt, &a.Dedup, &a.Severity)
 }
 
 func (a Alert) Normalize() (*Alert, error) {
@@ -55,14 +56,18 @@ func (a Alert) Normalize() (*Alert, error) {
 	if string(a.Status) == "" {
 		a.Status = StatusTriggered
 	}
+	if string(a.Severity) == "" {
+		a.Severity = DefaultSeverity
+	}
 	a.Summary = strings.ReplaceAll(a.Summary, "\n", " ")
 	a.Summary = strings.ReplaceAll(a.Summary, "  ", " ")
 
 	err := validate.Many(
 		validate.Text("Summary", a.Summary, 1, MaxSummaryLength),
 		validate.Text("Details", a.Details, 0, MaxDetailsLength),
-		validate.OneOf("Source", a.Source, SourceManual, SourceGrafana, SourceSite24x7, SourcePrometheusAlertmanager, SourceEmail, SourceGeneric, SourceUniversal),
+		validate.OneOf("Source", a.Source, SourceManual, SourceGrafana, SourceSite24x7, SourcePrometheusAlertmanager, SourceEmail, SourceGeneric, SourceUniversal, SourcePagerDuty),
 		validate.OneOf("Status", a.Status, StatusTriggered, StatusActive, StatusClosed),
+		validate.OneOf("Severity", a.Severity, SeverityCritical, SeverityHigh, SeverityLow, SeverityInfo),
 		validate.UUID("ServiceID", a.ServiceID),
 	)
 	if err != nil {
@@ -24,9 +24,11 @@ func TestAlert_Normalize(t *testing.T) {
 
 	valid := []Alert{
 		{Summary: "Sample First Alert", Source: SourceManual, Status: StatusTriggered, ServiceID: "e93facc0-4764-012d-7bfb-002500d5d1a6"},
+		{Summary: "Sample First Alert", Severity: SeverityLow, ServiceID: "e93facc0-4764-012d-7bfb-002500d5d1a6"},
 	}
 	invalid := []Alert{
 		{ServiceID: "e93facc0-4764-012d-7bfb"},
+		{Summary: "Sample First Alert", Severity: "urgent", ServiceID: "e93facc0-4764-012d-7bfb-002500d5d1a6"},
 	}
 	for _, a := range valid {
 		test(true, a)
@@ -35,3 +37,32 @@ func TestAlert_Normalize(t *testing.T) {
 		test(false, a)
 	}
 }
+
+func TestParseSeverity(t *testing.T) {
+	check := func(in string, exp Severity, expOK bool) {
+		t.Helper()
+		sev, ok := ParseSeverity(in)
+		if sev != exp || ok != expOK {
+			t.Errorf("ParseSeverity(%q) = %q, %v; want %q, %v", in, sev, ok, exp, expOK)
+		}
+	}
+
+	check("critical", SeverityCritical, true)
+	check(" Error ", SeverityHigh, true)
+	check("warning", SeverityLow, true)
+	check("info", SeverityInfo, true)
+	check("", "", false)
+	check("urgent", "", false)
+}
+
+func TestSeverity_AtLeast(t *testing.T) {
+	if !SeverityCritical.AtLeast(SeverityHigh) {
+		t.Error("critical should be at least high")
+	}
+	if SeverityLow.AtLeast(SeverityHigh) {
+		t.Error("low should not be at least high")
+	}
+	if !Severity("").AtLeast(DefaultSeverity) {
+		t.Error("unset severity should be treated as the default")
+	}
+}
@@ -34,16 +34,29 @@ type Entry struct {
 }
 
 func (e Entry) Meta(ctx context.Context) interface{} {
+	if len(e.meta) == 0 {
+		// older entries, and most types, have no metadata
+		return nil
+	}
+
 	var dest interface{}
 	switch e.Type() {
 	case TypeEscalated:
 		dest = &EscalationMetaData{}
 	case TypeNotificationSent:
 		dest = &NotificationMetaData{}
+	case TypeNoNotificationSent:
+		dest = &NoNotificationMetaData{}
 	case TypeCreated:
 		dest = &CreatedMetaData{}
 	case TypeClosed:
 		dest = &AutoClose{}
+	case TypeSnoozed:
+		dest = &SnoozeMetaData{}
+	case TypeNote:
+		dest = &NoteMetaData{}
+	case TypePolicyUpdated:
+		dest = &ReassignMetaData{}
 	default:
 		return nil
 	}
@@ -68,6 +81,9 @@ func (e Entry) MessageID(ctx context.Context) string {
 	if m, ok := m.(*NotificationMetaData); ok && m != nil {
 		return m.MessageID
 	}
+	if m, ok := m.(*NoNotificationMetaData); ok && m != nil {
+		return m.MessageID
+	}
 	return ""
 }
 
@@ -148,7 +164,10 @@ func (e Entry) String(ctx context.Context) string {
 	case TypeClosed:
 		msg = "Closed"
 		meta, ok := e.Meta(ctx).(*AutoClose)
-		if ok {
+		switch {
+		case ok && meta.SilenceMinutes > 0:
+			msg = "Closed automatically (no new events from integration key '" + meta.IntegrationKeyName + "' for " + strconv.Itoa(meta.SilenceMinutes) + " minutes)"
+		case ok:
 			msg = "Closed due to inactivity (unacknowledged for  " + strconv.Itoa(meta.AlertAutoCloseDays) + " days)"
 		}
 
@@ -166,10 +185,24 @@ func (e Entry) String(ctx context.Context) string {
 		infinitive = true
 	case TypePolicyUpdated:
 		msg = "Policy updated"
+		meta, ok := e.Meta(ctx).(*ReassignMetaData)
+		if ok && meta.NewServiceID != "" {
+			msg = "Reassigned from service " + meta.OldServiceName + " to " + meta.NewServiceName
+		}
 	case TypeDuplicateSupressed:
 		msg = "Suppressed duplicate: created"
 	case TypeEscalationRequest:
 		msg = "Escalation requested"
+	case TypeSnoozed:
+		msg = "Snoozed"
+		meta, ok := e.Meta(ctx).(*SnoozeMetaData)
+		if ok && meta.SnoozeMinutes > 0 {
+			msg += " for " + strconv.Itoa(meta.SnoozeMinutes) + " minutes"
+		}
+	case TypeSnoozeExpired:
+		msg = "Snooze expired"
+	case TypeNote:
+		msg = "Note added"
 	default:
 		return "Error"
 	}
@@ -177,6 +210,13 @@ func (e Entry) String(ctx context.Context) string {
 	// include subject, if available
 	msg += subjectString(infinitive, e.Subject())
 
+	if meta, ok := e.Meta(ctx).(*NoteMetaData); ok && meta.Note != "" {
+		msg += ": " + meta.Note
+	}
+	if meta, ok := e.Meta(ctx).(*NoNotificationMetaData); ok && meta.Reason != "" {
+		msg += ": " + meta.Reason
+	}
+
 	return msg
 }
 
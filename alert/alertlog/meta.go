@@ -13,10 +13,36 @@ type NotificationMetaData struct {
 	MessageID string
 }
 
+// NoNotificationMetaData records why a notification to a contact method was not sent.
+type NoNotificationMetaData struct {
+	MessageID string
+	Reason    string
+}
+
 type CreatedMetaData struct {
 	EPNoSteps bool
 }
 
 type AutoClose struct {
 	AlertAutoCloseDays int
+
+	// SilenceMinutes is set when the alert was closed because its integration key
+	// did not receive a new event within the configured number of minutes.
+	SilenceMinutes     int
+	IntegrationKeyName string
+}
+
+type SnoozeMetaData struct {
+	SnoozeMinutes int
+}
+
+type NoteMetaData struct {
+	Note string
+}
+
+type ReassignMetaData struct {
+	OldServiceID   string
+	OldServiceName string
+	NewServiceID   string
+	NewServiceName string
 }
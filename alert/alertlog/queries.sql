@@ -72,6 +72,13 @@ SELECT
 FROM
     unnest($1::bigint[]);
 
+-- name: AlertLog_InsertOne :one
+-- Inserts a single alert log, returning its ID.
+INSERT INTO alert_logs(alert_id, event, sub_type, sub_user_id, sub_integration_key_id, sub_hb_monitor_id, sub_channel_id, sub_classifier, meta, message)
+    VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+RETURNING
+    id;
+
 -- name: AlertLog_LookupCMDest :one
 -- Looks up the destination for a contact method
 SELECT
@@ -254,6 +254,37 @@ func (s *Store) queries(tx *sql.Tx) *gadb.Queries {
 	return gadb.New(s.db)
 }
 
+// LogOneTx will log a single entry for the alert, returning the ID of the new entry.
+func (s *Store) LogOneTx(ctx context.Context, tx *sql.Tx, alertID int, _type Type, meta interface{}) (int, error) {
+	err := permission.LimitCheckAny(ctx, permission.All)
+	if err != nil {
+		return 0, err
+	}
+
+	e, err := s.logEntry(ctx, tx, _type, meta)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := s.queries(tx).AlertLog_InsertOne(ctx, gadb.AlertLog_InsertOneParams{
+		AlertID:             sql.NullInt64{Int64: int64(alertID), Valid: true},
+		Event:               gadb.EnumAlertLogEvent(e._type),
+		SubType:             gadb.NullEnumAlertLogSubjectType{Valid: e.subject._type != SubjectTypeNone, EnumAlertLogSubjectType: gadb.EnumAlertLogSubjectType(e.subject._type)},
+		SubUserID:           e.subject.userID,
+		SubIntegrationKeyID: e.subject.integrationKeyID,
+		SubHbMonitorID:      e.subject.heartbeatMonitorID,
+		SubChannelID:        e.subject.channelID,
+		SubClassifier:       e.subject.classifier,
+		Meta:                pqtype.NullRawMessage{Valid: e.meta != nil, RawMessage: json.RawMessage(e.meta)},
+		Message:             e.message,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return int(id), nil
+}
+
 func (s *Store) LogTx(ctx context.Context, tx *sql.Tx, alertID int, _type Type, meta interface{}) error {
 	return s.LogManyTx(ctx, tx, []int{alertID}, _type, meta)
 }
@@ -316,11 +347,17 @@ func (s *Store) logEntry(ctx context.Context, tx *sql.Tx, _type Type, meta inter
 			r.subject.classifier = "Web"
 			r.subject._type = SubjectTypeUser
 			r.subject.userID = permission.UserNullUUID(ctx)
+		case permission.SourceTypeAuthSubject:
+			r.subject._type = SubjectTypeUser
+			r.subject.userID = permission.UserNullUUID(ctx)
+			if strings.HasPrefix(src.ID, "slack:") {
+				r.subject.classifier = "Slack"
+			}
 		case permission.SourceTypeContactMethod:
 			r.subject._type = SubjectTypeUser
 			r.subject.userID = permission.UserNullUUID(ctx)
-			if _type == TypeNoNotificationSent {
-				// no CMID for no notification sent
+			if _type == TypeNoNotificationSent && src.ID == "" {
+				// no CMID when there was no contact method to notify
 				r.subject.classifier = "no immediate rule"
 				break
 			}
@@ -370,7 +407,7 @@ func (s *Store) logEntry(ctx context.Context, tx *sql.Tx, _type Type, meta inter
 
 			r.subject.heartbeatMonitorID.Valid = true
 			r.subject.heartbeatMonitorID.UUID = uuid.MustParse(src.ID)
-		case permission.SourceTypeIntegrationKey:
+		case permission.SourceTypeIntegrationKey, permission.SourceTypeUIK:
 			r.subject._type = SubjectTypeIntegrationKey
 			var ikeyType integrationkey.Type
 			err = txWrap(ctx, tx, s.lookupIKeyType).QueryRowContext(ctx, src.ID).Scan(&ikeyType)
@@ -386,6 +423,10 @@ func (s *Store) logEntry(ctx context.Context, tx *sql.Tx, _type Type, meta inter
 				r.subject.classifier = "Site24x7"
 			case integrationkey.TypeEmail:
 				r.subject.classifier = "Email"
+			case integrationkey.TypePagerDuty:
+				r.subject.classifier = "PagerDuty Events API"
+			case integrationkey.TypeUniversal:
+				r.subject.classifier = "Universal"
 			}
 			r.subject.integrationKeyID.Valid = true
 			r.subject.integrationKeyID.UUID = uuid.MustParse(src.ID)
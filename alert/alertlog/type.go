@@ -16,6 +16,9 @@ const (
 	TypePolicyUpdated      Type = "policy_updated"
 	TypeDuplicateSupressed Type = "duplicate_suppressed"
 	TypeEscalationRequest  Type = "escalation_request"
+	TypeSnoozed            Type = "snoozed"
+	TypeSnoozeExpired      Type = "snooze_expired"
+	TypeNote               Type = "note"
 
 	// not exported, status_changed will be turned into an acknowledged where appropriate
 	_TypeStatusChanged Type = "status_changed"
@@ -0,0 +1,31 @@
+package alert
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/permission"
+)
+
+// touchAutoCloseTx records that an event was received for the alert, if the request came from
+// an integration key with auto-close enabled. It is a no-op for any other source.
+func (s *Store) touchAutoCloseTx(ctx context.Context, tx *sql.Tx, alertID int) error {
+	src := permission.Source(ctx)
+	if src == nil {
+		return nil
+	}
+	if src.Type != permission.SourceTypeIntegrationKey && src.Type != permission.SourceTypeUIK {
+		return nil
+	}
+	keyID, err := uuid.Parse(src.ID)
+	if err != nil {
+		return nil
+	}
+
+	return gadb.New(tx).Alert_TouchAutoClose(ctx, gadb.Alert_TouchAutoCloseParams{
+		AlertID:          int64(alertID),
+		IntegrationKeyID: keyID,
+	})
+}
@@ -0,0 +1,151 @@
+package alert
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/target/goalert/alert/alertlog"
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/util/sqlutil"
+	"github.com/target/goalert/validation/validate"
+)
+
+const (
+	// DefaultGroupWindow is used when a Grouping does not specify a window.
+	DefaultGroupWindow = 10 * time.Minute
+
+	// MaxGroupWindow is the longest window an alert group may be held open for.
+	MaxGroupWindow = 24 * time.Hour
+
+	// MetaGroupCount is the metadata key holding the number of events folded into a grouped alert.
+	MetaGroupCount = "group_count"
+
+	// MetaGroupLastSeen is the metadata key holding the last time (RFC3339) an event was folded into a grouped alert.
+	MetaGroupLastSeen = "group_last_seen"
+)
+
+// Grouping describes how incoming alerts from an integration key are folded
+// into an existing open alert.
+type Grouping struct {
+	IntegrationKeyID uuid.UUID
+
+	// Key is the evaluated grouping key; alerts with the same key are grouped.
+	Key string
+
+	// Window is how long after the last event a group stays open for new events.
+	Window time.Duration
+}
+
+// Normalize will validate and return a normalized Grouping.
+func (g Grouping) Normalize() (*Grouping, error) {
+	if g.Window == 0 {
+		g.Window = DefaultGroupWindow
+	}
+
+	err := validate.Many(
+		validate.RequiredText("GroupKey", g.Key, 1, 255),
+		validate.Duration("GroupWindow", g.Window, time.Second, MaxGroupWindow),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &g, nil
+}
+
+// CreateOrGroup will fold a triggered alert into an open alert with the same grouping key,
+// if one was last seen within the grouping window. Otherwise a new alert is created
+// (or de-duplicated as with CreateOrUpdate) and becomes the head of the group.
+//
+// The group count and last-seen time are recorded in the alert metadata.
+func (s *Store) CreateOrGroup(ctx context.Context, a *Alert, g Grouping) (alertID int, isNew bool, err error) {
+	err = permission.LimitCheckAny(ctx,
+		permission.System,
+		permission.Admin,
+		permission.User,
+		permission.MatchService(a.ServiceID),
+	)
+	if err != nil {
+		return 0, false, err
+	}
+
+	n, err := a.Normalize()
+	if err != nil {
+		return 0, false, err
+	}
+	if n.Status != StatusTriggered {
+		return 0, false, errors.New("only triggered alerts can be grouped")
+	}
+	grp, err := g.Normalize()
+	if err != nil {
+		return 0, false, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, false, err
+	}
+	defer sqlutil.Rollback(ctx, "alert: create or group", tx)
+
+	// lock the service first so concurrent events for the same group can't both create a new alert
+	q := gadb.New(tx)
+	err = q.Alert_LockService(ctx, uuid.MustParse(n.ServiceID))
+	if err != nil {
+		return 0, false, err
+	}
+
+	id, err := q.Alert_FindGroupAlert(ctx, gadb.Alert_FindGroupAlertParams{
+		IntegrationKeyID: grp.IntegrationKeyID,
+		GroupKey:         grp.Key,
+		GroupWindow:      sqlutil.IntervalMicro(grp.Window),
+	})
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		created, inserted, err := s.CreateOrUpdateTx(ctx, tx, n)
+		if err != nil {
+			return 0, false, err
+		}
+		alertID, isNew = created.ID, inserted
+	case err != nil:
+		return 0, false, err
+	default:
+		alertID = int(id)
+		s.logDB.MustLogTx(ctx, tx, alertID, alertlog.TypeDuplicateSupressed, nil)
+		err = s.touchAutoCloseTx(ctx, tx, alertID)
+		if err != nil {
+			return 0, false, err
+		}
+	}
+
+	row, err := q.Alert_RecordGroupAlert(ctx, gadb.Alert_RecordGroupAlertParams{
+		AlertID:          int64(alertID),
+		IntegrationKeyID: grp.IntegrationKeyID,
+		GroupKey:         grp.Key,
+	})
+	if err != nil {
+		return 0, false, err
+	}
+
+	meta, err := s.metadata(ctx, tx, alertID)
+	if err != nil {
+		return 0, false, err
+	}
+	meta[MetaGroupCount] = strconv.Itoa(int(row.Count))
+	meta[MetaGroupLastSeen] = row.LastSeen.UTC().Format(time.RFC3339)
+	err = s.SetMetadataTx(ctx, tx, alertID, meta)
+	if err != nil {
+		return 0, false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, false, err
+	}
+
+	return alertID, isNew, nil
+}
@@ -0,0 +1,31 @@
+package alert
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGrouping_Normalize(t *testing.T) {
+	keyID := uuid.New()
+
+	g, err := Grouping{IntegrationKeyID: keyID, Key: "cluster-a"}.Normalize()
+	require.NoError(t, err)
+	assert.Equal(t, DefaultGroupWindow, g.Window, "default window")
+
+	g, err = Grouping{IntegrationKeyID: keyID, Key: "cluster-a", Window: time.Hour}.Normalize()
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour, g.Window)
+
+	_, err = Grouping{IntegrationKeyID: keyID}.Normalize()
+	assert.Error(t, err, "empty key")
+
+	_, err = Grouping{IntegrationKeyID: keyID, Key: "cluster-a", Window: time.Millisecond}.Normalize()
+	assert.Error(t, err, "window too short")
+
+	_, err = Grouping{IntegrationKeyID: keyID, Key: "cluster-a", Window: 25 * time.Hour}.Normalize()
+	assert.Error(t, err, "window too long")
+}
@@ -27,6 +27,11 @@ func (s *Store) Metadata(ctx context.Context, db gadb.DBTX, alertID int) (meta m
 		return nil, err
 	}
 
+	return s.metadata(ctx, db, alertID)
+}
+
+// metadata returns the metadata for a single alert without permission checks.
+func (s *Store) metadata(ctx context.Context, db gadb.DBTX, alertID int) (map[string]string, error) {
 	md, err := gadb.New(db).Alert_GetAlertMetadata(ctx, int64(alertID))
 	if errors.Is(err, sql.ErrNoRows) || !md.Valid {
 		return map[string]string{}, nil
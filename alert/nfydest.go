@@ -10,10 +10,15 @@ import (
 const (
 	DestTypeAlert = "builtin-alert"
 
-	ParamSummary = "summary"
-	ParamDetails = "details"
-	ParamDedup   = "dedup"
-	ParamClose   = "close"
+	ParamSummary  = "summary"
+	ParamDetails  = "details"
+	ParamDedup    = "dedup"
+	ParamClose    = "close"
+	ParamAck      = "ack"
+	ParamSeverity = "severity"
+
+	ParamGroupKey    = "group_key"
+	ParamGroupWindow = "group_window"
 
 	FallbackIconURL = "builtin://alert"
 )
@@ -43,6 +48,22 @@ func (s *Store) TypeInfo(ctx context.Context) (*nfydest.TypeInfo, error) {
 			ParamID: ParamClose,
 			Label:   "Close",
 			Hint:    "If true, close an existing alert.",
+		}, {
+			ParamID: ParamAck,
+			Label:   "Acknowledge",
+			Hint:    "If true, acknowledge an existing alert.",
+		}, {
+			ParamID: ParamSeverity,
+			Label:   "Severity",
+			Hint:    "Severity of the alert (critical, high, low, or info). Defaults to high.",
+		}, {
+			ParamID: ParamGroupKey,
+			Label:   "Group Key",
+			Hint:    "If set, new alerts with the same key are folded into an open alert seen within the group window.",
+		}, {
+			ParamID: ParamGroupWindow,
+			Label:   "Group Window",
+			Hint:    "How long (e.g. 10m, 1h) a group stays open after its last alert. Defaults to 10m.",
 		}},
 	}, nil
 }
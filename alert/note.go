@@ -0,0 +1,65 @@
+package alert
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/target/goalert/alert/alertlog"
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/util/sqlutil"
+	"github.com/target/goalert/validation"
+	"github.com/target/goalert/validation/validate"
+)
+
+// MaxNoteLength is the maximum number of characters allowed in an alert note.
+const MaxNoteLength = 1000
+
+// AddNote will add a user-authored note to the alert's log.
+//
+// If notifySubscribers is true, the note is also sent as a status update to all
+// destinations subscribed to the alert, except contact methods belonging to the author.
+func (s *Store) AddNote(ctx context.Context, alertID int, note string, notifySubscribers bool) error {
+	err := permission.LimitCheckAny(ctx, permission.User)
+	if err != nil {
+		return err
+	}
+
+	err = validate.RequiredText("Note", note, 1, MaxNoteLength)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer sqlutil.Rollback(ctx, "alert: add note", tx)
+
+	var serviceID sql.NullString
+	err = tx.StmtContext(ctx, s.getServiceID).QueryRowContext(ctx, alertID).Scan(&serviceID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return validation.NewFieldError("AlertID", "not found")
+	}
+	if err != nil {
+		return err
+	}
+
+	logID, err := s.logDB.LogOneTx(ctx, tx, alertID, alertlog.TypeNote, alertlog.NoteMetaData{Note: note})
+	if err != nil {
+		return err
+	}
+
+	if notifySubscribers {
+		_, err = gadb.New(tx).Alert_NotifyNoteSubscribers(ctx, gadb.Alert_NotifyNoteSubscribersParams{
+			AlertID: int64(alertID),
+			LogID:   int64(logID),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
@@ -158,3 +158,181 @@ FROM
 WHERE
     a.id = @id::bigint;
 
+
+-- name: Alert_SnoozeMany :many
+-- Acknowledges the specified open alerts and schedules them to return to triggered after the snooze duration.
+WITH open_alerts AS (
+    SELECT
+        id,
+        status
+    FROM
+        alerts
+    WHERE
+        id = ANY (@alert_ids::bigint[])
+        AND status != 'closed'
+    FOR UPDATE
+),
+_ack AS (
+    UPDATE
+        alerts a
+    SET
+        status = 'active'
+    FROM
+        open_alerts
+    WHERE
+        a.id = open_alerts.id
+        AND open_alerts.status = 'triggered'
+),
+_snooze AS (
+    INSERT INTO alert_snoozes(alert_id, snoozed_until)
+    SELECT
+        id,
+        now() + make_interval(mins => @snooze_minutes::int)
+    FROM
+        open_alerts
+    ON CONFLICT (alert_id)
+        DO UPDATE SET
+            snoozed_until = excluded.snoozed_until,
+            created_at = now())
+SELECT
+    id
+FROM
+    open_alerts;
+
+-- name: Alert_NotifyNoteSubscribers :execrows
+-- Sends a status update for a note on an alert to all of its subscribers, except the note's author.
+INSERT INTO outgoing_messages(message_type, contact_method_id, channel_id, user_id, alert_id, alert_log_id)
+SELECT
+    'alert_status_update',
+    sub.contact_method_id,
+    sub.channel_id,
+    cm.user_id,
+    sub.alert_id,
+    log.id
+FROM
+    alert_status_subscriptions sub
+    JOIN alerts a ON a.id = sub.alert_id
+        AND a.status != 'closed'
+    JOIN alert_logs log ON log.id = @log_id::bigint
+        AND log.alert_id = sub.alert_id
+        AND log.event = 'note'
+    LEFT JOIN user_contact_methods cm ON cm.id = sub.contact_method_id
+WHERE
+    sub.alert_id = @alert_id::bigint
+    AND (sub.channel_id IS NOT NULL
+        OR (NOT cm.disabled
+            AND cm.user_id IS DISTINCT FROM log.sub_user_id));
+
+-- name: Alert_LockForReassign :one
+-- Locks the alert and its current service, returning the info needed to reassign it.
+SELECT
+    a.status,
+    svc.id AS service_id,
+    svc.name AS service_name
+FROM
+    alerts a
+    JOIN services svc ON svc.id = a.service_id
+WHERE
+    a.id = @id::bigint
+FOR UPDATE;
+
+-- name: Alert_LockServiceName :one
+-- Locks the service and returns its name.
+SELECT
+    name
+FROM
+    services
+WHERE
+    id = @service_id
+FOR UPDATE;
+
+-- name: Alert_Reassign :exec
+-- Moves an open alert to a new service, returning it to triggered.
+UPDATE
+    alerts
+SET
+    service_id = @service_id,
+    status = 'triggered'
+WHERE
+    id = @id::bigint
+    AND status != 'closed';
+
+-- name: Alert_DeleteEPState :exec
+-- Removes the escalation policy state of the alert.
+DELETE FROM escalation_policy_state
+WHERE alert_id = @alert_id::bigint;
+
+-- name: Alert_DeleteNotificationCycles :exec
+-- Removes the notification policy cycles of the alert, so users notified by the previous escalation policy stop being paged.
+DELETE FROM notification_policy_cycles
+WHERE alert_id = @alert_id::int;
+
+-- name: Alert_InsertEPState :exec
+-- Creates a fresh escalation policy state for the alert's current service, if its policy has steps.
+INSERT INTO escalation_policy_state(alert_id, service_id, escalation_policy_id)
+SELECT
+    a.id,
+    a.service_id,
+    svc.escalation_policy_id
+FROM
+    alerts a
+    JOIN services svc ON svc.id = a.service_id
+    JOIN escalation_policies ep ON ep.id = svc.escalation_policy_id
+        AND ep.step_count > 0
+WHERE
+    a.id = @alert_id::bigint;
+
+-- name: Alert_ClearSnooze :exec
+-- Removes any pending snooze for the alert.
+DELETE FROM alert_snoozes
+WHERE alert_id = @alert_id::bigint;
+
+-- name: Alert_ClearManySnoozes :exec
+-- Removes any pending snoozes for the given alerts.
+DELETE FROM alert_snoozes
+WHERE alert_id = ANY (@alert_ids::bigint[]);
+
+-- name: Alert_FindGroupAlert :one
+-- Returns the open alert for the group, if it was last seen within the window.
+SELECT
+    g.alert_id
+FROM
+    alert_groups g
+    JOIN alerts a ON a.id = g.alert_id
+        AND a.status != 'closed'
+WHERE
+    g.integration_key_id = @integration_key_id
+    AND g.group_key = @group_key
+    AND g.last_seen > now() - @group_window::interval
+ORDER BY
+    g.alert_id DESC
+LIMIT 1
+FOR UPDATE
+    OF g;
+
+-- name: Alert_RecordGroupAlert :one
+-- Records an alert as part of a group, incrementing the count if it is already part of it.
+--
+-- The group key is updated as well, so an alert matched by a changed grouping rule joins the new group.
+INSERT INTO alert_groups(alert_id, integration_key_id, group_key)
+    VALUES (@alert_id, @integration_key_id, @group_key)
+ON CONFLICT (alert_id)
+    DO UPDATE SET
+        count = alert_groups.count + 1, last_seen = now(), integration_key_id = excluded.integration_key_id, group_key = excluded.group_key
+    RETURNING
+        count, last_seen;
+
+-- name: Alert_TouchAutoClose :exec
+-- Records the last time an event was received for an alert from an integration key with auto-close enabled.
+INSERT INTO alert_auto_close(alert_id, integration_key_id)
+SELECT
+    @alert_id,
+    id
+FROM
+    integration_keys
+WHERE
+    id = @integration_key_id
+    AND auto_close_minutes IS NOT NULL
+ON CONFLICT (alert_id)
+    DO UPDATE SET
+        integration_key_id = excluded.integration_key_id, last_seen = now();
@@ -0,0 +1,104 @@
+package alert
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/target/goalert/alert/alertlog"
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/util/errutil"
+	"github.com/target/goalert/util/sqlutil"
+	"github.com/target/goalert/validation"
+	"github.com/target/goalert/validation/validate"
+)
+
+// Reassign will move an open alert to a different service.
+//
+// The alert is returned to triggered and its escalation restarts from the first step
+// of the new service's escalation policy, notifying whoever is on-call there. Users
+// notified by the previous policy are no longer paged. Alert history, metadata, and
+// subscriptions are preserved.
+func (s *Store) Reassign(ctx context.Context, alertID int, serviceID string) error {
+	err := permission.LimitCheckAny(ctx, permission.System, permission.User)
+	if err != nil {
+		return err
+	}
+
+	err = validate.UUID("ServiceID", serviceID)
+	if err != nil {
+		return err
+	}
+	newSvcID := uuid.MustParse(serviceID)
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer sqlutil.Rollback(ctx, "alert: reassign", tx)
+
+	q := gadb.New(tx)
+	cur, err := q.Alert_LockForReassign(ctx, int64(alertID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return validation.NewFieldError("AlertID", "not found")
+	}
+	if err != nil {
+		return err
+	}
+	if cur.Status == gadb.EnumAlertStatusClosed {
+		return logError{isAlreadyClosed: true, alertID: alertID, _type: alertlog.TypeClosed, logDB: s.logDB}
+	}
+	if cur.ServiceID == newSvcID {
+		return validation.NewFieldError("ServiceID", "alert is already assigned to this service")
+	}
+
+	newName, err := q.Alert_LockServiceName(ctx, newSvcID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return validation.NewFieldError("ServiceID", "service does not exist")
+	}
+	if err != nil {
+		return err
+	}
+
+	err = q.Alert_DeleteEPState(ctx, int64(alertID))
+	if err != nil {
+		return err
+	}
+
+	err = q.Alert_DeleteNotificationCycles(ctx, int32(alertID))
+	if err != nil {
+		return err
+	}
+
+	err = q.Alert_Reassign(ctx, gadb.Alert_ReassignParams{
+		ID:        int64(alertID),
+		ServiceID: uuid.NullUUID{UUID: newSvcID, Valid: true},
+	})
+	if err != nil {
+		return errutil.MapDBError(err)
+	}
+
+	err = q.Alert_InsertEPState(ctx, int64(alertID))
+	if err != nil {
+		return err
+	}
+
+	err = q.Alert_ClearSnooze(ctx, int64(alertID))
+	if err != nil {
+		return err
+	}
+
+	err = s.logDB.LogTx(ctx, tx, alertID, alertlog.TypePolicyUpdated, alertlog.ReassignMetaData{
+		OldServiceID:   cur.ServiceID.String(),
+		OldServiceName: cur.ServiceName,
+		NewServiceID:   serviceID,
+		NewServiceName: newName,
+	})
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,117 @@
+package alert
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/target/goalert/validation"
+)
+
+// Severity indicates the urgency of an Alert.
+type Severity string
+
+// Alert severity levels, from most to least urgent.
+const (
+	SeverityCritical Severity = "critical"
+	SeverityHigh     Severity = "high"
+	SeverityLow      Severity = "low"
+	SeverityInfo     Severity = "info"
+)
+
+// DefaultSeverity is used for alerts that do not specify a severity.
+const DefaultSeverity = SeverityHigh
+
+// Severities lists all valid severity levels, from most to least urgent.
+var Severities = []Severity{SeverityCritical, SeverityHigh, SeverityLow, SeverityInfo}
+
+// ParseSeverity will parse a severity string from an external source.
+//
+// In addition to the GoAlert severity names, common aliases used by monitoring
+// tools (e.g., "error", "warning") are accepted. It returns an empty Severity and
+// false if the value is not recognized.
+func ParseSeverity(s string) (Severity, bool) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "critical", "crit", "fatal", "emergency", "p1":
+		return SeverityCritical, true
+	case "high", "error", "err", "major", "p2":
+		return SeverityHigh, true
+	case "low", "warning", "warn", "minor", "p3", "p4":
+		return SeverityLow, true
+	case "info", "informational", "information", "debug", "p5":
+		return SeverityInfo, true
+	}
+
+	return "", false
+}
+
+// Rank returns the relative urgency of the severity, where lower values are more urgent.
+func (s Severity) Rank() int {
+	if s == "" {
+		s = DefaultSeverity
+	}
+	for i, sev := range Severities {
+		if sev == s {
+			return i
+		}
+	}
+
+	return len(Severities)
+}
+
+// AtLeast returns true if s is as urgent or more urgent than min.
+func (s Severity) AtLeast(min Severity) bool { return s.Rank() <= min.Rank() }
+
+func (s Severity) Value() (driver.Value, error) {
+	str := string(s)
+	if str == "" {
+		str = string(DefaultSeverity)
+	}
+	return str, nil
+}
+
+func (s *Severity) Scan(value interface{}) error {
+	switch t := value.(type) {
+	case []byte:
+		*s = Severity(t)
+	case string:
+		*s = Severity(t)
+	case nil:
+		*s = DefaultSeverity
+	default:
+		return fmt.Errorf("could not process unknown type for Severity(%T)", t)
+	}
+	return nil
+}
+
+// gqlName returns the GraphQL enum value for the severity (e.g., `SeverityCritical`).
+func (s Severity) gqlName() string {
+	if s == "" {
+		s = DefaultSeverity
+	}
+	return "Severity" + strings.ToUpper(string(s[:1])) + string(s[1:])
+}
+
+// UnmarshalGQL implements the graphql.Unmarshaler interface.
+func (s *Severity) UnmarshalGQL(v interface{}) error {
+	str, err := graphql.UnmarshalString(v)
+	if err != nil {
+		return err
+	}
+
+	for _, sev := range Severities {
+		if sev.gqlName() == str {
+			*s = sev
+			return nil
+		}
+	}
+
+	return validation.NewFieldError("Severity", "unknown severity "+str)
+}
+
+// MarshalGQL implements the graphql.Marshaler interface.
+func (s Severity) MarshalGQL(w io.Writer) {
+	graphql.MarshalString(s.gqlName()).MarshalGQL(w)
+}
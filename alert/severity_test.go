@@ -0,0 +1,25 @@
+package alert
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeverity_GQL(t *testing.T) {
+	var buf bytes.Buffer
+	SeverityCritical.MarshalGQL(&buf)
+	assert.Equal(t, `"SeverityCritical"`, buf.String())
+
+	buf.Reset()
+	Severity("").MarshalGQL(&buf)
+	assert.Equal(t, `"SeverityHigh"`, buf.String(), "empty should marshal as the default")
+
+	var s Severity
+	require.NoError(t, s.UnmarshalGQL("SeverityInfo"))
+	assert.Equal(t, SeverityInfo, s)
+
+	assert.Error(t, s.UnmarshalGQL("info"), "DB values are not valid enum values")
+}
@@ -0,0 +1,95 @@
+package alert
+
+import (
+	"context"
+
+	"github.com/target/goalert/alert/alertlog"
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/util/sqlutil"
+	"github.com/target/goalert/validation/validate"
+)
+
+// DefaultSnoozeMinutes is used when a snooze duration is not configured.
+const DefaultSnoozeMinutes = 60
+
+// MaxSnoozeMinutes is the longest an alert may be snoozed for (1 week).
+const MaxSnoozeMinutes = 7 * 24 * 60
+
+// Snooze will snooze a single alert for the provided number of minutes. An error is returned if the alert is already closed.
+func (s *Store) Snooze(ctx context.Context, alertID int, minutes int) error {
+	ids, err := s.SnoozeMany(ctx, []int{alertID}, minutes)
+	if err != nil {
+		return err
+	}
+	if len(ids) == 0 {
+		return logError{isAlreadyClosed: true, alertID: alertID, _type: alertlog.TypeClosed, logDB: s.logDB}
+	}
+
+	return nil
+}
+
+// SnoozeMany will acknowledge the given alerts and return them to triggered, restarting escalation, after the
+// provided number of minutes. Snoozing an alert that is already snoozed will replace the existing snooze.
+//
+// The IDs of all open alerts that were snoozed are returned.
+func (s *Store) SnoozeMany(ctx context.Context, alertIDs []int, minutes int) ([]int, error) {
+	err := permission.LimitCheckAny(ctx, permission.System, permission.User)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(alertIDs) == 0 {
+		return nil, nil
+	}
+
+	err = validate.Many(
+		validate.Range("AlertIDs", len(alertIDs), 1, maxBatch),
+		validate.Range("SnoozeMinutes", minutes, 1, MaxSnoozeMinutes),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, len(alertIDs))
+	for i, id := range alertIDs {
+		ids[i] = int64(id)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer sqlutil.Rollback(ctx, "alert: snooze", tx)
+
+	q := gadb.New(tx)
+	err = q.Alert_LockManyAlertServices(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := q.Alert_SnoozeMany(ctx, gadb.Alert_SnoozeManyParams{
+		AlertIds:      ids,
+		SnoozeMinutes: int32(minutes),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	updatedIDs := make([]int, len(res))
+	for i, id := range res {
+		updatedIDs[i] = int(id)
+	}
+
+	err = s.logDB.LogManyTx(ctx, tx, updatedIDs, alertlog.TypeSnoozed, alertlog.SnoozeMetaData{SnoozeMinutes: minutes})
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return updatedIDs, nil
+}
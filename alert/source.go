@@ -17,6 +17,7 @@ const (
 	SourceManual                 Source = "manual"                 // manually triggered
 	SourceGeneric                Source = "generic"                // generic API
 	SourceUniversal              Source = "universal"              // universal integration
+	SourcePagerDuty              Source = "pagerduty"              // PagerDuty Events API v2 compatible
 )
 
 func (s Source) Value() (driver.Value, error) {
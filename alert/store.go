@@ -58,7 +58,7 @@ func NewStore(ctx context.Context, db *sql.DB, logDB *alertlog.Store) (*Store, e
 		logDB: logDB,
 
 		insert: p(`
-			INSERT INTO alerts (summary, details, service_id, source, status, dedup_key) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id, created_at
+			INSERT INTO alerts (summary, details, service_id, source, status, dedup_key, severity) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id, created_at
 		`),
 		update: p("UPDATE alerts SET status = $2 WHERE id = $1"),
 		logs:   p("SELECT timestamp, event, message FROM alert_logs WHERE alert_id = $1"),
@@ -72,13 +72,14 @@ func NewStore(ctx context.Context, db *sql.DB, logDB *alertlog.Store) (*Store, e
 				a.source,
 				a.status,
 				created_at,
-				a.dedup_key
+				a.dedup_key,
+				a.severity
 			FROM alerts a
 			WHERE a.id = ANY ($1)
 		`),
 		createUpdNew: p(`
 			WITH existing as (
-				SELECT id, summary, details, status, source, created_at, false
+				SELECT id, summary, details, status, source, severity, created_at, false
 				FROM alerts
 				WHERE service_id = $3 AND dedup_key = $5
 			), to_insert as (
@@ -88,11 +89,11 @@ func NewStore(ctx context.Context, db *sql.DB, logDB *alertlog.Store) (*Store, e
 				FROM existing
 			), inserted as (
 				INSERT INTO alerts (
-					summary, details, service_id, source, dedup_key
+					summary, details, service_id, source, dedup_key, severity
 				)
-				SELECT $1, $2, $3, $4, $5
+				SELECT $1, $2, $3, $4, $5, $6
 				FROM to_insert
-				RETURNING id, summary, details, status, source, created_at, true
+				RETURNING id, summary, details, status, source, severity, created_at, true
 			)
 			SELECT * FROM existing
 			UNION
@@ -287,6 +288,12 @@ func (s *Store) EscalateAsOf(ctx context.Context, id int, t time.Time) error {
 		return validation.NewGenericError("alert has already escalated")
 	}
 
+	// a pending snooze would otherwise re-trigger the alert after it is handled
+	err = gadb.New(tx).Alert_ClearSnooze(ctx, int64(id))
+	if err != nil {
+		return fmt.Errorf("clear snooze: %w", err)
+	}
+
 	err = s.logDB.LogTx(ctx, tx, id, alertlog.TypeEscalationRequest, nil)
 	if err != nil {
 		return fmt.Errorf("log escalation request: %w", err)
@@ -373,13 +380,31 @@ func (s *Store) UpdateStatusByService(ctx context.Context, serviceID string, sta
 		return err
 	}
 
-	_, err = tx.StmtContext(ctx, s.updateByStatusAndService).ExecContext(ctx, serviceID, status)
-	if errors.Is(err, sql.ErrNoRows) {
-		err = nil
-	}
+	rows, err := tx.StmtContext(ctx, s.updateByStatusAndService).QueryContext(ctx, serviceID, status)
 	if err != nil {
 		return err
 	}
+	defer rows.Close()
+
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		err = rows.Scan(&id)
+		if err != nil {
+			return err
+		}
+		ids = append(ids, id)
+	}
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	if status == StatusActive && len(ids) > 0 {
+		err = gadb.New(tx).Alert_ClearManySnoozes(ctx, ids)
+		if err != nil {
+			return err
+		}
+	}
 
 	err = tx.Commit()
 	if err != nil {
@@ -399,6 +424,36 @@ func (s *Store) UpdateManyAlertStatus(ctx context.Context, status Status, alertI
 		return nil, nil
 	}
 
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer sqlutil.Rollback(ctx, "alert: update status", tx)
+
+	updatedIDs, err := s.UpdateManyAlertStatusTx(ctx, tx, status, alertIDs, logMeta)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return updatedIDs, nil
+}
+
+// UpdateManyAlertStatusTx works like UpdateManyAlertStatus but uses the provided transaction.
+func (s *Store) UpdateManyAlertStatusTx(ctx context.Context, tx *sql.Tx, status Status, alertIDs []int, logMeta interface{}) ([]int, error) {
+	err := permission.LimitCheckAny(ctx, permission.System, permission.User)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(alertIDs) == 0 {
+		return nil, nil
+	}
+
 	err = validate.Many(
 		validate.Range("AlertIDs", len(alertIDs), 1, maxBatch),
 		validate.OneOf("Status", status, StatusActive, StatusClosed),
@@ -412,12 +467,6 @@ func (s *Store) UpdateManyAlertStatus(ctx context.Context, status Status, alertI
 		ids[i] = int64(id)
 	}
 
-	tx, err := s.db.BeginTx(ctx, nil)
-	if err != nil {
-		return nil, err
-	}
-	defer sqlutil.Rollback(ctx, "alert: update status", tx)
-
 	t := alertlog.TypeAcknowledged
 	if status == StatusClosed {
 		t = alertlog.TypeClosed
@@ -444,14 +493,24 @@ func (s *Store) UpdateManyAlertStatus(ctx context.Context, status Status, alertI
 		}
 		updatedIDs = append(updatedIDs, id)
 	}
-
-	// Logging Batch Updates for every alertID whose status was updated
-	err = s.logDB.LogManyTx(ctx, tx, updatedIDs, t, logMeta)
-	if err != nil {
+	if err = rows.Err(); err != nil {
 		return nil, err
 	}
 
-	err = tx.Commit()
+	if status == StatusActive && len(updatedIDs) > 0 {
+		// acknowledged by hand, so a snooze left over from before the alert re-triggered no longer applies
+		clearIDs := make([]int64, len(updatedIDs))
+		for i, id := range updatedIDs {
+			clearIDs[i] = int64(id)
+		}
+		err = gadb.New(tx).Alert_ClearManySnoozes(ctx, clearIDs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Logging Batch Updates for every alertID whose status was updated
+	err = s.logDB.LogManyTx(ctx, tx, updatedIDs, t, logMeta)
 	if err != nil {
 		return nil, err
 	}
@@ -500,7 +559,7 @@ func (s *Store) CreateTx(ctx context.Context, tx *sql.Tx, a *Alert) (*Alert, err
 func (s *Store) _create(ctx context.Context, tx *sql.Tx, a Alert) (*Alert, *alertlog.CreatedMetaData, error) {
 	var meta alertlog.CreatedMetaData
 
-	row := tx.StmtContext(ctx, s.insert).QueryRowContext(ctx, a.Summary, a.Details, a.ServiceID, a.Source, a.Status, a.DedupKey())
+	row := tx.StmtContext(ctx, s.insert).QueryRowContext(ctx, a.Summary, a.Details, a.ServiceID, a.Source, a.Status, a.DedupKey(), a.Severity)
 	err := row.Scan(&a.ID, &a.CreatedAt)
 	if err != nil {
 		return nil, nil, err
@@ -554,8 +613,8 @@ func (s *Store) CreateOrUpdateTx(ctx context.Context, tx *sql.Tx, a *Alert) (*Al
 	case StatusTriggered:
 		var m alertlog.CreatedMetaData
 		err = tx.Stmt(s.createUpdNew).
-			QueryRowContext(ctx, n.Summary, n.Details, n.ServiceID, n.Source, n.DedupKey()).
-			Scan(&n.ID, &n.Summary, &n.Details, &n.Status, &n.Source, &n.CreatedAt, &inserted)
+			QueryRowContext(ctx, n.Summary, n.Details, n.ServiceID, n.Source, n.DedupKey(), n.Severity).
+			Scan(&n.ID, &n.Summary, &n.Details, &n.Status, &n.Source, &n.Severity, &n.CreatedAt, &inserted)
 		if !inserted {
 			logType = alertlog.TypeDuplicateSupressed
 		} else {
@@ -572,8 +631,9 @@ func (s *Store) CreateOrUpdateTx(ctx context.Context, tx *sql.Tx, a *Alert) (*Al
 		err = tx.Stmt(s.createUpdAck).
 			QueryRowContext(ctx, n.ServiceID, n.DedupKey()).
 			Scan(&n.ID, &n.Summary, &n.Details, &oldStatus, &n.CreatedAt)
-		if oldStatus != n.Status {
+		if err == nil && oldStatus != n.Status {
 			logType = alertlog.TypeAcknowledged
+			err = gadb.New(tx).Alert_ClearSnooze(ctx, int64(n.ID))
 		}
 	case StatusClosed:
 		err = tx.Stmt(s.createUpdClose).
@@ -591,6 +651,12 @@ func (s *Store) CreateOrUpdateTx(ctx context.Context, tx *sql.Tx, a *Alert) (*Al
 	if logType != "" {
 		s.logDB.MustLogTx(ctx, tx, n.ID, logType, meta)
 	}
+	if n.Status == StatusTriggered {
+		err = s.touchAutoCloseTx(ctx, tx, n.ID)
+		if err != nil {
+			return nil, false, err
+		}
+	}
 
 	return n, inserted, nil
 }
@@ -674,6 +740,14 @@ func (s *Store) UpdateStatusTx(ctx context.Context, tx *sql.Tx, id int, stat Sta
 		return err
 	}
 
+	if stat != StatusClosed {
+		// snoozing doesn't go through here, so any pending snooze is stale
+		err = gadb.New(tx).Alert_ClearSnooze(ctx, int64(id))
+		if err != nil {
+			return err
+		}
+	}
+
 	if stat == StatusClosed {
 		s.logDB.MustLogTx(ctx, tx, id, alertlog.TypeClosed, nil)
 	} else if stat == StatusActive {
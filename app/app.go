@@ -29,6 +29,7 @@ import (
 	"github.com/target/goalert/escalation"
 	"github.com/target/goalert/graphql2/graphqlapp"
 	"github.com/target/goalert/heartbeat"
+	"github.com/target/goalert/incident"
 	"github.com/target/goalert/integrationkey"
 	"github.com/target/goalert/integrationkey/uik"
 	"github.com/target/goalert/keyring"
@@ -38,7 +39,9 @@ import (
 	"github.com/target/goalert/notification"
 	"github.com/target/goalert/notification/nfydest"
 	"github.com/target/goalert/notification/slack"
+	"github.com/target/goalert/notification/teams"
 	"github.com/target/goalert/notification/twilio"
+	"github.com/target/goalert/notification/webhook"
 	"github.com/target/goalert/notificationchannel"
 	"github.com/target/goalert/oncall"
 	"github.com/target/goalert/override"
@@ -47,12 +50,14 @@ import (
 	"github.com/target/goalert/schedule/rotation"
 	"github.com/target/goalert/schedule/rule"
 	"github.com/target/goalert/service"
+	"github.com/target/goalert/service/msgtemplate"
 	"github.com/target/goalert/smtpsrv"
 	"github.com/target/goalert/timezone"
 	"github.com/target/goalert/user"
 	"github.com/target/goalert/user/contactmethod"
 	"github.com/target/goalert/user/favorite"
 	"github.com/target/goalert/user/notificationrule"
+	"github.com/target/goalert/usergroupsync"
 	"github.com/target/goalert/util/calllimiter"
 	"github.com/target/goalert/util/log"
 	"github.com/target/goalert/util/sqlutil"
@@ -97,12 +102,14 @@ type App struct {
 	twilioConfig *twilio.Config
 
 	slackChan *slack.ChannelSender
+	teams     *teams.Sender
 
 	ConfigStore *config.Store
 
 	AlertStore        *alert.Store
 	AlertLogStore     *alertlog.Store
 	AlertMetricsStore *alertmetrics.Store
+	IncidentStore     *incident.Store
 
 	AuthBasicStore        *basic.Store
 	UserStore             *user.Store
@@ -111,6 +118,7 @@ type App struct {
 	FavoriteStore         *favorite.Store
 
 	ServiceStore        *service.Store
+	MsgTemplateStore    *msgtemplate.Store
 	EscalationStore     *escalation.Store
 	IntegrationKeyStore *integrationkey.Store
 	UIKHandler          *uik.Handler
@@ -119,6 +127,7 @@ type App struct {
 	ScheduleStore       *schedule.Store
 	RotationStore       *rotation.Store
 	DestRegistry        *nfydest.Registry
+	WebhookSecretStore  *webhook.SecretStore
 
 	CalSubStore    *calsub.Store
 	OverrideStore  *override.Store
@@ -136,6 +145,7 @@ type App struct {
 	NCStore       *notificationchannel.Store
 	TimeZoneStore *timezone.Store
 	NoticeStore   *notice.Store
+	UGSyncStore   *usergroupsync.Store
 	AuthLinkStore *authlink.Store
 	APIKeyStore   *apikey.Store
 	River         *river.Client[pgx.Tx]
@@ -30,6 +30,7 @@ func (app *App) initEngine(ctx context.Context) error {
 	app.notificationManager = notification.NewManager(app.DestRegistry)
 	app.Engine, err = engine.NewEngine(ctx, app.db, &engine.Config{
 		AlertStore:          app.AlertStore,
+		MsgTemplateStore:    app.MsgTemplateStore,
 		AlertLogStore:       app.AlertLogStore,
 		ContactMethodStore:  app.ContactMethodStore,
 		NotificationManager: app.notificationManager,
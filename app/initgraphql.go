@@ -17,7 +17,9 @@ func (app *App) initGraphQL(ctx context.Context) error {
 		AlertStore:          app.AlertStore,
 		AlertLogStore:       app.AlertLogStore,
 		AlertMetricsStore:   app.AlertMetricsStore,
+		IncidentStore:       app.IncidentStore,
 		ServiceStore:        app.ServiceStore,
+		MsgTemplateStore:    app.MsgTemplateStore,
 		FavoriteStore:       app.FavoriteStore,
 		PolicyStore:         app.EscalationStore,
 		ScheduleStore:       app.ScheduleStore,
@@ -35,6 +37,7 @@ func (app *App) initGraphQL(ctx context.Context) error {
 		SlackStore:          app.slackChan,
 		HeartbeatStore:      app.HeartbeatStore,
 		NoticeStore:         app.NoticeStore,
+		UGSyncStore:         app.UGSyncStore,
 		Twilio:              app.twilioConfig,
 		AuthHandler:         app.AuthHandler,
 		NotificationManager: app.notificationManager,
@@ -43,6 +46,7 @@ func (app *App) initGraphQL(ctx context.Context) error {
 		APIKeyStore:         app.APIKeyStore,
 		DestReg:             app.DestRegistry,
 		EncryptionKeys:      app.cfg.EncryptionKeys,
+		WebhookSecretStore:  app.WebhookSecretStore,
 	}
 
 	return nil
@@ -15,6 +15,7 @@ import (
 	"github.com/target/goalert/grafana"
 	"github.com/target/goalert/mailgun"
 	"github.com/target/goalert/notification/twilio"
+	"github.com/target/goalert/pagerduty"
 	"github.com/target/goalert/permission"
 	prometheus "github.com/target/goalert/prometheusalertmanager"
 	"github.com/target/goalert/site24x7"
@@ -143,11 +144,14 @@ func (app *App) initHTTP(ctx context.Context) error {
 
 	if expflag.ContextHas(ctx, expflag.UnivKeys) {
 		mux.HandleFunc("POST /api/v2/uik", app.UIKHandler.ServeHTTP)
+		mux.HandleFunc("POST /api/v2/otlp/v1/logs", app.UIKHandler.ServeOTLPLogs)
+		mux.HandleFunc("POST /api/v2/otlp/v1/traces", app.UIKHandler.ServeOTLPTraces)
 	}
 	mux.HandleFunc("POST /api/v2/mailgun/incoming", mailgun.IngressWebhooks(app.AlertStore, app.IntegrationKeyStore))
 	mux.HandleFunc("POST /api/v2/grafana/incoming", grafana.GrafanaToEventsAPI(app.AlertStore, app.IntegrationKeyStore))
 	mux.HandleFunc("POST /api/v2/site24x7/incoming", site24x7.Site24x7ToEventsAPI(app.AlertStore, app.IntegrationKeyStore))
 	mux.HandleFunc("POST /api/v2/prometheusalertmanager/incoming", prometheus.PrometheusAlertmanagerEventsAPI(app.AlertStore, app.IntegrationKeyStore))
+	mux.HandleFunc("POST /api/v2/pagerduty/enqueue", pagerduty.EventsAPIv2(app.AlertStore, app.IntegrationKeyStore))
 
 	mux.HandleFunc("POST /api/v2/generic/incoming", generic.ServeCreateAlert)
 	mux.HandleFunc("POST /api/v2/heartbeat/{heartbeatID}", generic.ServeHeartbeatCheck)
@@ -160,6 +164,8 @@ func (app *App) initHTTP(ctx context.Context) error {
 	mux.HandleFunc("POST /api/v2/twilio/call/status", app.twilioVoice.ServeStatusCallback)
 
 	mux.HandleFunc("POST /api/v2/slack/message-action", app.slackChan.ServeMessageAction)
+	mux.HandleFunc("POST /api/v2/slack/menu-options", app.slackChan.ServeMenuOptions)
+	mux.HandleFunc("POST /api/v2/slack/command", app.slackChan.ServeSlashCommand)
 
 	middleware = append(middleware,
 		httpRewrite(app.cfg.HTTPPrefix, "/v1/graphql2", "/api/graphql"),
@@ -173,6 +179,7 @@ func (app *App) initHTTP(ctx context.Context) error {
 		httpRewrite(app.cfg.HTTPPrefix, "/v1/webhooks/mailgun", "/api/v2/mailgun/incoming"),
 		httpRewrite(app.cfg.HTTPPrefix, "/v1/webhooks/grafana", "/api/v2/grafana/incoming"),
 		httpRewrite(app.cfg.HTTPPrefix, "/v1/api/alerts", "/api/v2/generic/incoming"),
+		httpRewrite(app.cfg.HTTPPrefix, "/v2/enqueue", "/api/v2/pagerduty/enqueue"),
 		httpRewrite(app.cfg.HTTPPrefix, "/v1/api/heartbeat/", "/api/v2/heartbeat/"),
 		httpRewriteWith(app.cfg.HTTPPrefix, "/v1/api/users/", func(req *http.Request) *http.Request {
 			parts := strings.Split(strings.TrimSuffix(req.URL.Path, "/avatar"), "/")
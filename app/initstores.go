@@ -15,6 +15,7 @@ import (
 	"github.com/target/goalert/config"
 	"github.com/target/goalert/escalation"
 	"github.com/target/goalert/heartbeat"
+	"github.com/target/goalert/incident"
 	"github.com/target/goalert/integrationkey"
 	"github.com/target/goalert/integrationkey/uik"
 	"github.com/target/goalert/keyring"
@@ -24,6 +25,8 @@ import (
 	"github.com/target/goalert/notification"
 	"github.com/target/goalert/notification/nfydest"
 	"github.com/target/goalert/notification/slack"
+	"github.com/target/goalert/notification/teams"
+	"github.com/target/goalert/notification/webhook"
 	"github.com/target/goalert/notificationchannel"
 	"github.com/target/goalert/oncall"
 	"github.com/target/goalert/override"
@@ -32,11 +35,13 @@ import (
 	"github.com/target/goalert/schedule/rotation"
 	"github.com/target/goalert/schedule/rule"
 	"github.com/target/goalert/service"
+	"github.com/target/goalert/service/msgtemplate"
 	"github.com/target/goalert/timezone"
 	"github.com/target/goalert/user"
 	"github.com/target/goalert/user/contactmethod"
 	"github.com/target/goalert/user/favorite"
 	"github.com/target/goalert/user/notificationrule"
+	"github.com/target/goalert/usergroupsync"
 
 	"github.com/pkg/errors"
 )
@@ -154,6 +159,13 @@ func (app *App) initStores(ctx context.Context) error {
 		return errors.Wrap(err, "init alert store")
 	}
 
+	if app.IncidentStore == nil {
+		app.IncidentStore, err = incident.NewStore(ctx, app.db, app.AlertStore)
+	}
+	if err != nil {
+		return errors.Wrap(err, "init incident store")
+	}
+
 	if app.ContactMethodStore == nil {
 		app.ContactMethodStore = contactmethod.NewStore(app.DestRegistry)
 	}
@@ -172,6 +184,13 @@ func (app *App) initStores(ctx context.Context) error {
 		return errors.Wrap(err, "init service store")
 	}
 
+	if app.MsgTemplateStore == nil {
+		app.MsgTemplateStore, err = msgtemplate.NewStore(ctx, app.db)
+	}
+	if err != nil {
+		return errors.Wrap(err, "init message template store")
+	}
+
 	if app.AuthBasicStore == nil {
 		app.AuthBasicStore, err = basic.NewStore(ctx, app.db)
 	}
@@ -297,6 +316,13 @@ func (app *App) initStores(ctx context.Context) error {
 		return errors.Wrap(err, "init notice store")
 	}
 
+	if app.UGSyncStore == nil {
+		app.UGSyncStore, err = usergroupsync.NewStore(ctx, app.db)
+	}
+	if err != nil {
+		return errors.Wrap(err, "init user group sync store")
+	}
+
 	if app.APIKeyStore == nil {
 		app.APIKeyStore, err = apikey.NewStore(ctx, app.db, app.APIKeyring)
 	}
@@ -304,7 +330,15 @@ func (app *App) initStores(ctx context.Context) error {
 		return errors.Wrap(err, "init API key store")
 	}
 
-	app.UIKHandler = uik.NewHandler(app.db, app.httpClient, app.IntegrationKeyStore, app.AlertStore)
+	if app.WebhookSecretStore == nil {
+		app.WebhookSecretStore, err = webhook.NewSecretStore(ctx, app.db, app.cfg.EncryptionKeys)
+	}
+	if err != nil {
+		return errors.Wrap(err, "init webhook secret store")
+	}
+
+	app.teams = teams.NewSender(ctx, app.httpClient)
+	app.UIKHandler = uik.NewHandler(app.db, app.IntegrationKeyStore, app.AlertStore)
 
 	return nil
 }
@@ -14,6 +14,11 @@ func (app *App) initTwilio(ctx context.Context) error {
 		CMStore: app.ContactMethodStore,
 		DB:      app.db,
 		Client:  app.httpClient,
+
+		UserStore:    app.UserStore,
+		AlertStore:   app.AlertStore,
+		ServiceStore: app.ServiceStore,
+		OnCallStore:  app.OnCallStore,
 	}
 
 	var err error
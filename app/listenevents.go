@@ -16,4 +16,5 @@ func (app *App) setupListenEvents() {
 		})
 		return nil
 	})
+	app.events.Handle("/goalert/uik-config-change", app.UIKHandler.HandleConfigChange)
 }
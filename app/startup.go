@@ -7,7 +7,9 @@ import (
 
 	"github.com/target/goalert/app/lifecycle"
 	"github.com/target/goalert/expflag"
+	"github.com/target/goalert/notification/discord"
 	"github.com/target/goalert/notification/email"
+	"github.com/target/goalert/notification/mattermost"
 	"github.com/target/goalert/notification/webhook"
 	"github.com/target/goalert/retry"
 
@@ -94,7 +96,10 @@ func (app *App) startup(ctx context.Context) error {
 	app.DestRegistry.RegisterProvider(ctx, app.slackChan)
 	app.DestRegistry.RegisterProvider(ctx, app.slackChan.DMSender())
 	app.DestRegistry.RegisterProvider(ctx, app.slackChan.UserGroupSender())
-	app.DestRegistry.RegisterProvider(ctx, webhook.NewSender(ctx, app.httpClient))
+	app.DestRegistry.RegisterProvider(ctx, app.teams)
+	app.DestRegistry.RegisterProvider(ctx, discord.NewSender(ctx, app.httpClient))
+	app.DestRegistry.RegisterProvider(ctx, mattermost.NewSender(ctx, app.httpClient))
+	app.DestRegistry.RegisterProvider(ctx, webhook.NewSender(ctx, app.httpClient, app.WebhookSecretStore))
 	if app.cfg.StubNotifiers {
 		app.DestRegistry.StubNotifiers()
 	}
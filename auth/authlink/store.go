@@ -35,7 +35,7 @@ type Metadata struct {
 func (m Metadata) Validate() error {
 	return validate.Many(
 		validate.ASCII("UserDetails", m.UserDetails, 1, 255),
-		validate.OneOf("AlertAction", m.AlertAction, "", "ResultAcknowledge", "ResultResolve"),
+		validate.OneOf("AlertAction", m.AlertAction, "", "ResultAcknowledge", "ResultResolve", "ResultSnooze"),
 	)
 }
 
@@ -5,36 +5,25 @@ import (
 	"strings"
 )
 
+// TokenParams are the form/query parameters GetToken will read a token from, in priority order.
+//
+// Only `token` is documented, the rest are supported for compatibility.
+var TokenParams = []string{"token", "integrationKey", "integration_key", "key"}
+
 // GetToken will return the auth token associated with a request.
 //
 // Supported options (in priority order):
 // - `token` (field or query)
 // - Authorization: Bearer header
 func GetToken(req *http.Request) string {
-	tok := req.FormValue("token")
-	if tok != "" {
-		return tok
-	}
-
-	// compat
-	tok = req.FormValue("integrationKey")
-	if tok != "" {
-		return tok
-	}
-
-	// compat
-	tok = req.FormValue("integration_key")
-	if tok != "" {
-		return tok
-	}
-
-	// compat
-	tok = req.FormValue("key")
-	if tok != "" {
-		return tok
+	for _, name := range TokenParams {
+		tok := req.FormValue(name)
+		if tok != "" {
+			return tok
+		}
 	}
 
-	tok = strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")
+	tok := strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")
 	if tok != "" {
 		return tok
 	}
@@ -573,6 +573,15 @@ func (h *Handler) authWithToken(w http.ResponseWriter, req *http.Request, next h
 		next.ServeHTTP(w, req.WithContext(ctx))
 		return true
 	}
+	if strings.HasPrefix(req.URL.Path, "/api/v2/otlp/") && strings.HasPrefix(tokStr, "ey") {
+		ctx, err = h.cfg.IntKeyStore.AuthorizeOTLP(ctx, tokStr)
+		if errutil.HTTPError(req.Context(), w, err) {
+			return true
+		}
+
+		next.ServeHTTP(w, req.WithContext(ctx))
+		return true
+	}
 
 	tok, _, err := authtoken.Parse(tokStr, func(t authtoken.Type, p, sig []byte) (bool, bool) {
 		if t == authtoken.TypeSession {
@@ -666,6 +675,12 @@ func (h *Handler) WrapHandler(wrapped http.Handler) http.Handler {
 			wrapped.ServeHTTP(w, req)
 			return
 		}
+		if req.URL.Path == "/api/v2/pagerduty/enqueue" || req.URL.Path == "/v2/enqueue" {
+			// The PagerDuty Events API authenticates with the routing_key
+			// in the request body, so the handler does its own auth.
+			wrapped.ServeHTTP(w, req)
+			return
+		}
 		if h.authWithToken(w, req, wrapped) {
 			return
 		}
@@ -32,6 +32,7 @@ type Config struct {
 		DisableSMSLinks              bool   `public:"true" info:"If set, SMS messages will not contain a URL pointing to GoAlert."`
 		DisableLabelCreation         bool   `public:"true" info:"Disables the ability to create new labels for services."`
 		DisableCalendarSubscriptions bool   `public:"true" info:"If set, disables all active calendar subscriptions as well as the ability to create new calendar subscriptions."`
+		AlertSnoozeMinutes           int    `public:"true" info:"Number of minutes an alert is snoozed for when using Slack or SMS replies (0 means 60 minutes)."`
 	}
 
 	Services struct {
@@ -99,10 +100,26 @@ type Config struct {
 		AccessToken string `password:"true" info:"Slack app bot user OAuth access token (should start with xoxb-)."`
 
 		SigningSecret       string `password:"true" info:"Signing secret to verify requests from slack."`
-		InteractiveMessages bool   `info:"Enable interactive messages (e.g. buttons)."`
+		InteractiveMessages bool   `info:"Enable interactive messages (e.g. buttons), modals, and the /goalert slash command."`
 		DisableBroadcastThreadReplies bool `info:"Disable broadcasting alert status updates in threads to the main channel." public:"true"`
 	}
 
+	Discord struct {
+		Enable bool `public:"true" info:"Enables sending notifications to Discord channels via webhooks."`
+	}
+
+	Mattermost struct {
+		Enable bool `public:"true" info:"Enables sending notifications to Mattermost channels via incoming webhooks."`
+
+		URL      string `info:"Base URL of the Mattermost server (e.g., https://mattermost.example.com). Required to use the Bot Token."`
+		BotToken string `password:"true" info:"Access token of a Mattermost bot account. If set, messages to channels with a Channel ID are posted by the bot so alert updates can be threaded."`
+	}
+
+	Teams struct {
+		Enable              bool `public:"true" info:"Enables sending notifications to Microsoft Teams channels via incoming webhooks (Workflows)."`
+		InteractiveMessages bool `info:"Add Acknowledge and Close buttons to alert messages. Buttons open the alert in GoAlert, where the action is confirmed by the logged-in user."`
+	}
+
 	Twilio struct {
 		Enable bool `public:"true" info:"Enables sending and processing of Voice and SMS messages through the Twilio notification provider."`
 
@@ -119,6 +136,8 @@ type Config struct {
 
 		RCSSenderID string `info:"The sender ID for RCS messages. Required if RCS is enabled for the MessagingServiceSID."`
 
+		VoiceMinSeverity string `info:"If set, voice calls will only be made for alerts of at least this severity (critical, high, low, or info). Other contact methods are unaffected."`
+
 		DisableTwoWaySMS      bool     `info:"Disables SMS reply codes for alert messages."`
 		SMSCarrierLookup      bool     `info:"Perform carrier lookup of SMS contact methods (required for SMSFromNumberOverride). Extra charges may apply."`
 		SMSFromNumberOverride []string `info:"List of 'carrier=number' pairs, SMS messages to numbers of the provided carrier string (exact match) will use the alternate From Number."`
@@ -477,6 +496,8 @@ func (cfg Config) Validate() error {
 		validateKey("GitHub.ClientID", cfg.GitHub.ClientID),
 		validateKey("GitHub.ClientSecret", cfg.GitHub.ClientSecret),
 		validateKey("Slack.AccessToken", cfg.Slack.AccessToken),
+		validateKey("Mattermost.BotToken", cfg.Mattermost.BotToken),
+		validate.Range("General.AlertSnoozeMinutes", cfg.General.AlertSnoozeMinutes, 0, 10080),
 		validate.Range("Maintenance.AlertCleanupDays", cfg.Maintenance.AlertCleanupDays, 0, 9000),
 		validate.Range("Maintenance.AlertAutoCloseDays", cfg.Maintenance.AlertAutoCloseDays, 0, 9000),
 		validate.Range("Maintenance.APIKeyExpireDays", cfg.Maintenance.APIKeyExpireDays, 0, 9000),
@@ -492,6 +513,10 @@ func (cfg Config) Validate() error {
 		err = validate.Many(err, validate.MeasurementID("General.GoogleAnalyticsID", cfg.General.GoogleAnalyticsID))
 	}
 
+	if cfg.Twilio.VoiceMinSeverity != "" {
+		err = validate.Many(err, validate.OneOf("Twilio.VoiceMinSeverity", cfg.Twilio.VoiceMinSeverity, "critical", "high", "low", "info"))
+	}
+
 	if cfg.Twilio.VoiceName != "" && cfg.Twilio.VoiceLanguage == "" {
 		err = validate.Many(err, validation.NewFieldError("Twilio.VoiceLanguage", "required when Twilio.VoiceName is set"))
 	}
@@ -524,6 +549,13 @@ func (cfg Config) Validate() error {
 	if cfg.SMTP.From != "" {
 		err = validate.Many(err, validate.Email("SMTP.From", cfg.SMTP.From))
 	}
+	if cfg.Mattermost.URL != "" {
+		err = validate.Many(err, validate.AbsoluteURL("Mattermost.URL", cfg.Mattermost.URL))
+	}
+	if cfg.Mattermost.BotToken != "" && cfg.Mattermost.URL == "" {
+		err = validate.Many(err, validation.NewFieldError("Mattermost.URL", "required to use a Mattermost bot token"))
+	}
+
 	if cfg.Slack.InteractiveMessages && cfg.Slack.SigningSecret == "" {
 		err = validate.Many(err, validation.NewFieldError("Slack.SigningSecret", "required to enable Slack interactive messages"))
 	}
@@ -17,6 +17,8 @@ type Hints struct {
 	}
 	Slack struct {
 		InteractivityResponseURL string
+		MenuOptionsURL           string
+		SlashCommandURL          string
 	}
 }
 
@@ -30,6 +32,8 @@ func (cfg Config) Hints() Hints {
 	h.Twilio.MessageWebhookURL = cfg.CallbackURL("/api/v2/twilio/message")
 	h.Twilio.VoiceWebhookURL = cfg.CallbackURL("/api/v2/twilio/call")
 	h.Slack.InteractivityResponseURL = cfg.CallbackURL("/api/v2/slack/message-action")
+	h.Slack.MenuOptionsURL = cfg.CallbackURL("/api/v2/slack/menu-options")
+	h.Slack.SlashCommandURL = cfg.CallbackURL("/api/v2/slack/command")
 
 	return h
 }
@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -42,23 +43,59 @@ type actionBody struct {
 	Actions     []actionItem
 }
 
-func (s *Server) ServeActionResponse(w http.ResponseWriter, r *http.Request) {
-	var req struct {
-		Text string
-		Type string `json:"response_type"`
+// responseMessage is a message returned by an app, either directly or via a response URL.
+type responseMessage struct {
+	Text string
+	Type string `json:"response_type"`
 
-		Blocks []struct {
+	Blocks []struct {
+		Type     string
+		Text     struct{ Text string }
+		Elements []struct {
 			Type     string
 			Text     struct{ Text string }
-			Elements []struct {
-				Type     string
-				Text     struct{ Text string }
-				Value    string
-				ActionID string `json:"action_id"`
-				URL      string
+			Value    string
+			ActionID string `json:"action_id"`
+			URL      string
+		}
+	}
+}
+
+// content returns the text and button actions of the message; base is used for the app, team, and channel of each action.
+func (m responseMessage) content(base Action) (text string, actions []Action) {
+	if len(m.Blocks) == 0 {
+		return m.Text, nil
+	}
+
+	// new API
+	for _, block := range m.Blocks {
+		switch block.Type {
+		case "section":
+			text = block.Text.Text
+		case "actions":
+			for _, action := range block.Elements {
+				if action.Type != "button" {
+					continue
+				}
+
+				actions = append(actions, Action{
+					ChannelID: base.ChannelID,
+					TeamID:    base.TeamID,
+					AppID:     base.AppID,
+					ActionID:  action.ActionID,
+					Text:      action.Text.Text,
+					Value:     action.Value,
+					URL:       action.URL,
+				})
 			}
 		}
 	}
+
+	return text, actions
+}
+
+func (s *Server) ServeActionResponse(w http.ResponseWriter, r *http.Request) {
+	var req responseMessage
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
@@ -80,34 +117,7 @@ func (s *Server) ServeActionResponse(w http.ResponseWriter, r *http.Request) {
 		ChannelID: a.ChannelID,
 		User:      r.URL.Query().Get("user"),
 	}
-
-	if len(req.Blocks) > 0 {
-		// new API
-		for _, block := range req.Blocks {
-			switch block.Type {
-			case "section":
-				opts.Text = block.Text.Text
-			case "actions":
-				for _, action := range block.Elements {
-					if action.Type != "button" {
-						continue
-					}
-
-					opts.Actions = append(opts.Actions, Action{
-						ChannelID: a.ChannelID,
-						TeamID:    a.TeamID,
-						AppID:     a.AppID,
-						ActionID:  action.ActionID,
-						Text:      action.Text.Text,
-						Value:     action.Value,
-						URL:       action.URL,
-					})
-				}
-			}
-		}
-	} else {
-		opts.Text = req.Text
-	}
+	opts.Text, opts.Actions = req.content(a)
 
 	msg, err := s.API().ChatPostMessage(r.Context(), opts)
 	if respondErr(w, err) {
@@ -179,11 +189,21 @@ func (s *Server) PerformActionAs(userID string, a Action) error {
 
 	v := make(url.Values)
 	v.Set("payload", string(data))
-	data = []byte(v.Encode())
+	_, err = s.postSigned(app, app.ActionURL, v)
+	if err != nil {
+		return fmt.Errorf("perform action: %w", err)
+	}
+
+	return nil
+}
+
+// postSigned will send the form to the app at urlStr, signed with the app's signing secret, and return the response body.
+func (s *Server) postSigned(app *appState, urlStr string, v url.Values) ([]byte, error) {
+	data := []byte(v.Encode())
 
-	req, err := http.NewRequest("POST", app.ActionURL, bytes.NewReader(data))
+	req, err := http.NewRequest("POST", urlStr, bytes.NewReader(data))
 	if err != nil {
-		return fmt.Errorf("create action request: %w", err)
+		return nil, fmt.Errorf("create request: %w", err)
 	}
 
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
@@ -195,13 +215,18 @@ func (s *Server) PerformActionAs(userID string, a Action) error {
 
 	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("perform action: %w", err)
+		return nil, err
 	}
 	defer resp.Body.Close()
 
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response: %w", err)
+	}
+
 	if resp.StatusCode != 200 {
-		return fmt.Errorf("perform action: %s", resp.Status)
+		return nil, fmt.Errorf("%s: %s", resp.Status, strings.TrimSpace(string(body)))
 	}
 
-	return nil
+	return body, nil
 }
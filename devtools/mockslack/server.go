@@ -43,7 +43,9 @@ func NewServer() *Server {
 	srv.mux.HandleFunc("/api/groups.create", srv.ServeGroupsCreate)
 	srv.mux.HandleFunc("/api/team.info", srv.ServeTeamInfo)
 	srv.mux.HandleFunc("/api/usergroups.list", srv.ServeUserGroupList)
+	srv.mux.HandleFunc("/api/usergroups.users.list", srv.ServeUserGroupsUsersList)
 	srv.mux.HandleFunc("/api/usergroups.users.update", srv.ServeUserGroupsUsersUpdate)
+	srv.mux.HandleFunc("/api/views.open", srv.ServeViewsOpen)
 	// TODO: history, leave, join
 
 	srv.mux.HandleFunc("/stats", func(w http.ResponseWriter, req *http.Request) {
@@ -101,6 +103,20 @@ func (s *Server) SetActionURL(appID string, actionURL string) {
 	s.apps[appID].ActionURL = actionURL
 }
 
+// SetCommandURL sets the URL slash commands for the app will be sent to.
+func (s *Server) SetCommandURL(appID string, commandURL string) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	s.apps[appID].CommandURL = commandURL
+}
+
+// SetOptionsURL sets the URL external select menus for the app will load options from.
+func (s *Server) SetOptionsURL(appID string, optionsURL string) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	s.apps[appID].OptionsURL = optionsURL
+}
+
 // InstallApp will "install" a new app to this Slack server using pre-configured AppInfo.
 func (st *state) InstallStaticApp(app AppInfo, scopes ...string) (*AppInfo, error) {
 	st.mx.Lock()
@@ -251,6 +267,22 @@ func (st *state) UserGroupUserIDs(ugID string) []string {
 	return users
 }
 
+// SetUserGroupUserIDs will replace the users of a given user group, as if changed by someone in Slack.
+func (st *state) SetUserGroupUserIDs(ugID string, ids []string) {
+	st.mx.Lock()
+	defer st.mx.Unlock()
+
+	ug := st.usergroups[ugID]
+	if ug == nil {
+		return
+	}
+
+	ug.Users = append([]string(nil), ids...)
+}
+
+// TeamID will return the ID of the workspace.
+func (st *state) TeamID() string { return st.teamID }
+
 // Messages will return all messages from a given channel/group.
 func (st *state) Messages(chanID string) []Message {
 	st.mx.Lock()
@@ -0,0 +1,78 @@
+package mockslack
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// SlashCommand is a slash command sent to an app.
+type SlashCommand struct {
+	AppID     string
+	ChannelID string
+
+	// Command is the name of the command, e.g., `/goalert`.
+	Command string
+	Text    string
+}
+
+// CommandResponse is the message an app responded to a slash command with.
+type CommandResponse struct {
+	// Type is `ephemeral` or `in_channel`.
+	Type string
+
+	Message
+}
+
+// SlashCommandAs will send the slash command to the app's command URL as the given user.
+//
+// A nil response is returned if the app did not respond with a message (e.g., it opened a view instead).
+func (s *Server) SlashCommandAs(userID string, cmd SlashCommand) (*CommandResponse, error) {
+	usr := s.user(userID)
+	if usr == nil {
+		return nil, errors.New("invalid Slack user ID")
+	}
+
+	app := s.app(cmd.AppID)
+	if app == nil {
+		return nil, errors.New("invalid Slack app ID")
+	}
+
+	s.mx.Lock()
+	triggerID := s.gen.TriggerID()
+	s.triggers[triggerID] = &trigger{AppID: app.ID, UserID: usr.ID}
+	s.mx.Unlock()
+
+	v := make(url.Values)
+	v.Set("api_app_id", app.ID)
+	v.Set("team_id", s.teamID)
+	v.Set("team_domain", "example.com")
+	v.Set("channel_id", cmd.ChannelID)
+	v.Set("user_id", usr.ID)
+	v.Set("user_name", usr.Name)
+	v.Set("command", cmd.Command)
+	v.Set("text", cmd.Text)
+	v.Set("trigger_id", triggerID)
+
+	data, err := s.postSigned(app, app.CommandURL, v)
+	if err != nil {
+		return nil, fmt.Errorf("slash command: %w", err)
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var msg responseMessage
+	err = json.Unmarshal(data, &msg)
+	if err != nil {
+		return nil, fmt.Errorf("parse slash command response: %w", err)
+	}
+
+	resp := &CommandResponse{Type: msg.Type}
+	resp.ChannelID = cmd.ChannelID
+	resp.ToUserID = usr.ID
+	resp.Text, resp.Actions = msg.content(Action{AppID: app.ID, TeamID: s.teamID, ChannelID: cmd.ChannelID})
+
+	return resp, nil
+}
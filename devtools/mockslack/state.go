@@ -20,6 +20,8 @@ type state struct {
 	users      map[string]*userState
 	tokenCodes map[string]*tokenCode
 	usergroups map[string]*usergroupState
+	triggers   map[string]*trigger
+	views      map[string]*View
 	teamID     string
 }
 
@@ -32,6 +34,8 @@ func newState() *state {
 		users:      make(map[string]*userState),
 		tokenCodes: make(map[string]*tokenCode),
 		usergroups: make(map[string]*usergroupState),
+		triggers:   make(map[string]*trigger),
+		views:      make(map[string]*View),
 		teamID:     genTeamID(),
 	}
 }
@@ -51,9 +55,19 @@ type App struct {
 	AuthToken *AuthToken
 	ActionURL string
 
+	// CommandURL and OptionsURL are used for slash commands and external select menus, respectively.
+	CommandURL string
+	OptionsURL string
+
 	SigningSecret string
 }
 
+// trigger is issued with an interaction, allowing the app to open a view for the user.
+type trigger struct {
+	AppID  string
+	UserID string
+}
+
 type channelState struct {
 	Channel
 
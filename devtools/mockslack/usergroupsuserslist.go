@@ -0,0 +1,46 @@
+package mockslack
+
+import (
+	"context"
+	"net/http"
+)
+
+// UserGroupsUsersList returns the IDs of the users within a user group.
+func (st *API) UserGroupsUsersList(ctx context.Context, ugID string) ([]string, error) {
+	err := checkPermission(ctx, "bot", "usergroups:read")
+	if err != nil {
+		return nil, err
+	}
+
+	st.mx.Lock()
+	defer st.mx.Unlock()
+
+	ug := st.usergroups[ugID]
+	if ug == nil {
+		return nil, &response{Err: "no_such_subteam"}
+	}
+
+	users := make([]string, len(ug.Users))
+	copy(users, ug.Users)
+
+	return users, nil
+}
+
+// ServeUserGroupsUsersList serves a request to the `usergroups.users.list` API call.
+//
+// https://api.slack.com/methods/usergroups.users.list
+func (s *Server) ServeUserGroupsUsersList(w http.ResponseWriter, req *http.Request) {
+	users, err := s.API().UserGroupsUsersList(req.Context(), req.FormValue("usergroup"))
+	if respondErr(w, err) {
+		return
+	}
+
+	var resp struct {
+		response
+		Users []string `json:"users"`
+	}
+
+	resp.Users = users
+
+	respondWith(w, resp)
+}
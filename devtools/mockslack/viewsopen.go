@@ -0,0 +1,326 @@
+package mockslack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// View is a modal opened by an app.
+type View struct {
+	ID     string
+	AppID  string
+	UserID string
+
+	CallbackID      string
+	PrivateMetadata string
+	Title           string
+
+	Inputs []ViewInput
+}
+
+// ViewInput is an input block within a view.
+type ViewInput struct {
+	BlockID  string
+	ActionID string
+	Label    string
+
+	// Type is the type of the input element, e.g., `plain_text_input` or `external_select`.
+	Type string
+
+	// InitialValue is the initial value of the input, or the value of the initial option for selects.
+	InitialValue string
+}
+
+// ViewsOpen will open a view for the user an interaction's trigger ID was issued to.
+func (st *API) ViewsOpen(ctx context.Context, triggerID string, data json.RawMessage) (*View, error) {
+	err := checkPermission(ctx, "bot")
+	if err != nil {
+		return nil, err
+	}
+
+	var v struct {
+		Type            string
+		CallbackID      string `json:"callback_id"`
+		PrivateMetadata string `json:"private_metadata"`
+		Title           struct{ Text string }
+		Blocks          []struct {
+			Type    string
+			BlockID string `json:"block_id"`
+			Label   struct{ Text string }
+			Element struct {
+				Type          string
+				ActionID      string `json:"action_id"`
+				InitialValue  string `json:"initial_value"`
+				InitialOption *struct {
+					Value string
+				} `json:"initial_option"`
+			}
+		}
+	}
+	err = json.Unmarshal(data, &v)
+	if err != nil {
+		return nil, &response{Err: "invalid_arguments"}
+	}
+	if v.Type != "modal" {
+		return nil, &response{Err: "invalid_arguments"}
+	}
+
+	st.mx.Lock()
+	defer st.mx.Unlock()
+
+	trig := st.triggers[triggerID]
+	if trig == nil || trig.AppID != ContextToken(ctx).User {
+		return nil, &response{Err: "invalid_trigger_id"}
+	}
+	delete(st.triggers, triggerID)
+
+	view := &View{
+		ID:              st.gen.ViewID(),
+		AppID:           trig.AppID,
+		UserID:          trig.UserID,
+		CallbackID:      v.CallbackID,
+		PrivateMetadata: v.PrivateMetadata,
+		Title:           v.Title.Text,
+	}
+	for _, b := range v.Blocks {
+		if b.Type != "input" {
+			continue
+		}
+		in := ViewInput{
+			BlockID:      b.BlockID,
+			ActionID:     b.Element.ActionID,
+			Label:        b.Label.Text,
+			Type:         b.Element.Type,
+			InitialValue: b.Element.InitialValue,
+		}
+		if b.Element.InitialOption != nil {
+			in.InitialValue = b.Element.InitialOption.Value
+		}
+		view.Inputs = append(view.Inputs, in)
+	}
+	st.views[view.ID] = view
+
+	cpy := *view
+	return &cpy, nil
+}
+
+// ServeViewsOpen serves a request to the `views.open` API call.
+//
+// https://api.slack.com/methods/views.open
+func (s *Server) ServeViewsOpen(w http.ResponseWriter, req *http.Request) {
+	var body struct {
+		TriggerID string `json:"trigger_id"`
+		View      json.RawMessage
+	}
+	err := json.NewDecoder(req.Body).Decode(&body)
+	if err != nil {
+		respondErr(w, &response{Err: "invalid_json"})
+		return
+	}
+
+	view, err := s.API().ViewsOpen(req.Context(), body.TriggerID, body.View)
+	if respondErr(w, err) {
+		return
+	}
+
+	var resp struct {
+		response
+		View struct {
+			ID         string `json:"id"`
+			CallbackID string `json:"callback_id"`
+		} `json:"view"`
+	}
+	resp.OK = true
+	resp.View.ID = view.ID
+	resp.View.CallbackID = view.CallbackID
+
+	respondWith(w, resp)
+}
+
+// UserViews returns the views that have been opened for the user.
+func (st *state) UserViews(userID string) []View {
+	st.mx.Lock()
+	defer st.mx.Unlock()
+
+	var views []View
+	for _, v := range st.views {
+		if v.UserID == userID {
+			views = append(views, *v)
+		}
+	}
+
+	return views
+}
+
+// interactionBody is the payload for interactions with a view.
+type interactionBody struct {
+	Type     string `json:"type"`
+	AppID    string `json:"api_app_id"`
+	ActionID string `json:"action_id,omitempty"`
+	BlockID  string `json:"block_id,omitempty"`
+	Value    string `json:"value,omitempty"`
+	Team     struct {
+		ID     string `json:"id"`
+		Domain string `json:"domain"`
+	} `json:"team"`
+	User struct {
+		ID       string `json:"id"`
+		Username string `json:"username"`
+		Name     string `json:"name"`
+		TeamID   string `json:"team_id"`
+	} `json:"user"`
+	View struct {
+		ID              string `json:"id"`
+		CallbackID      string `json:"callback_id"`
+		PrivateMetadata string `json:"private_metadata"`
+		State           struct {
+			Values map[string]map[string]any `json:"values"`
+		} `json:"state"`
+	} `json:"view"`
+}
+
+// viewInteraction returns the view, app, and a payload for an interaction with it by the user.
+func (s *Server) viewInteraction(userID, viewID, typ string) (*View, *appState, *interactionBody, error) {
+	usr := s.user(userID)
+	if usr == nil {
+		return nil, nil, nil, errors.New("invalid Slack user ID")
+	}
+
+	s.mx.Lock()
+	v := s.views[viewID]
+	s.mx.Unlock()
+	if v == nil || v.UserID != userID {
+		return nil, nil, nil, errors.New("invalid view ID")
+	}
+
+	app := s.app(v.AppID)
+	if app == nil {
+		return nil, nil, nil, errors.New("invalid Slack app ID")
+	}
+
+	var p interactionBody
+	p.Type = typ
+	p.AppID = app.ID
+	p.Team.ID = s.teamID
+	p.Team.Domain = "example.com"
+	p.User.ID = usr.ID
+	p.User.Username = usr.Name
+	p.User.Name = usr.Name
+	p.User.TeamID = s.teamID
+	p.View.ID = v.ID
+	p.View.CallbackID = v.CallbackID
+	p.View.PrivateMetadata = v.PrivateMetadata
+
+	return v, app, &p, nil
+}
+
+func encodePayload(p any) (url.Values, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, fmt.Errorf("serialize payload: %w", err)
+	}
+
+	v := make(url.Values)
+	v.Set("payload", string(data))
+	return v, nil
+}
+
+// SubmitViewAs will submit the view as the given user, with values keyed by action ID. For
+// selects, the value is the value of the selected option.
+//
+// Inputs without a provided value keep their initial value. The response body is returned, which
+// is empty if the app closed the view.
+func (s *Server) SubmitViewAs(userID, viewID string, values map[string]string) ([]byte, error) {
+	v, app, p, err := s.viewInteraction(userID, viewID, "view_submission")
+	if err != nil {
+		return nil, err
+	}
+
+	p.View.State.Values = make(map[string]map[string]any)
+	for _, in := range v.Inputs {
+		val, ok := values[in.ActionID]
+		if !ok {
+			val = in.InitialValue
+		}
+
+		state := map[string]any{"type": in.Type}
+		switch in.Type {
+		case "static_select", "external_select":
+			if val != "" {
+				state["selected_option"] = map[string]string{"value": val}
+			}
+		default:
+			state["value"] = val
+		}
+		p.View.State.Values[in.BlockID] = map[string]any{in.ActionID: state}
+	}
+
+	form, err := encodePayload(p)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := s.postSigned(app, app.ActionURL, form)
+	if err != nil {
+		return nil, fmt.Errorf("submit view: %w", err)
+	}
+
+	return data, nil
+}
+
+// Option is an option for a select menu.
+type Option struct {
+	Text  string
+	Value string
+}
+
+// MenuOptionsAs will request the options for an external select within the view as the given user.
+func (s *Server) MenuOptionsAs(userID, viewID, actionID, query string) ([]Option, error) {
+	v, app, p, err := s.viewInteraction(userID, viewID, "block_suggestion")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, in := range v.Inputs {
+		if in.ActionID == actionID {
+			p.BlockID = in.BlockID
+		}
+	}
+	if p.BlockID == "" {
+		return nil, errors.New("invalid action ID")
+	}
+	p.ActionID = actionID
+	p.Value = query
+
+	form, err := encodePayload(p)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := s.postSigned(app, app.OptionsURL, form)
+	if err != nil {
+		return nil, fmt.Errorf("menu options: %w", err)
+	}
+
+	var resp struct {
+		Options []struct {
+			Text  struct{ Text string }
+			Value string
+		}
+	}
+	err = json.Unmarshal(data, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("parse menu options: %w", err)
+	}
+
+	opts := make([]Option, len(resp.Options))
+	for i, o := range resp.Options {
+		opts[i] = Option{Text: o.Text.Text, Value: o.Value}
+	}
+
+	return opts, nil
+}
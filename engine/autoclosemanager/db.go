@@ -0,0 +1,35 @@
+package autoclosemanager
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/target/goalert/alert"
+	"github.com/target/goalert/engine/processinglock"
+)
+
+// DB closes alerts from integration keys that have stopped sending events.
+type DB struct {
+	lock *processinglock.Lock
+
+	alertStore *alert.Store
+}
+
+// Name returns the name of the module.
+func (db *DB) Name() string { return "Engine.AutoCloseManager" }
+
+// NewDB creates a new DB.
+func NewDB(ctx context.Context, db *sql.DB, a *alert.Store) (*DB, error) {
+	lock, err := processinglock.NewLock(ctx, db, processinglock.Config{
+		Type:    processinglock.TypeAutoClose,
+		Version: 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &DB{
+		lock:       lock,
+		alertStore: a,
+	}, nil
+}
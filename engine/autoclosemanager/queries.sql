@@ -0,0 +1,28 @@
+-- name: AutoCloseMgrFindSilent :many
+-- Returns open alerts that have not received an event within the auto-close duration of their integration key.
+SELECT
+    ac.alert_id,
+    ac.integration_key_id,
+    k.name AS integration_key_name,
+    k.auto_close_minutes::int AS auto_close_minutes
+FROM
+    alert_auto_close ac
+    JOIN integration_keys k ON k.id = ac.integration_key_id
+        AND k.auto_close_minutes IS NOT NULL
+    JOIN alerts a ON a.id = ac.alert_id
+        AND a.status != 'closed'
+WHERE
+    ac.last_seen < now() - make_interval(mins => k.auto_close_minutes)
+ORDER BY
+    ac.alert_id
+LIMIT 100
+FOR UPDATE
+    OF ac SKIP LOCKED;
+
+-- name: AutoCloseMgrDeleteStale :exec
+-- Removes tracking for alerts that are closed, or whose integration key no longer has auto-close enabled.
+DELETE FROM alert_auto_close ac USING alerts a, integration_keys k
+WHERE a.id = ac.alert_id
+    AND k.id = ac.integration_key_id
+    AND (a.status = 'closed'
+        OR k.auto_close_minutes IS NULL);
@@ -0,0 +1,65 @@
+package autoclosemanager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/target/goalert/alert"
+	"github.com/target/goalert/alert/alertlog"
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/util/log"
+	"github.com/target/goalert/util/sqlutil"
+)
+
+// UpdateAll will close any open alerts whose integration key has not received
+// a new or duplicate event within its auto-close duration.
+func (db *DB) UpdateAll(ctx context.Context) error {
+	err := permission.LimitCheckAny(ctx, permission.System)
+	if err != nil {
+		return err
+	}
+	log.Debugf(ctx, "Auto-closing silent alerts.")
+
+	tx, err := db.lock.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer sqlutil.Rollback(ctx, "auto-close manager", tx)
+
+	q := gadb.New(tx)
+	rows, err := q.AutoCloseMgrFindSilent(ctx)
+	if err != nil {
+		return fmt.Errorf("find silent alerts: %w", err)
+	}
+
+	// group by key, so each alert is logged with the settings of the key that closed it
+	type keyInfo struct {
+		ID   uuid.UUID
+		Name string
+		Min  int32
+	}
+	byKey := make(map[keyInfo][]int)
+	for _, r := range rows {
+		k := keyInfo{ID: r.IntegrationKeyID, Name: r.IntegrationKeyName, Min: r.AutoCloseMinutes}
+		byKey[k] = append(byKey[k], int(r.AlertID))
+	}
+
+	for k, ids := range byKey {
+		_, err = db.alertStore.UpdateManyAlertStatusTx(ctx, tx, alert.StatusClosed, ids, alertlog.AutoClose{
+			SilenceMinutes:     int(k.Min),
+			IntegrationKeyName: k.Name,
+		})
+		if err != nil {
+			return fmt.Errorf("close alerts for key %s: %w", k.ID, err)
+		}
+	}
+
+	err = q.AutoCloseMgrDeleteStale(ctx)
+	if err != nil {
+		return fmt.Errorf("delete stale: %w", err)
+	}
+
+	return tx.Commit()
+}
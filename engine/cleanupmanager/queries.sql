@@ -224,3 +224,19 @@ WHERE
         FOR UPDATE
             SKIP LOCKED);
 
+
+-- name: CleanupMgrTrimUIKSamples :execrows
+-- CleanupMgrTrimUIKSamples will delete universal key request samples beyond the most recent keep_count for each key.
+DELETE FROM uik_samples
+WHERE id = ANY (
+        SELECT
+            id
+        FROM (
+            SELECT
+                id,
+                row_number() OVER (PARTITION BY key_id ORDER BY received_at DESC) AS n
+            FROM
+                uik_samples) s
+        WHERE
+            n > sqlc.arg(keep_count)::int
+        LIMIT 100);
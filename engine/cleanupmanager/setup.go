@@ -19,6 +19,7 @@ const (
 	PriorityAlertCleanup = 1
 	PrioritySchedHistory = 1
 	PriorityAPICleanup   = 1
+	PriorityUIKSamples   = 1
 	PriorityTempSchedLFW = 2
 	PriorityAlertLogsLFW = 2
 	PriorityTempSched    = 3
@@ -59,6 +60,7 @@ func (db *DB) Setup(ctx context.Context, args processinglock.SetupArgs) error {
 	river.AddWorker(args.Workers, river.WorkFunc(db.CleanupAlertLogs))
 	river.AddWorker(args.Workers, river.WorkFunc(db.LookForWorkAlertLogs))
 	river.AddWorker(args.Workers, river.WorkFunc(db.CleanupAPIKeys))
+	river.AddWorker(args.Workers, river.WorkFunc(db.CleanupUIKSamples))
 
 	err := args.River.Queues().Add(QueueName, river.QueueConfig{MaxWorkers: 5})
 	if err != nil {
@@ -132,5 +134,18 @@ func (db *DB) Setup(ctx context.Context, args processinglock.SetupArgs) error {
 		),
 	})
 
+	args.River.PeriodicJobs().AddMany([]*river.PeriodicJob{
+		river.NewPeriodicJob(
+			river.PeriodicInterval(10*time.Minute),
+			func() (river.JobArgs, *river.InsertOpts) {
+				return UIKSamplesArgs{}, &river.InsertOpts{
+					Queue:    QueueName,
+					Priority: PriorityUIKSamples,
+				}
+			},
+			&river.PeriodicJobOpts{RunOnStart: true},
+		),
+	})
+
 	return nil
 }
@@ -0,0 +1,31 @@
+package cleanupmanager
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/riverqueue/river"
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/integrationkey"
+)
+
+type UIKSamplesArgs struct{}
+
+func (UIKSamplesArgs) Kind() string { return "cleanup-manager-uik-samples" }
+
+// CleanupUIKSamples will remove universal key request samples beyond the most recent integrationkey.MaxSamples for each key.
+func (db *DB) CleanupUIKSamples(ctx context.Context, j *river.Job[UIKSamplesArgs]) error {
+	err := db.whileWork(ctx, func(ctx context.Context, tx *sql.Tx) (done bool, err error) {
+		count, err := gadb.New(tx).CleanupMgrTrimUIKSamples(ctx, integrationkey.MaxSamples)
+		if err != nil {
+			return false, fmt.Errorf("trim uik samples: %w", err)
+		}
+		return count < 100, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
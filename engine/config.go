@@ -17,6 +17,7 @@ import (
 	"github.com/target/goalert/notificationchannel"
 	"github.com/target/goalert/oncall"
 	"github.com/target/goalert/schedule"
+	"github.com/target/goalert/service/msgtemplate"
 	"github.com/target/goalert/user"
 	"github.com/target/goalert/user/contactmethod"
 )
@@ -25,6 +26,7 @@ import (
 type Config struct {
 	AlertLogStore       *alertlog.Store
 	AlertStore          *alert.Store
+	MsgTemplateStore    *msgtemplate.Store
 	ContactMethodStore  *contactmethod.Store
 	NotificationManager *notification.Manager
 	UserStore           *user.Store
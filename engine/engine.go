@@ -12,6 +12,8 @@ import (
 	"github.com/target/goalert/alert"
 	"github.com/target/goalert/app/lifecycle"
 	"github.com/target/goalert/auth/authlink"
+	"github.com/target/goalert/config"
+	"github.com/target/goalert/engine/autoclosemanager"
 	"github.com/target/goalert/engine/cleanupmanager"
 	"github.com/target/goalert/engine/compatmanager"
 	"github.com/target/goalert/engine/escalationmanager"
@@ -24,6 +26,7 @@ import (
 	"github.com/target/goalert/engine/schedulemanager"
 	"github.com/target/goalert/engine/signalmgr"
 	"github.com/target/goalert/engine/statusmgr"
+	"github.com/target/goalert/engine/usergroupmanager"
 	"github.com/target/goalert/engine/verifymanager"
 	"github.com/target/goalert/expflag"
 	"github.com/target/goalert/gadb"
@@ -32,6 +35,7 @@ import (
 	"github.com/target/goalert/user"
 	"github.com/target/goalert/util/log"
 	"github.com/target/goalert/util/sqlutil"
+	"github.com/target/goalert/validation"
 )
 
 // Engine handles automatic escalation of unacknowledged(triggered) alerts, as well as
@@ -135,6 +139,14 @@ func NewEngine(ctx context.Context, db *sql.DB, c *Config) (*Engine, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "compatibility backend")
 	}
+	autoCloseMgr, err := autoclosemanager.NewDB(ctx, db, c.AlertStore)
+	if err != nil {
+		return nil, errors.Wrap(err, "auto-close backend")
+	}
+	ugMgr, err := usergroupmanager.NewDB(ctx, db, c.SlackStore)
+	if err != nil {
+		return nil, errors.Wrap(err, "user group sync backend")
+	}
 
 	p.modules = []processinglock.Module{
 		compatMgr,
@@ -145,6 +157,8 @@ func NewEngine(ctx context.Context, db *sql.DB, c *Config) (*Engine, error) {
 		statMgr,
 		verifyMgr,
 		hbMgr,
+		autoCloseMgr,
+		ugMgr,
 		cleanMgr,
 		metricsMgr,
 	}
@@ -370,6 +384,8 @@ func (p *Engine) ReceiveSubject(ctx context.Context, providerID, subjectID, call
 			return fmt.Errorf("escalate alert: %w", err)
 		}
 		return nil
+	case notification.ResultSnooze:
+		return p.snooze(ctx, cb.AlertID)
 	default:
 		return errors.New("unknown result type")
 	}
@@ -384,6 +400,20 @@ func (p *Engine) ReceiveSubject(ctx context.Context, providerID, subjectID, call
 	return errors.New("unknown callback type")
 }
 
+// snooze will snooze the alert for the configured duration.
+func (p *Engine) snooze(ctx context.Context, alertID int) error {
+	if alertID == 0 {
+		return validation.NewGenericError("snooze is only supported for individual alerts")
+	}
+
+	minutes := config.FromContext(ctx).General.AlertSnoozeMinutes
+	if minutes == 0 {
+		minutes = alert.DefaultSnoozeMinutes
+	}
+
+	return errors.Wrap(p.a.Snooze(ctx, alertID, minutes), "snooze alert")
+}
+
 // Receive will process a notification result.
 func (p *Engine) Receive(ctx context.Context, callbackID string, result notification.Result) error {
 	cb, err := p.b.FindOne(ctx, callbackID)
@@ -429,6 +459,8 @@ func (p *Engine) Receive(ctx context.Context, callbackID string, result notifica
 			return fmt.Errorf("escalate alert: %w", err)
 		}
 		return nil
+	case notification.ResultSnooze:
+		return p.snooze(ctx, cb.AlertID)
 	default:
 		return errors.New("unknown result type")
 	}
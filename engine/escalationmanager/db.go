@@ -23,6 +23,10 @@ type DB struct {
 	deletedSteps     *sql.Stmt
 	normalEscalation *sql.Stmt
 
+	endSnooze       *sql.Stmt
+	retriggerAlerts *sql.Stmt
+	resetEPState    *sql.Stmt
+
 	log *alertlog.Store
 }
 
@@ -32,7 +36,7 @@ func (db *DB) Name() string { return "Engine.EscalationManager" }
 // NewDB creates a new DB.
 func NewDB(ctx context.Context, db *sql.DB, log *alertlog.Store) (*DB, error) {
 	lock, err := processinglock.NewLock(ctx, db, processinglock.Config{
-		Version: 4,
+		Version: 5,
 		Type:    processinglock.TypeEscalation,
 	})
 	if err != nil {
@@ -98,6 +102,34 @@ func NewDB(ctx context.Context, db *sql.DB, log *alertlog.Store) (*DB, error) {
 				pol.step_count = 0
 		`),
 
+		endSnooze: p.P(`
+			delete from alert_snoozes snooze
+			using alerts a
+			where
+				a.id = snooze.alert_id and
+				(snooze.snoozed_until <= now() or a.status = 'closed')
+			returning snooze.alert_id, a.status = 'active'
+		`),
+
+		retriggerAlerts: p.P(`
+			update alerts
+			set status = 'triggered'
+			where id = any($1) and status = 'active'
+			returning id
+		`),
+
+		resetEPState: p.P(`
+			update escalation_policy_state
+			set
+				escalation_policy_step_number = 0,
+				escalation_policy_step_id = null,
+				loop_count = 0,
+				last_escalation = null,
+				next_escalation = null,
+				force_escalation = false
+			where alert_id = any($1)
+		`),
+
 		newPolicies: p.P(`
 			with to_escalate as (
 				select alert_id, step.id ep_step_id, step.delay, step.escalation_policy_id, a.service_id
@@ -54,6 +54,11 @@ func (db *DB) update(ctx context.Context, all bool, alertID *int) error {
 		return errors.Wrap(err, "end policies with no steps")
 	}
 
+	err = db.endSnoozes(ctx)
+	if err != nil {
+		return errors.Wrap(err, "end expired snoozes")
+	}
+
 	err = db.processEscalations(ctx, db.newPolicies, func(rows *sql.Rows) (int, *alertlog.EscalationMetaData, error) {
 		var id int
 		var meta alertlog.EscalationMetaData
@@ -87,6 +92,67 @@ func (db *DB) update(ctx context.Context, all bool, alertID *int) error {
 	return nil
 }
 
+// endSnoozes will return alerts with an expired snooze to triggered, restarting escalation from the first step.
+func (db *DB) endSnoozes(ctx context.Context) error {
+	tx, err := db.lock.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer sqlutil.Rollback(ctx, "escalation manager: end snoozes", tx)
+
+	rows, err := tx.StmtContext(ctx, db.endSnooze).QueryContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	var expired sqlutil.IntArray
+	for rows.Next() {
+		var id int
+		var isActive bool
+		err = rows.Scan(&id, &isActive)
+		if err != nil {
+			return err
+		}
+		if !isActive {
+			// closed alerts are not re-triggered
+			continue
+		}
+		expired = append(expired, id)
+	}
+	if len(expired) == 0 {
+		return tx.Commit()
+	}
+
+	rows, err = tx.StmtContext(ctx, db.retriggerAlerts).QueryContext(ctx, expired)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	var retriggered sqlutil.IntArray
+	for rows.Next() {
+		var id int
+		err = rows.Scan(&id)
+		if err != nil {
+			return err
+		}
+		retriggered = append(retriggered, id)
+	}
+
+	_, err = tx.StmtContext(ctx, db.resetEPState).ExecContext(ctx, retriggered)
+	if err != nil {
+		return err
+	}
+
+	err = db.log.LogManyTx(ctx, tx, retriggered, alertlog.TypeSnoozeExpired, nil)
+	if err != nil {
+		return errors.Wrap(err, "log snooze expired")
+	}
+
+	return tx.Commit()
+}
+
 func (db *DB) processEscalations(ctx context.Context, stmt *sql.Stmt, scan func(*sql.Rows) (int, *alertlog.EscalationMetaData, error)) error {
 	tx, err := db.lock.BeginTx(ctx, nil)
 	if err != nil {
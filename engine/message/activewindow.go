@@ -0,0 +1,46 @@
+package message
+
+import (
+	"time"
+
+	"github.com/target/goalert/notification"
+)
+
+// deferredDetails returns the status details for a message being held until the contact method's active window opens.
+func deferredDetails(msg Message, now time.Time) string {
+	start := msg.ActiveWindow.NextStart(now)
+	if start.IsZero() {
+		return "deferred: outside of contact method active window"
+	}
+
+	return "deferred until " + start.Format("Mon Jan 2 15:04 MST") + ": outside of contact method active window"
+}
+
+// splitActiveWindow will remove unsent alert messages for contact methods that are outside of their active window.
+//
+// Messages that should be held until the window opens are returned as deferred, all others that are
+// outside of the window are returned as skipped.
+func splitActiveWindow(messages []Message, now time.Time) (result, deferred, skipped []Message) {
+	toProcess, result := splitPendingByType(messages,
+		notification.MessageTypeAlert,
+		notification.MessageTypeAlertBundle,
+		notification.MessageTypeAlertStatus,
+		notification.MessageTypeAlertStatusBundle,
+	)
+
+	for _, msg := range toProcess {
+		if msg.ActiveWindow == nil || msg.ActiveWindow.IsActive(now) {
+			result = append(result, msg)
+			continue
+		}
+
+		if msg.ActiveWindow.Defer {
+			deferred = append(deferred, msg)
+			continue
+		}
+
+		skipped = append(skipped, msg)
+	}
+
+	return result, deferred, skipped
+}
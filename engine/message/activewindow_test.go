@@ -0,0 +1,51 @@
+package message
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/target/goalert/notification"
+	"github.com/target/goalert/user/contactmethod"
+	"github.com/target/goalert/util/timeutil"
+)
+
+func TestSplitActiveWindow(t *testing.T) {
+	// 9am-5pm, every day
+	business := &contactmethod.ActiveWindow{
+		Days:     timeutil.EveryDay(),
+		Start:    timeutil.NewClock(9, 0),
+		End:      timeutil.NewClock(17, 0),
+		TimeZone: "UTC",
+	}
+	deferBusiness := *business
+	deferBusiness.Defer = true
+
+	night := time.Date(2024, 1, 1, 3, 0, 0, 0, time.UTC)
+	msgs := []Message{
+		{ID: "no-window", Type: notification.MessageTypeAlert},
+		{ID: "skip", Type: notification.MessageTypeAlert, ActiveWindow: business},
+		{ID: "defer", Type: notification.MessageTypeAlertStatus, ActiveWindow: &deferBusiness},
+		{ID: "verify", Type: notification.MessageTypeVerification, ActiveWindow: business},
+		{ID: "sent", Type: notification.MessageTypeAlert, ActiveWindow: business, SentAt: night},
+	}
+
+	result, deferred, skipped := splitActiveWindow(msgs, night)
+	assert.ElementsMatch(t, []string{"no-window", "verify", "sent"}, msgIDs(result))
+	assert.Equal(t, []string{"defer"}, msgIDs(deferred))
+	assert.Equal(t, []string{"skip"}, msgIDs(skipped))
+	assert.Equal(t, "deferred until Mon Jan 1 09:00 UTC: outside of contact method active window", deferredDetails(deferred[0], night))
+
+	result, deferred, skipped = splitActiveWindow(msgs, night.Add(8*time.Hour))
+	assert.Len(t, result, len(msgs))
+	assert.Empty(t, deferred)
+	assert.Empty(t, skipped)
+}
+
+func msgIDs(msgs []Message) []string {
+	var ids []string
+	for _, m := range msgs {
+		ids = append(ids, m.ID)
+	}
+	return ids
+}
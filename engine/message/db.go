@@ -17,6 +17,7 @@ import (
 	"github.com/target/goalert/notification"
 	"github.com/target/goalert/permission"
 	"github.com/target/goalert/retry"
+	"github.com/target/goalert/user/contactmethod"
 	"github.com/target/goalert/util"
 	"github.com/target/goalert/util/log"
 	"github.com/target/goalert/util/sqlutil"
@@ -46,6 +47,10 @@ type DB struct {
 
 	failSMSVoice *sql.Stmt
 
+	deferInactiveCM *sql.Stmt
+	failInactiveCM  *sql.Stmt
+	failPausedCM    *sql.Stmt
+
 	sentByCMType *sql.Stmt
 
 	cleanupStatusUpdateOptOut *sql.Stmt
@@ -70,7 +75,7 @@ type DB struct {
 func NewDB(ctx context.Context, db *sql.DB, a *alertlog.Store, pausable lifecycle.Pausable) (*DB, error) {
 	lock, err := processinglock.NewLock(ctx, db, processinglock.Config{
 		Type:    processinglock.TypeMessage,
-		Version: 11,
+		Version: 13,
 	})
 	if err != nil {
 		return nil, err
@@ -84,7 +89,16 @@ func NewDB(ctx context.Context, db *sql.DB, a *alertlog.Store, pausable lifecycl
 			last_status_at = now(),
 			status_details = $3,
 			provider_msg_id = coalesce($2, provider_msg_id),
-			next_retry_at = CASE WHEN retry_count < 3 THEN now() + '15 seconds'::interval ELSE null END
+			next_retry_at = CASE WHEN retry_count < 3 THEN
+				now() + '15 seconds'::interval * (
+					-- webhook endpoints back off exponentially, other destinations keep a fixed retry interval
+					CASE WHEN 'builtin-webhook' IN (
+						SELECT nc.dest ->> 'Type' FROM notification_channels nc WHERE nc.id = channel_id
+						UNION ALL
+						SELECT cm.dest ->> 'Type' FROM user_contact_methods cm WHERE cm.id = contact_method_id
+					) THEN power(2, retry_count) ELSE 1 END
+				)
+			ELSE null END
 		where id = $1 or provider_msg_id = $2
 	`)
 	permFail := p.P(`
@@ -275,6 +289,36 @@ func NewDB(ctx context.Context, db *sql.DB, a *alertlog.Store, pausable lifecycl
 			returning msg.id as msg_id, alert_id, msg.user_id, cm.id as cm_id
 		`),
 
+		deferInactiveCM: p.P(`
+			update outgoing_messages
+			set
+				status_details = $2,
+				last_status_at = now()
+			where id = $1 and last_status = 'pending'
+		`),
+
+		failInactiveCM: p.P(`
+			update outgoing_messages
+			set
+				last_status = 'failed',
+				last_status_at = now(),
+				status_details = 'outside of contact method active window',
+				cycle_id = null,
+				next_retry_at = null
+			where id = $1 and last_status = 'pending'
+		`),
+
+		failPausedCM: p.P(`
+			update outgoing_messages
+			set
+				last_status = 'failed',
+				last_status_at = now(),
+				status_details = $2,
+				cycle_id = null,
+				next_retry_at = null
+			where id = $1 and last_status = 'pending'
+		`),
+
 		createAlertBundle: p.P(`
 			insert into outgoing_messages (
 				id,
@@ -338,6 +382,14 @@ func (db *DB) currentQueue(ctx context.Context, tx *sql.Tx, now time.Time) (*que
 		msg.DestID.NCID = row.ChanID
 		msg.Dest = row.Dest.DestV1
 		msg.StatusAlertIDs = row.StatusAlertIds
+		msg.StatusDetails = row.StatusDetails
+		msg.PausedUntil = row.PausedUntil.Time
+		if row.ActiveWindow.Valid {
+			msg.ActiveWindow, err = contactmethod.ParseActiveWindow(row.ActiveWindow.RawMessage)
+			if err != nil {
+				log.Log(ctx, fmt.Errorf("parse active window for message %s: %w", msg.ID, err))
+			}
+		}
 		if row.ScheduleID.Valid {
 			msg.ScheduleID = row.ScheduleID.UUID.String()
 		}
@@ -364,6 +416,36 @@ func (db *DB) currentQueue(ctx context.Context, tx *sql.Tx, now time.Time) (*que
 	}
 	db.lastSent = now
 
+	result, paused := splitPaused(result, now)
+	for _, msg := range paused {
+		_, err = tx.StmtContext(ctx, db.failPausedCM).ExecContext(ctx, msg.ID, pausedDetails(msg))
+		if err != nil {
+			return nil, fmt.Errorf("skip message to paused contact method: %w", err)
+		}
+		db.logNotSent(ctx, tx, msg, "skipped: "+pausedDetails(msg))
+	}
+
+	result, deferred, skipped := splitActiveWindow(result, now)
+	for _, msg := range deferred {
+		details := deferredDetails(msg, now)
+		if details == msg.StatusDetails {
+			// already logged
+			continue
+		}
+		_, err = tx.StmtContext(ctx, db.deferInactiveCM).ExecContext(ctx, msg.ID, details)
+		if err != nil {
+			return nil, fmt.Errorf("defer message outside of active window: %w", err)
+		}
+		db.logNotSent(ctx, tx, msg, details)
+	}
+	for _, msg := range skipped {
+		_, err = tx.StmtContext(ctx, db.failInactiveCM).ExecContext(ctx, msg.ID)
+		if err != nil {
+			return nil, fmt.Errorf("skip message outside of active window: %w", err)
+		}
+		db.logNotSent(ctx, tx, msg, "skipped: outside of contact method active window")
+	}
+
 	result, toDelete := dedupOnCallNotifications(result)
 	if len(toDelete) > 0 {
 		_, err = tx.StmtContext(ctx, db.deleteAny).ExecContext(ctx, sqlutil.UUIDArray(toDelete))
@@ -422,6 +504,19 @@ func (db *DB) currentQueue(ctx context.Context, tx *sql.Tx, now time.Time) (*que
 	return newQueue(result, now), nil
 }
 
+// logNotSent will record a message that was deferred or skipped, along with the reason, in the alert log.
+func (db *DB) logNotSent(ctx context.Context, tx *sql.Tx, msg Message, reason string) {
+	if msg.AlertID == 0 {
+		// bundles and status updates for multiple alerts are not logged
+		return
+	}
+
+	db.alertlogstore.MustLogTx(permission.UserSourceContext(ctx, msg.UserID, permission.RoleUser, &permission.SourceInfo{
+		Type: permission.SourceTypeContactMethod,
+		ID:   msg.DestID.CMID.UUID.String(),
+	}), tx, msg.AlertID, alertlog.TypeNoNotificationSent, alertlog.NoNotificationMetaData{MessageID: msg.ID, Reason: reason})
+}
+
 // UpdateMessageStatus will update the state of a message.
 func (db *DB) UpdateMessageStatus(ctx context.Context, status *notification.SendResult) error {
 	return retry.DoTemporaryError(func(int) error {
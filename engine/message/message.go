@@ -6,6 +6,7 @@ import (
 	"github.com/target/goalert/gadb"
 	"github.com/target/goalert/notification"
 	"github.com/target/goalert/notification/nfymsg"
+	"github.com/target/goalert/user/contactmethod"
 )
 
 // Message represents the data for an outgoing message.
@@ -25,6 +26,13 @@ type Message struct {
 	SentAt     time.Time
 
 	StatusAlertIDs []int64
+
+	// ActiveWindow, if set, restricts when the message may be sent to the contact method.
+	ActiveWindow  *contactmethod.ActiveWindow
+	StatusDetails string
+
+	// PausedUntil, if set, is the time until which alert messages to the contact method are skipped.
+	PausedUntil time.Time
 }
 
 func (m Message) Base() nfymsg.Base {
@@ -0,0 +1,33 @@
+package message
+
+import (
+	"time"
+
+	"github.com/target/goalert/notification"
+)
+
+// pausedDetails returns the status details for a message skipped because the contact method is paused.
+func pausedDetails(msg Message) string {
+	return "contact method paused until " + msg.PausedUntil.Format("Mon Jan 2 15:04 MST")
+}
+
+// splitPaused will remove unsent alert messages for contact methods that are currently paused.
+func splitPaused(messages []Message, now time.Time) (result, skipped []Message) {
+	toProcess, result := splitPendingByType(messages,
+		notification.MessageTypeAlert,
+		notification.MessageTypeAlertBundle,
+		notification.MessageTypeAlertStatus,
+		notification.MessageTypeAlertStatusBundle,
+	)
+
+	for _, msg := range toProcess {
+		if msg.PausedUntil.After(now) {
+			skipped = append(skipped, msg)
+			continue
+		}
+
+		result = append(result, msg)
+	}
+
+	return result, skipped
+}
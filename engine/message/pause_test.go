@@ -0,0 +1,27 @@
+package message
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/target/goalert/notification"
+)
+
+func TestSplitPaused(t *testing.T) {
+	now := time.Date(2024, 1, 1, 3, 0, 0, 0, time.UTC)
+	later := now.Add(time.Hour)
+	msgs := []Message{
+		{ID: "not-paused", Type: notification.MessageTypeAlert},
+		{ID: "expired", Type: notification.MessageTypeAlert, PausedUntil: now.Add(-time.Minute)},
+		{ID: "paused", Type: notification.MessageTypeAlert, PausedUntil: later},
+		{ID: "paused-status", Type: notification.MessageTypeAlertStatus, PausedUntil: later},
+		{ID: "verify", Type: notification.MessageTypeVerification, PausedUntil: later},
+		{ID: "sent", Type: notification.MessageTypeAlert, PausedUntil: later, SentAt: now},
+	}
+
+	result, skipped := splitPaused(msgs, now)
+	assert.ElementsMatch(t, []string{"not-paused", "expired", "verify", "sent"}, msgIDs(result))
+	assert.ElementsMatch(t, []string{"paused", "paused-status"}, msgIDs(skipped))
+	assert.Equal(t, "contact method paused until Mon Jan 1 04:00 UTC", pausedDetails(skipped[0]))
+}
@@ -13,7 +13,10 @@ SELECT
     msg.created_at,
     msg.sent_at,
     msg.status_alert_ids,
-    msg.schedule_id
+    msg.schedule_id,
+    msg.status_details,
+    cm.active_window,
+    cm.paused_until
 FROM
     outgoing_messages msg
     LEFT JOIN user_contact_methods cm ON cm.id = msg.contact_method_id
@@ -44,6 +44,10 @@ func NewDB(ctx context.Context, db *sql.DB, log *alertlog.Store) (*DB, error) {
 		// - notifications were sent for 0-minute at 1:00:15 (last tick = 1:00:15)
 		// - at 1:01:15 only notification rules with delays between 15 and 75 seconds would be processed/sent
 		// Note: since delays are in minutes, the above example would just send the 1 minute rules (60 seconds)
+		//
+		// Rules with a filter are only used for matching alerts.
+		//
+		// If $1 is set, voice contact methods are skipped for alerts less severe than $1.
 		queueMessages: p.P(`
 			with lock_cycles as (
 				select
@@ -103,7 +107,27 @@ func NewDB(ctx context.Context, db *sql.DB, log *alertlog.Store) (*DB, error) {
 						cycle.last_tick isnull or
 						concat(rule.delay_minutes,' minutes')::interval > (cycle.last_tick - cycle.started_at)
 					) and
-					concat(rule.delay_minutes,' minutes')::interval <= (now() - cycle.started_at)
+					concat(rule.delay_minutes,' minutes')::interval <= (now() - cycle.started_at) and
+					(rule.filter_min_severity isnull or a.severity <= rule.filter_min_severity) and
+					(rule.filter_service_id isnull or rule.filter_service_id = a.service_id) and
+					(
+						rule.filter_label_key isnull or
+						exists (
+							select 1
+							from labels l
+							where
+								l.tgt_service_id = a.service_id and
+								l.key = rule.filter_label_key and
+								l.value = rule.filter_label_value
+						)
+					)
+				join user_contact_methods cm on
+					cm.id = rule.contact_method_id and
+					(
+						cm.type != 'VOICE' or
+						$1::enum_alert_severity isnull or
+						a.severity <= $1::enum_alert_severity
+					)
 				returning cycle_id
 			), no_first_notif_sent as (
 				select user_id, alert_id
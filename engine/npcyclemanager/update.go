@@ -2,9 +2,11 @@ package npcyclemanager
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/pkg/errors"
 	"github.com/target/goalert/alert/alertlog"
+	"github.com/target/goalert/config"
 	"github.com/target/goalert/permission"
 	"github.com/target/goalert/util/log"
 	"github.com/target/goalert/util/sqlutil"
@@ -35,7 +37,13 @@ func (db *DB) update(ctx context.Context, all bool, alertID *int) error {
 	}
 	defer sqlutil.Rollback(ctx, "np cycle manager", tx)
 
-	rows, err := tx.StmtContext(ctx, db.queueMessages).QueryContext(ctx)
+	cfg := config.FromContext(ctx)
+	voiceMinSev := sql.NullString{
+		String: cfg.Twilio.VoiceMinSeverity,
+		Valid:  cfg.Twilio.VoiceMinSeverity != "",
+	}
+
+	rows, err := tx.StmtContext(ctx, db.queueMessages).QueryContext(ctx, voiceMinSev)
 	if merr := sqlutil.MapError(err); merr != nil && merr.Code == "23503" && merr.ConstraintName == "outgoing_messages_contact_method_id_fkey" {
 		// This can happen if a contact method is deleted after the notification policy cycle was created, but before the notification was sent.
 		// Log a debug message, but otherwise ignore it, we'll try again on the next cycle.
@@ -5,16 +5,18 @@ type Type string
 
 // Recognized types
 const (
-	TypeEscalation   Type = "escalation"
-	TypeHeartbeat    Type = "heartbeat"
-	TypeNPCycle      Type = "np_cycle"
-	TypeRotation     Type = "rotation"
-	TypeSchedule     Type = "schedule"
-	TypeStatusUpdate Type = "status_update"
-	TypeVerify       Type = "verify"
-	TypeMessage      Type = "message"
-	TypeCleanup      Type = "cleanup"
-	TypeMetrics      Type = "metrics"
-	TypeCompat       Type = "compat"
-	TypeSignals      Type = "signals"
+	TypeEscalation    Type = "escalation"
+	TypeHeartbeat     Type = "heartbeat"
+	TypeNPCycle       Type = "np_cycle"
+	TypeRotation      Type = "rotation"
+	TypeSchedule      Type = "schedule"
+	TypeStatusUpdate  Type = "status_update"
+	TypeVerify        Type = "verify"
+	TypeMessage       Type = "message"
+	TypeCleanup       Type = "cleanup"
+	TypeMetrics       Type = "metrics"
+	TypeCompat        Type = "compat"
+	TypeSignals       Type = "signals"
+	TypeAutoClose     Type = "auto_close"
+	TypeUserGroupSync Type = "usergroup_sync"
 )
@@ -7,11 +7,13 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	"github.com/target/goalert/alert"
 	"github.com/target/goalert/alert/alertlog"
 	"github.com/target/goalert/engine/message"
 	"github.com/target/goalert/gadb"
 	"github.com/target/goalert/notification"
 	"github.com/target/goalert/permission"
+	"github.com/target/goalert/service/msgtemplate"
 	"github.com/target/goalert/util/log"
 )
 
@@ -76,7 +78,7 @@ func (p *Engine) sendMessage(ctx context.Context, msg *message.Message) (*notifi
 		if err != nil {
 			return nil, errors.Wrap(err, "lookup alert metadata")
 		}
-		notifMsg = notification.Alert{
+		alertMsg := notification.Alert{
 			Base:        msg.Base(),
 			AlertID:     msg.AlertID,
 			Summary:     a.Summary,
@@ -87,6 +89,11 @@ func (p *Engine) sendMessage(ctx context.Context, msg *message.Message) (*notifi
 
 			OriginalStatus: stat,
 		}
+		err = p.applyMsgTemplate(ctx, &alertMsg, msg.Dest.Type)
+		if err != nil {
+			return nil, errors.Wrap(err, "lookup message template")
+		}
+		notifMsg = alertMsg
 		isFirstAlertMessage = stat == nil
 	case notification.MessageTypeAlertStatus:
 		e, err := p.cfg.AlertLogStore.FindOne(ctx, msg.AlertLogID)
@@ -115,12 +122,22 @@ func (p *Engine) sendMessage(ctx context.Context, msg *message.Message) (*notifi
 
 		var status notification.AlertState
 		switch e.Type() {
-		case alertlog.TypeAcknowledged:
+		case alertlog.TypeAcknowledged, alertlog.TypeSnoozed:
 			status = notification.AlertStateAcknowledged
-		case alertlog.TypeEscalated:
+		case alertlog.TypeEscalated, alertlog.TypeSnoozeExpired:
 			status = notification.AlertStateUnacknowledged
 		case alertlog.TypeClosed:
 			status = notification.AlertStateClosed
+		case alertlog.TypeNote:
+			// notes don't change the alert state, so report the current one
+			switch a.Status {
+			case alert.StatusTriggered:
+				status = notification.AlertStateUnacknowledged
+			case alert.StatusActive:
+				status = notification.AlertStateAcknowledged
+			case alert.StatusClosed:
+				status = notification.AlertStateClosed
+			}
 		}
 
 		notifMsg = notification.AlertStatus{
@@ -228,3 +245,37 @@ func (p *Engine) sendMessage(ctx context.Context, msg *message.Message) (*notifi
 
 	return res, nil
 }
+
+// applyMsgTemplate will render the service's message template (if any) for the destination type
+// into the alert summary and details. A template that fails to render is logged and the original
+// alert content is sent instead, so that a bad template never prevents a notification.
+func (p *Engine) applyMsgTemplate(ctx context.Context, a *notification.Alert, destType string) error {
+	if p.cfg.MsgTemplateStore == nil {
+		return nil
+	}
+
+	t, err := p.cfg.MsgTemplateStore.FindForDest(ctx, a.ServiceID, destType)
+	if err != nil {
+		return err
+	}
+	if t == nil {
+		return nil
+	}
+
+	r, err := t.Render(msgtemplate.Data{
+		AlertID:     a.AlertID,
+		Summary:     a.Summary,
+		Details:     a.Details,
+		ServiceID:   a.ServiceID,
+		ServiceName: a.ServiceName,
+		Meta:        a.Meta,
+	})
+	if err != nil {
+		log.Log(ctx, errors.Wrapf(err, "render message template for service %s (dest type '%s')", a.ServiceID, t.DestType))
+		return nil
+	}
+
+	a.Summary = r.Summary
+	a.Details = r.Details
+	return nil
+}
@@ -28,32 +28,32 @@ func (db *DB) update(ctx context.Context, tx *sql.Tx, id int64) error {
 		return nil
 	}
 
-	var eventType gadb.EnumAlertLogEvent
+	var eventTypes []gadb.EnumAlertLogEvent
 	switch sub.Status {
 	case gadb.EnumAlertStatusTriggered:
-		eventType = gadb.EnumAlertLogEventEscalated
+		eventTypes = []gadb.EnumAlertLogEvent{gadb.EnumAlertLogEventEscalated, gadb.EnumAlertLogEventSnoozeExpired}
 	case gadb.EnumAlertStatusActive:
-		eventType = gadb.EnumAlertLogEventAcknowledged
+		eventTypes = []gadb.EnumAlertLogEvent{gadb.EnumAlertLogEventAcknowledged, gadb.EnumAlertLogEventSnoozed}
 	case gadb.EnumAlertStatusClosed:
-		eventType = gadb.EnumAlertLogEventClosed
+		eventTypes = []gadb.EnumAlertLogEvent{gadb.EnumAlertLogEventClosed}
 	}
 
 	entry, err := q.StatusMgrLogEntry(ctx, gadb.StatusMgrLogEntryParams{
-		AlertID:   sub.AlertID,
-		EventType: eventType,
+		AlertID:    sub.AlertID,
+		EventTypes: eventTypes,
 	})
 	if errors.Is(err, sql.ErrNoRows) {
 		// no log entry, ignore
 		err = nil
 	}
 	if err != nil {
-		return fmt.Errorf("lookup latest log entry of '%s' for alert #%d: %w", eventType, sub.AlertID, err)
+		return fmt.Errorf("lookup latest log entry of '%v' for alert #%d: %w", eventTypes, sub.AlertID, err)
 	}
 
 	switch {
 	case entry.ID == 0:
 		// no log entry, log error but continue
-		log.Log(ctx, fmt.Errorf("no log entry found for alert #%d status update (%s), skipping", sub.AlertID, eventType))
+		log.Log(ctx, fmt.Errorf("no log entry found for alert #%d status update (%s), skipping", sub.AlertID, sub.Status))
 	case sub.ContactMethodID.Valid:
 		info, err := q.ContactMethodFineOne(ctx, sub.ContactMethodID.UUID)
 		if errors.Is(err, sql.ErrNoRows) || info.Disabled {
@@ -0,0 +1,37 @@
+package usergroupmanager
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/target/goalert/engine/processinglock"
+	"github.com/target/goalert/notification/slack"
+)
+
+// DB keeps Slack user groups in sync with the on-call users of rotations and escalation policy steps.
+type DB struct {
+	db   *sql.DB
+	lock *processinglock.Lock
+
+	cs *slack.ChannelSender
+}
+
+// Name returns the name of the module.
+func (db *DB) Name() string { return "Engine.UserGroupManager" }
+
+// NewDB creates a new DB.
+func NewDB(ctx context.Context, db *sql.DB, cs *slack.ChannelSender) (*DB, error) {
+	lock, err := processinglock.NewLock(ctx, db, processinglock.Config{
+		Version: 1,
+		Type:    processinglock.TypeUserGroupSync,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &DB{
+		db:   db,
+		lock: lock,
+		cs:   cs,
+	}, nil
+}
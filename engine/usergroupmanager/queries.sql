@@ -0,0 +1,62 @@
+-- name: UGMgrFindAll :many
+-- Returns all Slack user group syncs along with the users that should currently be members.
+SELECT
+    s.id,
+    s.usergroup_id,
+    s.members,
+    s.last_sync_at,
+    ARRAY (
+        SELECT
+            rp.user_id
+        FROM
+            rotation_state rs
+            JOIN rotation_participants rp ON rp.id = rs.rotation_participant_id
+        WHERE
+            rs.rotation_id = s.rotation_id
+        UNION
+        SELECT
+            oc.user_id
+        FROM
+            ep_step_on_call_users oc
+        WHERE
+            oc.ep_step_id = s.ep_step_id
+            AND oc.end_time IS NULL)::uuid[] AS user_ids
+FROM
+    slack_usergroup_syncs s
+ORDER BY
+    s.id;
+
+-- name: UGMgrUpdate :exec
+-- Records the result of a sync. Drift details are only updated when drift_detected is true.
+UPDATE
+    slack_usergroup_syncs
+SET
+    members = @members,
+    last_sync_at = now(),
+    last_error = @last_error,
+    drift_detected_at = CASE WHEN @drift_detected::boolean THEN
+        now()
+    ELSE
+        drift_detected_at
+    END,
+    drift_details = CASE WHEN @drift_detected::boolean THEN
+        @drift_details
+    ELSE
+        drift_details
+    END
+WHERE
+    id = @id;
+
+-- name: UGMgrSlackUserIDs :many
+-- Returns the Slack user ID for each of the given users that have linked their Slack account.
+SELECT DISTINCT ON (user_id)
+    user_id,
+    subject_id
+FROM
+    auth_subjects
+WHERE
+    provider_id = @provider_id
+    AND user_id = ANY (@user_ids::uuid[])
+ORDER BY
+    user_id,
+    id;
@@ -0,0 +1,44 @@
+package usergroupmanager
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/riverqueue/river"
+	"github.com/target/goalert/engine/processinglock"
+)
+
+var _ processinglock.Setupable = &DB{}
+
+const QueueName = "usergroup-manager"
+
+// Setup implements processinglock.Setupable.
+func (db *DB) Setup(ctx context.Context, args processinglock.SetupArgs) error {
+	river.AddWorker(args.Workers, river.WorkFunc(db.SyncAll))
+
+	// Slack rate limits apply, so a single worker is used.
+	err := args.River.Queues().Add(QueueName, river.QueueConfig{MaxWorkers: 1})
+	if err != nil {
+		return fmt.Errorf("add queue: %w", err)
+	}
+
+	args.River.PeriodicJobs().AddMany([]*river.PeriodicJob{
+		river.NewPeriodicJob(
+			river.PeriodicInterval(time.Minute),
+			func() (river.JobArgs, *river.InsertOpts) {
+				return SyncArgs{}, &river.InsertOpts{
+					Queue: QueueName,
+					// completed jobs count toward uniqueness, so limit it to the interval
+					UniqueOpts: river.UniqueOpts{
+						ByArgs:   true,
+						ByPeriod: time.Minute,
+					},
+				}
+			},
+			&river.PeriodicJobOpts{RunOnStart: true},
+		),
+	})
+
+	return nil
+}
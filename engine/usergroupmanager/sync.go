@@ -0,0 +1,140 @@
+package usergroupmanager
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+
+	"github.com/riverqueue/river"
+	"github.com/target/goalert/config"
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/util/log"
+)
+
+type SyncArgs struct{}
+
+func (SyncArgs) Kind() string { return "usergroup-manager-sync" }
+
+// SyncAll will re-assert the membership of all synced Slack user groups, recording
+// drift if a group was changed outside of GoAlert since the last sync.
+func (db *DB) SyncAll(ctx context.Context, j *river.Job[SyncArgs]) error {
+	cfg := config.FromContext(ctx)
+	if !cfg.Slack.Enable {
+		return nil
+	}
+
+	var rows []gadb.UGMgrFindAllRow
+	err := db.lock.WithTxShared(ctx, func(ctx context.Context, tx *sql.Tx) (err error) {
+		rows, err = gadb.New(tx).UGMgrFindAll(ctx)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("find syncs: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+
+	teamID, err := db.cs.TeamID(ctx)
+	if err != nil {
+		return fmt.Errorf("lookup team ID: %w", err)
+	}
+
+	for _, row := range rows {
+		res, err := db.sync(ctx, "slack:"+teamID, row)
+		if err != nil {
+			return fmt.Errorf("sync user group '%s': %w", row.UsergroupID, err)
+		}
+
+		err = db.lock.WithTxShared(ctx, func(ctx context.Context, tx *sql.Tx) error {
+			return gadb.New(tx).UGMgrUpdate(ctx, *res)
+		})
+		if err != nil {
+			return fmt.Errorf("update sync '%s': %w", row.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// sync will update a single user group, returning the result to be recorded.
+//
+// Slack errors are recorded on the sync (and surfaced as notices) rather than returned.
+func (db *DB) sync(ctx context.Context, providerID string, row gadb.UGMgrFindAllRow) (*gadb.UGMgrUpdateParams, error) {
+	res := &gadb.UGMgrUpdateParams{
+		ID:      row.ID,
+		Members: row.Members,
+	}
+
+	subs, err := gadb.New(db.db).UGMgrSlackUserIDs(ctx, gadb.UGMgrSlackUserIDsParams{
+		ProviderID: providerID,
+		UserIds:    row.UserIds,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("lookup Slack user IDs: %w", err)
+	}
+	desired := make([]string, 0, len(subs))
+	for _, s := range subs {
+		desired = append(desired, s.SubjectID)
+	}
+
+	current, err := db.cs.UserGroupMembers(ctx, row.UsergroupID)
+	if err != nil {
+		log.Log(ctx, err)
+		res.LastError = "Failed to read user group members from Slack."
+		return res, nil
+	}
+	res.Members = current
+
+	if row.LastSyncAt.Valid {
+		added, removed := diffMembers(row.Members, current)
+		if added > 0 || removed > 0 {
+			res.DriftDetected = true
+			res.DriftDetails = fmt.Sprintf("%d member(s) added and %d removed outside of GoAlert.", added, removed)
+		}
+	}
+
+	switch {
+	case len(row.UserIds) == 0:
+		res.LastError = "No users are on-call; Slack does not allow user groups to be empty."
+		return res, nil
+	case len(desired) == 0:
+		res.LastError = "No on-call users have linked their Slack account."
+		return res, nil
+	case len(subs) < len(row.UserIds):
+		// still sync the linked users, but report the rest
+		res.LastError = fmt.Sprintf("%d on-call user(s) have not linked their Slack account.", len(row.UserIds)-len(subs))
+	}
+
+	added, removed := diffMembers(current, desired)
+	if added == 0 && removed == 0 {
+		return res, nil
+	}
+
+	err = db.cs.SetUserGroupMembers(ctx, row.UsergroupID, desired)
+	if err != nil {
+		log.Log(ctx, err)
+		res.LastError = "Failed to update user group members in Slack."
+		return res, nil
+	}
+	res.Members = desired
+
+	return res, nil
+}
+
+// diffMembers returns the number of members added and removed going from one set of members to another.
+func diffMembers(from, to []string) (added, removed int) {
+	for _, id := range to {
+		if !slices.Contains(from, id) {
+			added++
+		}
+	}
+	for _, id := range from {
+		if !slices.Contains(to, id) {
+			removed++
+		}
+	}
+
+	return added, removed
+}
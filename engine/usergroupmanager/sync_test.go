@@ -0,0 +1,22 @@
+package usergroupmanager
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffMembers(t *testing.T) {
+	check := func(from, to []string, expAdded, expRemoved int) {
+		t.Helper()
+		added, removed := diffMembers(from, to)
+		assert.Equal(t, expAdded, added, "added")
+		assert.Equal(t, expRemoved, removed, "removed")
+	}
+
+	check(nil, nil, 0, 0)
+	check([]string{"U1", "U2"}, []string{"U2", "U1"}, 0, 0)
+	check([]string{"U1"}, []string{"U1", "U2"}, 1, 0)
+	check([]string{"U1", "U2"}, []string{"U2"}, 0, 1)
+	check([]string{"U1"}, []string{"U2", "U3"}, 2, 1)
+}
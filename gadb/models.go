@@ -20,18 +20,20 @@ import (
 type EngineProcessingType string
 
 const (
-	EngineProcessingTypeCleanup      EngineProcessingType = "cleanup"
-	EngineProcessingTypeCompat       EngineProcessingType = "compat"
-	EngineProcessingTypeEscalation   EngineProcessingType = "escalation"
-	EngineProcessingTypeHeartbeat    EngineProcessingType = "heartbeat"
-	EngineProcessingTypeMessage      EngineProcessingType = "message"
-	EngineProcessingTypeMetrics      EngineProcessingType = "metrics"
-	EngineProcessingTypeNpCycle      EngineProcessingType = "np_cycle"
-	EngineProcessingTypeRotation     EngineProcessingType = "rotation"
-	EngineProcessingTypeSchedule     EngineProcessingType = "schedule"
-	EngineProcessingTypeSignals      EngineProcessingType = "signals"
-	EngineProcessingTypeStatusUpdate EngineProcessingType = "status_update"
-	EngineProcessingTypeVerify       EngineProcessingType = "verify"
+	EngineProcessingTypeAutoClose     EngineProcessingType = "auto_close"
+	EngineProcessingTypeCleanup       EngineProcessingType = "cleanup"
+	EngineProcessingTypeCompat        EngineProcessingType = "compat"
+	EngineProcessingTypeEscalation    EngineProcessingType = "escalation"
+	EngineProcessingTypeHeartbeat     EngineProcessingType = "heartbeat"
+	EngineProcessingTypeMessage       EngineProcessingType = "message"
+	EngineProcessingTypeMetrics       EngineProcessingType = "metrics"
+	EngineProcessingTypeNpCycle       EngineProcessingType = "np_cycle"
+	EngineProcessingTypeRotation      EngineProcessingType = "rotation"
+	EngineProcessingTypeSchedule      EngineProcessingType = "schedule"
+	EngineProcessingTypeSignals       EngineProcessingType = "signals"
+	EngineProcessingTypeStatusUpdate  EngineProcessingType = "status_update"
+	EngineProcessingTypeUsergroupSync EngineProcessingType = "usergroup_sync"
+	EngineProcessingTypeVerify        EngineProcessingType = "verify"
 )
 
 func (e *EngineProcessingType) Scan(src interface{}) error {
@@ -80,10 +82,13 @@ const (
 	EnumAlertLogEventEscalated           EnumAlertLogEvent = "escalated"
 	EnumAlertLogEventEscalationRequest   EnumAlertLogEvent = "escalation_request"
 	EnumAlertLogEventNoNotificationSent  EnumAlertLogEvent = "no_notification_sent"
+	EnumAlertLogEventNote                EnumAlertLogEvent = "note"
 	EnumAlertLogEventNotificationSent    EnumAlertLogEvent = "notification_sent"
 	EnumAlertLogEventPolicyUpdated       EnumAlertLogEvent = "policy_updated"
 	EnumAlertLogEventReopened            EnumAlertLogEvent = "reopened"
 	EnumAlertLogEventResponseReceived    EnumAlertLogEvent = "response_received"
+	EnumAlertLogEventSnoozeExpired       EnumAlertLogEvent = "snooze_expired"
+	EnumAlertLogEventSnoozed             EnumAlertLogEvent = "snoozed"
 	EnumAlertLogEventStatusChanged       EnumAlertLogEvent = "status_changed"
 )
 
@@ -166,6 +171,50 @@ func (ns NullEnumAlertLogSubjectType) Value() (driver.Value, error) {
 	return string(ns.EnumAlertLogSubjectType), nil
 }
 
+type EnumAlertSeverity string
+
+const (
+	EnumAlertSeverityCritical EnumAlertSeverity = "critical"
+	EnumAlertSeverityHigh     EnumAlertSeverity = "high"
+	EnumAlertSeverityInfo     EnumAlertSeverity = "info"
+	EnumAlertSeverityLow      EnumAlertSeverity = "low"
+)
+
+func (e *EnumAlertSeverity) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = EnumAlertSeverity(s)
+	case string:
+		*e = EnumAlertSeverity(s)
+	default:
+		return fmt.Errorf("unsupported scan type for EnumAlertSeverity: %T", src)
+	}
+	return nil
+}
+
+type NullEnumAlertSeverity struct {
+	EnumAlertSeverity EnumAlertSeverity
+	Valid             bool // Valid is true if EnumAlertSeverity is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullEnumAlertSeverity) Scan(value interface{}) error {
+	if value == nil {
+		ns.EnumAlertSeverity, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.EnumAlertSeverity.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullEnumAlertSeverity) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.EnumAlertSeverity), nil
+}
+
 type EnumAlertSource string
 
 const (
@@ -173,6 +222,7 @@ const (
 	EnumAlertSourceGeneric                EnumAlertSource = "generic"
 	EnumAlertSourceGrafana                EnumAlertSource = "grafana"
 	EnumAlertSourceManual                 EnumAlertSource = "manual"
+	EnumAlertSourcePagerduty              EnumAlertSource = "pagerduty"
 	EnumAlertSourcePrometheusAlertmanager EnumAlertSource = "prometheusAlertmanager"
 	EnumAlertSourceSite24x7               EnumAlertSource = "site24x7"
 	EnumAlertSourceUniversal              EnumAlertSource = "universal"
@@ -305,6 +355,8 @@ const (
 	EnumIntegrationKeysTypeEmail                  EnumIntegrationKeysType = "email"
 	EnumIntegrationKeysTypeGeneric                EnumIntegrationKeysType = "generic"
 	EnumIntegrationKeysTypeGrafana                EnumIntegrationKeysType = "grafana"
+	EnumIntegrationKeysTypeOtlp                   EnumIntegrationKeysType = "otlp"
+	EnumIntegrationKeysTypePagerduty              EnumIntegrationKeysType = "pagerduty"
 	EnumIntegrationKeysTypePrometheusAlertmanager EnumIntegrationKeysType = "prometheusAlertmanager"
 	EnumIntegrationKeysTypeSite24x7               EnumIntegrationKeysType = "site24x7"
 	EnumIntegrationKeysTypeUniversal              EnumIntegrationKeysType = "universal"
@@ -815,11 +867,18 @@ type Alert struct {
 	LastEscalation  sql.NullTime
 	LastProcessed   sql.NullTime
 	ServiceID       uuid.NullUUID
+	Severity        EnumAlertSeverity
 	Source          EnumAlertSource
 	Status          EnumAlertStatus
 	Summary         string
 }
 
+type AlertAutoClose struct {
+	AlertID          int64
+	IntegrationKeyID uuid.UUID
+	LastSeen         time.Time
+}
+
 type AlertDatum struct {
 	AlertID  int64
 	ID       int64
@@ -832,6 +891,14 @@ type AlertFeedback struct {
 	NoiseReason string
 }
 
+type AlertGroup struct {
+	AlertID          int64
+	Count            int32
+	GroupKey         string
+	IntegrationKeyID uuid.UUID
+	LastSeen         time.Time
+}
+
 type AlertLog struct {
 	AlertID             sql.NullInt64
 	Event               EnumAlertLogEvent
@@ -857,6 +924,12 @@ type AlertMetric struct {
 	TimeToClose sql.NullInt64
 }
 
+type AlertSnooze struct {
+	AlertID      int64
+	CreatedAt    time.Time
+	SnoozedUntil time.Time
+}
+
 type AlertStatusSubscription struct {
 	AlertID         int64
 	ChannelID       uuid.NullUUID
@@ -1017,7 +1090,31 @@ type HeartbeatMonitor struct {
 	ServiceID         uuid.UUID
 }
 
+type Incident struct {
+	ClosedAt    sql.NullTime
+	CreatedAt   time.Time
+	Description string
+	ID          int64
+	Status      EnumAlertStatus
+	Title       string
+}
+
+type IncidentAlert struct {
+	AlertID    int64
+	CreatedAt  time.Time
+	IncidentID int64
+}
+
+type IncidentUpdate struct {
+	CreatedAt  time.Time
+	ID         int64
+	IncidentID int64
+	Message    string
+	UserID     uuid.NullUUID
+}
+
 type IntegrationKey struct {
+	AutoCloseMinutes   sql.NullInt32
 	ExternalSystemName sql.NullString
 	ID                 uuid.UUID
 	Name               string
@@ -1255,6 +1352,7 @@ type ScheduleRule struct {
 }
 
 type Service struct {
+	ConferenceNumber     sql.NullString
 	Description          string
 	EscalationPolicyID   uuid.UUID
 	ID                   uuid.UUID
@@ -1262,6 +1360,27 @@ type Service struct {
 	Name                 string
 }
 
+type ServiceMessageTemplate struct {
+	DestType        string
+	DetailsTemplate string
+	ServiceID       uuid.UUID
+	SummaryTemplate string
+	UpdatedAt       time.Time
+}
+
+type SlackUsergroupSync struct {
+	CreatedAt       time.Time
+	DriftDetectedAt sql.NullTime
+	DriftDetails    string
+	EpStepID        uuid.NullUUID
+	ID              uuid.UUID
+	LastError       string
+	LastSyncAt      sql.NullTime
+	Members         []string
+	RotationID      uuid.NullUUID
+	UsergroupID     string
+}
+
 type SwitchoverLog struct {
 	Data      json.RawMessage
 	ID        int64
@@ -1305,10 +1424,21 @@ type UikConfig struct {
 	ID                 uuid.UUID
 	PrimaryToken       uuid.NullUUID
 	PrimaryTokenHint   sql.NullString
+	Revision           int64
 	SecondaryToken     uuid.NullUUID
 	SecondaryTokenHint sql.NullString
 }
 
+type UikSample struct {
+	Body       json.RawMessage
+	ID         uuid.UUID
+	KeyID      uuid.UUID
+	Query      string
+	ReceivedAt time.Time
+	RemoteAddr string
+	UserAgent  string
+}
+
 type User struct {
 	AlertStatusLogContactMethodID uuid.NullUUID
 	AvatarUrl                     string
@@ -1332,6 +1462,7 @@ type UserCalendarSubscription struct {
 }
 
 type UserContactMethod struct {
+	ActiveWindow        pqtype.NullRawMessage
 	Dest                NullDestV1
 	Disabled            bool
 	EnableStatusUpdates bool
@@ -1339,6 +1470,7 @@ type UserContactMethod struct {
 	LastTestVerifyAt    sql.NullTime
 	Metadata            pqtype.NullRawMessage
 	Name                string
+	PausedUntil         sql.NullTime
 	Pending             bool
 	Type                EnumUserContactMethodType
 	UserID              uuid.UUID
@@ -1356,11 +1488,15 @@ type UserFavorite struct {
 }
 
 type UserNotificationRule struct {
-	ContactMethodID uuid.UUID
-	CreatedAt       sql.NullTime
-	DelayMinutes    int32
-	ID              uuid.UUID
-	UserID          uuid.UUID
+	ContactMethodID   uuid.UUID
+	CreatedAt         sql.NullTime
+	DelayMinutes      int32
+	FilterLabelKey    sql.NullString
+	FilterLabelValue  sql.NullString
+	FilterMinSeverity NullEnumAlertSeverity
+	FilterServiceID   uuid.NullUUID
+	ID                uuid.UUID
+	UserID            uuid.UUID
 }
 
 type UserOverride struct {
@@ -1384,3 +1520,9 @@ type UserVerificationCode struct {
 	ID              uuid.UUID
 	Sent            bool
 }
+
+type WebhookSigningSecret struct {
+	CreatedAt  time.Time
+	Secret     []byte
+	WebhookUrl string
+}
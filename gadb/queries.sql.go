@@ -395,6 +395,45 @@ func (q *Queries) AlertLog_InsertMany(ctx context.Context, arg AlertLog_InsertMa
 	return err
 }
 
+const alertLog_InsertOne = `-- name: AlertLog_InsertOne :one
+INSERT INTO alert_logs(alert_id, event, sub_type, sub_user_id, sub_integration_key_id, sub_hb_monitor_id, sub_channel_id, sub_classifier, meta, message)
+    VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+RETURNING
+    id
+`
+
+type AlertLog_InsertOneParams struct {
+	AlertID             sql.NullInt64
+	Event               EnumAlertLogEvent
+	SubType             NullEnumAlertLogSubjectType
+	SubUserID           uuid.NullUUID
+	SubIntegrationKeyID uuid.NullUUID
+	SubHbMonitorID      uuid.NullUUID
+	SubChannelID        uuid.NullUUID
+	SubClassifier       string
+	Meta                pqtype.NullRawMessage
+	Message             string
+}
+
+// Inserts a single alert log, returning its ID.
+func (q *Queries) AlertLog_InsertOne(ctx context.Context, arg AlertLog_InsertOneParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, alertLog_InsertOne,
+		arg.AlertID,
+		arg.Event,
+		arg.SubType,
+		arg.SubUserID,
+		arg.SubIntegrationKeyID,
+		arg.SubHbMonitorID,
+		arg.SubChannelID,
+		arg.SubClassifier,
+		arg.Meta,
+		arg.Message,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
 const alertLog_InsertSvc = `-- name: AlertLog_InsertSvc :exec
 INSERT INTO alert_logs(alert_id, event, sub_type, sub_user_id, sub_integration_key_id, sub_hb_monitor_id, sub_channel_id, sub_classifier, meta, message)
 SELECT
@@ -519,6 +558,82 @@ func (q *Queries) Alert_AlertHasEPState(ctx context.Context, alertID int64) (boo
 	return has_ep_state, err
 }
 
+const alert_ClearManySnoozes = `-- name: Alert_ClearManySnoozes :exec
+DELETE FROM alert_snoozes
+WHERE alert_id = ANY ($1::bigint[])
+`
+
+// Removes any pending snoozes for the given alerts.
+func (q *Queries) Alert_ClearManySnoozes(ctx context.Context, alertIds []int64) error {
+	_, err := q.db.ExecContext(ctx, alert_ClearManySnoozes, pq.Array(alertIds))
+	return err
+}
+
+const alert_ClearSnooze = `-- name: Alert_ClearSnooze :exec
+DELETE FROM alert_snoozes
+WHERE alert_id = $1::bigint
+`
+
+// Removes any pending snooze for the alert.
+func (q *Queries) Alert_ClearSnooze(ctx context.Context, alertID int64) error {
+	_, err := q.db.ExecContext(ctx, alert_ClearSnooze, alertID)
+	return err
+}
+
+const alert_DeleteEPState = `-- name: Alert_DeleteEPState :exec
+DELETE FROM escalation_policy_state
+WHERE alert_id = $1::bigint
+`
+
+// Removes the escalation policy state of the alert.
+func (q *Queries) Alert_DeleteEPState(ctx context.Context, alertID int64) error {
+	_, err := q.db.ExecContext(ctx, alert_DeleteEPState, alertID)
+	return err
+}
+
+const alert_DeleteNotificationCycles = `-- name: Alert_DeleteNotificationCycles :exec
+DELETE FROM notification_policy_cycles
+WHERE alert_id = $1::int
+`
+
+// Removes the notification policy cycles of the alert, so users notified by the previous escalation policy stop being paged.
+func (q *Queries) Alert_DeleteNotificationCycles(ctx context.Context, alertID int32) error {
+	_, err := q.db.ExecContext(ctx, alert_DeleteNotificationCycles, alertID)
+	return err
+}
+
+const alert_FindGroupAlert = `-- name: Alert_FindGroupAlert :one
+SELECT
+    g.alert_id
+FROM
+    alert_groups g
+    JOIN alerts a ON a.id = g.alert_id
+        AND a.status != 'closed'
+WHERE
+    g.integration_key_id = $1
+    AND g.group_key = $2
+    AND g.last_seen > now() - $3::interval
+ORDER BY
+    g.alert_id DESC
+LIMIT 1
+FOR UPDATE
+    OF g
+`
+
+type Alert_FindGroupAlertParams struct {
+	IntegrationKeyID uuid.UUID
+	GroupKey         string
+	GroupWindow      sqlutil.Interval
+}
+
+// Returns the open alert for the group, if it was last seen within the window.
+func (q *Queries) Alert_FindGroupAlert(ctx context.Context, arg Alert_FindGroupAlertParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, alert_FindGroupAlert, arg.IntegrationKeyID, arg.GroupKey, arg.GroupWindow)
+	var alert_id int64
+	err := row.Scan(&alert_id)
+	return alert_id, err
+}
+
 const alert_GetAlertFeedback = `-- name: Alert_GetAlertFeedback :many
 SELECT
     alert_id,
@@ -651,6 +766,54 @@ func (q *Queries) Alert_GetStatusAndLockService(ctx context.Context, id int64) (
 	return status, err
 }
 
+const alert_InsertEPState = `-- name: Alert_InsertEPState :exec
+INSERT INTO escalation_policy_state(alert_id, service_id, escalation_policy_id)
+SELECT
+    a.id,
+    a.service_id,
+    svc.escalation_policy_id
+FROM
+    alerts a
+    JOIN services svc ON svc.id = a.service_id
+    JOIN escalation_policies ep ON ep.id = svc.escalation_policy_id
+        AND ep.step_count > 0
+WHERE
+    a.id = $1::bigint
+`
+
+// Creates a fresh escalation policy state for the alert's current service, if its policy has steps.
+func (q *Queries) Alert_InsertEPState(ctx context.Context, alertID int64) error {
+	_, err := q.db.ExecContext(ctx, alert_InsertEPState, alertID)
+	return err
+}
+
+const alert_LockForReassign = `-- name: Alert_LockForReassign :one
+SELECT
+    a.status,
+    svc.id AS service_id,
+    svc.name AS service_name
+FROM
+    alerts a
+    JOIN services svc ON svc.id = a.service_id
+WHERE
+    a.id = $1::bigint
+FOR UPDATE
+`
+
+type Alert_LockForReassignRow struct {
+	Status      EnumAlertStatus
+	ServiceID   uuid.UUID
+	ServiceName string
+}
+
+// Locks the alert and its current service, returning the info needed to reassign it.
+func (q *Queries) Alert_LockForReassign(ctx context.Context, id int64) (Alert_LockForReassignRow, error) {
+	row := q.db.QueryRowContext(ctx, alert_LockForReassign, id)
+	var i Alert_LockForReassignRow
+	err := row.Scan(&i.Status, &i.ServiceID, &i.ServiceName)
+	return i, err
+}
+
 const alert_LockManyAlertServices = `-- name: Alert_LockManyAlertServices :exec
 SELECT
     1
@@ -709,6 +872,115 @@ func (q *Queries) Alert_LockService(ctx context.Context, serviceID uuid.UUID) er
 	return err
 }
 
+const alert_LockServiceName = `-- name: Alert_LockServiceName :one
+SELECT
+    name
+FROM
+    services
+WHERE
+    id = $1
+FOR UPDATE
+`
+
+// Locks the service and returns its name.
+func (q *Queries) Alert_LockServiceName(ctx context.Context, serviceID uuid.UUID) (string, error) {
+	row := q.db.QueryRowContext(ctx, alert_LockServiceName, serviceID)
+	var name string
+	err := row.Scan(&name)
+	return name, err
+}
+
+const alert_NotifyNoteSubscribers = `-- name: Alert_NotifyNoteSubscribers :execrows
+INSERT INTO outgoing_messages(message_type, contact_method_id, channel_id, user_id, alert_id, alert_log_id)
+SELECT
+    'alert_status_update',
+    sub.contact_method_id,
+    sub.channel_id,
+    cm.user_id,
+    sub.alert_id,
+    log.id
+FROM
+    alert_status_subscriptions sub
+    JOIN alerts a ON a.id = sub.alert_id
+        AND a.status != 'closed'
+    JOIN alert_logs log ON log.id = $1::bigint
+        AND log.alert_id = sub.alert_id
+        AND log.event = 'note'
+    LEFT JOIN user_contact_methods cm ON cm.id = sub.contact_method_id
+WHERE
+    sub.alert_id = $2::bigint
+    AND (sub.channel_id IS NOT NULL
+        OR (NOT cm.disabled
+            AND cm.user_id IS DISTINCT FROM log.sub_user_id))
+`
+
+type Alert_NotifyNoteSubscribersParams struct {
+	LogID   int64
+	AlertID int64
+}
+
+// Sends a status update for a note on an alert to all of its subscribers, except the note's author.
+func (q *Queries) Alert_NotifyNoteSubscribers(ctx context.Context, arg Alert_NotifyNoteSubscribersParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, alert_NotifyNoteSubscribers, arg.LogID, arg.AlertID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const alert_Reassign = `-- name: Alert_Reassign :exec
+UPDATE
+    alerts
+SET
+    service_id = $1,
+    status = 'triggered'
+WHERE
+    id = $2::bigint
+    AND status != 'closed'
+`
+
+type Alert_ReassignParams struct {
+	ServiceID uuid.NullUUID
+	ID        int64
+}
+
+// Moves an open alert to a new service, returning it to triggered.
+func (q *Queries) Alert_Reassign(ctx context.Context, arg Alert_ReassignParams) error {
+	_, err := q.db.ExecContext(ctx, alert_Reassign, arg.ServiceID, arg.ID)
+	return err
+}
+
+const alert_RecordGroupAlert = `-- name: Alert_RecordGroupAlert :one
+INSERT INTO alert_groups(alert_id, integration_key_id, group_key)
+    VALUES ($1, $2, $3)
+ON CONFLICT (alert_id)
+    DO UPDATE SET
+        count = alert_groups.count + 1, last_seen = now(), integration_key_id = excluded.integration_key_id, group_key = excluded.group_key
+    RETURNING
+        count, last_seen
+`
+
+type Alert_RecordGroupAlertParams struct {
+	AlertID          int64
+	IntegrationKeyID uuid.UUID
+	GroupKey         string
+}
+
+type Alert_RecordGroupAlertRow struct {
+	Count    int32
+	LastSeen time.Time
+}
+
+// Records an alert as part of a group, incrementing the count if it is already part of it.
+//
+// The group key is updated as well, so an alert matched by a changed grouping rule joins the new group.
+func (q *Queries) Alert_RecordGroupAlert(ctx context.Context, arg Alert_RecordGroupAlertParams) (Alert_RecordGroupAlertRow, error) {
+	row := q.db.QueryRowContext(ctx, alert_RecordGroupAlert, arg.AlertID, arg.IntegrationKeyID, arg.GroupKey)
+	var i Alert_RecordGroupAlertRow
+	err := row.Scan(&i.Count, &i.LastSeen)
+	return i, err
+}
+
 const alert_RequestAlertEscalationByTime = `-- name: Alert_RequestAlertEscalationByTime :one
 UPDATE
     escalation_policy_state
@@ -851,6 +1123,101 @@ func (q *Queries) Alert_SetManyAlertFeedback(ctx context.Context, arg Alert_SetM
 	return items, nil
 }
 
+const alert_SnoozeMany = `-- name: Alert_SnoozeMany :many
+WITH open_alerts AS (
+    SELECT
+        id,
+        status
+    FROM
+        alerts
+    WHERE
+        id = ANY ($1::bigint[])
+        AND status != 'closed'
+    FOR UPDATE
+),
+_ack AS (
+    UPDATE
+        alerts a
+    SET
+        status = 'active'
+    FROM
+        open_alerts
+    WHERE
+        a.id = open_alerts.id
+        AND open_alerts.status = 'triggered'
+),
+_snooze AS (
+    INSERT INTO alert_snoozes(alert_id, snoozed_until)
+    SELECT
+        id,
+        now() + make_interval(mins => $2::int)
+    FROM
+        open_alerts
+    ON CONFLICT (alert_id)
+        DO UPDATE SET
+            snoozed_until = excluded.snoozed_until,
+            created_at = now())
+SELECT
+    id
+FROM
+    open_alerts
+`
+
+type Alert_SnoozeManyParams struct {
+	AlertIds      []int64
+	SnoozeMinutes int32
+}
+
+// Acknowledges the specified open alerts and schedules them to return to triggered after the snooze duration.
+func (q *Queries) Alert_SnoozeMany(ctx context.Context, arg Alert_SnoozeManyParams) ([]int64, error) {
+	rows, err := q.db.QueryContext(ctx, alert_SnoozeMany, pq.Array(arg.AlertIds), arg.SnoozeMinutes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const alert_TouchAutoClose = `-- name: Alert_TouchAutoClose :exec
+INSERT INTO alert_auto_close(alert_id, integration_key_id)
+SELECT
+    $1,
+    id
+FROM
+    integration_keys
+WHERE
+    id = $2
+    AND auto_close_minutes IS NOT NULL
+ON CONFLICT (alert_id)
+    DO UPDATE SET
+        integration_key_id = excluded.integration_key_id, last_seen = now()
+`
+
+type Alert_TouchAutoCloseParams struct {
+	AlertID          int64
+	IntegrationKeyID uuid.UUID
+}
+
+// Records the last time an event was received for an alert from an integration key with auto-close enabled.
+func (q *Queries) Alert_TouchAutoClose(ctx context.Context, arg Alert_TouchAutoCloseParams) error {
+	_, err := q.db.ExecContext(ctx, alert_TouchAutoClose, arg.AlertID, arg.IntegrationKeyID)
+	return err
+}
+
 const allPendingMsgDests = `-- name: AllPendingMsgDests :many
 SELECT DISTINCT
   usr.name AS user_name,
@@ -988,6 +1355,77 @@ func (q *Queries) AuthLinkUseReq(ctx context.Context, id uuid.UUID) (AuthLinkUse
 	return i, err
 }
 
+const autoCloseMgrDeleteStale = `-- name: AutoCloseMgrDeleteStale :exec
+DELETE FROM alert_auto_close ac USING alerts a, integration_keys k
+WHERE a.id = ac.alert_id
+    AND k.id = ac.integration_key_id
+    AND (a.status = 'closed'
+        OR k.auto_close_minutes IS NULL)
+`
+
+// Removes tracking for alerts that are closed, or whose integration key no longer has auto-close enabled.
+func (q *Queries) AutoCloseMgrDeleteStale(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, autoCloseMgrDeleteStale)
+	return err
+}
+
+const autoCloseMgrFindSilent = `-- name: AutoCloseMgrFindSilent :many
+SELECT
+    ac.alert_id,
+    ac.integration_key_id,
+    k.name AS integration_key_name,
+    k.auto_close_minutes::int AS auto_close_minutes
+FROM
+    alert_auto_close ac
+    JOIN integration_keys k ON k.id = ac.integration_key_id
+        AND k.auto_close_minutes IS NOT NULL
+    JOIN alerts a ON a.id = ac.alert_id
+        AND a.status != 'closed'
+WHERE
+    ac.last_seen < now() - make_interval(mins => k.auto_close_minutes)
+ORDER BY
+    ac.alert_id
+LIMIT 100
+FOR UPDATE
+    OF ac SKIP LOCKED
+`
+
+type AutoCloseMgrFindSilentRow struct {
+	AlertID            int64
+	IntegrationKeyID   uuid.UUID
+	IntegrationKeyName string
+	AutoCloseMinutes   int32
+}
+
+// Returns open alerts that have not received an event within the auto-close duration of their integration key.
+func (q *Queries) AutoCloseMgrFindSilent(ctx context.Context) ([]AutoCloseMgrFindSilentRow, error) {
+	rows, err := q.db.QueryContext(ctx, autoCloseMgrFindSilent)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AutoCloseMgrFindSilentRow
+	for rows.Next() {
+		var i AutoCloseMgrFindSilentRow
+		if err := rows.Scan(
+			&i.AlertID,
+			&i.IntegrationKeyID,
+			&i.IntegrationKeyName,
+			&i.AutoCloseMinutes,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const calSubAuthUser = `-- name: CalSubAuthUser :one
 UPDATE
     user_calendar_subscriptions
@@ -1446,6 +1884,31 @@ func (q *Queries) CleanupMgrScheduleNeedsCleanup(ctx context.Context, cleanupInt
 	return items, nil
 }
 
+const cleanupMgrTrimUIKSamples = `-- name: CleanupMgrTrimUIKSamples :execrows
+DELETE FROM uik_samples
+WHERE id = ANY (
+        SELECT
+            id
+        FROM (
+            SELECT
+                id,
+                row_number() OVER (PARTITION BY key_id ORDER BY received_at DESC) AS n
+            FROM
+                uik_samples) s
+        WHERE
+            n > $1::int
+        LIMIT 100)
+`
+
+// CleanupMgrTrimUIKSamples will delete universal key request samples beyond the most recent keep_count for each key.
+func (q *Queries) CleanupMgrTrimUIKSamples(ctx context.Context, keepCount int32) (int64, error) {
+	result, err := q.db.ExecContext(ctx, cleanupMgrTrimUIKSamples, keepCount)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const cleanupMgrUpdateScheduleData = `-- name: CleanupMgrUpdateScheduleData :exec
 UPDATE
     schedule_data
@@ -1712,8 +2175,8 @@ func (q *Queries) ConnectionInfo(ctx context.Context) ([]ConnectionInfoRow, erro
 }
 
 const contactMethodAdd = `-- name: ContactMethodAdd :exec
-INSERT INTO user_contact_methods(id, name, dest, disabled, user_id, enable_status_updates)
-    VALUES ($1, $2, $3, $4, $5, $6)
+INSERT INTO user_contact_methods(id, name, dest, disabled, user_id, enable_status_updates, active_window)
+    VALUES ($1, $2, $3, $4, $5, $6, $7)
 `
 
 type ContactMethodAddParams struct {
@@ -1723,6 +2186,7 @@ type ContactMethodAddParams struct {
 	Disabled            bool
 	UserID              uuid.UUID
 	EnableStatusUpdates bool
+	ActiveWindow        pqtype.NullRawMessage
 }
 
 func (q *Queries) ContactMethodAdd(ctx context.Context, arg ContactMethodAddParams) error {
@@ -1733,6 +2197,7 @@ func (q *Queries) ContactMethodAdd(ctx context.Context, arg ContactMethodAddPara
 		arg.Disabled,
 		arg.UserID,
 		arg.EnableStatusUpdates,
+		arg.ActiveWindow,
 	)
 	return err
 }
@@ -1741,7 +2206,8 @@ const contactMethodEnableDisable = `-- name: ContactMethodEnableDisable :one
 UPDATE
     user_contact_methods
 SET
-    disabled = $2
+    disabled = $2,
+    paused_until = NULL
 WHERE
     dest = $1
 RETURNING
@@ -1762,7 +2228,7 @@ func (q *Queries) ContactMethodEnableDisable(ctx context.Context, arg ContactMet
 
 const contactMethodFindAll = `-- name: ContactMethodFindAll :many
 SELECT
-    dest, disabled, enable_status_updates, id, last_test_verify_at, metadata, name, pending, type, user_id, value
+    active_window, dest, disabled, enable_status_updates, id, last_test_verify_at, metadata, name, paused_until, pending, type, user_id, value
 FROM
     user_contact_methods
 WHERE
@@ -1779,6 +2245,7 @@ func (q *Queries) ContactMethodFindAll(ctx context.Context, userID uuid.UUID) ([
 	for rows.Next() {
 		var i UserContactMethod
 		if err := rows.Scan(
+			&i.ActiveWindow,
 			&i.Dest,
 			&i.Disabled,
 			&i.EnableStatusUpdates,
@@ -1786,6 +2253,7 @@ func (q *Queries) ContactMethodFindAll(ctx context.Context, userID uuid.UUID) ([
 			&i.LastTestVerifyAt,
 			&i.Metadata,
 			&i.Name,
+			&i.PausedUntil,
 			&i.Pending,
 			&i.Type,
 			&i.UserID,
@@ -1806,7 +2274,7 @@ func (q *Queries) ContactMethodFindAll(ctx context.Context, userID uuid.UUID) ([
 
 const contactMethodFindMany = `-- name: ContactMethodFindMany :many
 SELECT
-    dest, disabled, enable_status_updates, id, last_test_verify_at, metadata, name, pending, type, user_id, value
+    active_window, dest, disabled, enable_status_updates, id, last_test_verify_at, metadata, name, paused_until, pending, type, user_id, value
 FROM
     user_contact_methods
 WHERE
@@ -1823,6 +2291,7 @@ func (q *Queries) ContactMethodFindMany(ctx context.Context, dollar_1 []uuid.UUI
 	for rows.Next() {
 		var i UserContactMethod
 		if err := rows.Scan(
+			&i.ActiveWindow,
 			&i.Dest,
 			&i.Disabled,
 			&i.EnableStatusUpdates,
@@ -1830,6 +2299,7 @@ func (q *Queries) ContactMethodFindMany(ctx context.Context, dollar_1 []uuid.UUI
 			&i.LastTestVerifyAt,
 			&i.Metadata,
 			&i.Name,
+			&i.PausedUntil,
 			&i.Pending,
 			&i.Type,
 			&i.UserID,
@@ -1848,13 +2318,43 @@ func (q *Queries) ContactMethodFindMany(ctx context.Context, dollar_1 []uuid.UUI
 	return items, nil
 }
 
-const contactMethodFindOneUpdate = `-- name: ContactMethodFindOneUpdate :one
+const contactMethodFindOneByDest = `-- name: ContactMethodFindOneByDest :one
 SELECT
-    dest, disabled, enable_status_updates, id, last_test_verify_at, metadata, name, pending, type, user_id, value
+    active_window, dest, disabled, enable_status_updates, id, last_test_verify_at, metadata, name, paused_until, pending, type, user_id, value
 FROM
     user_contact_methods
 WHERE
-    id = $1
+    dest = $1
+`
+
+func (q *Queries) ContactMethodFindOneByDest(ctx context.Context, dest NullDestV1) (UserContactMethod, error) {
+	row := q.db.QueryRowContext(ctx, contactMethodFindOneByDest, dest)
+	var i UserContactMethod
+	err := row.Scan(
+		&i.ActiveWindow,
+		&i.Dest,
+		&i.Disabled,
+		&i.EnableStatusUpdates,
+		&i.ID,
+		&i.LastTestVerifyAt,
+		&i.Metadata,
+		&i.Name,
+		&i.PausedUntil,
+		&i.Pending,
+		&i.Type,
+		&i.UserID,
+		&i.Value,
+	)
+	return i, err
+}
+
+const contactMethodFindOneUpdate = `-- name: ContactMethodFindOneUpdate :one
+SELECT
+    active_window, dest, disabled, enable_status_updates, id, last_test_verify_at, metadata, name, paused_until, pending, type, user_id, value
+FROM
+    user_contact_methods
+WHERE
+    id = $1
 FOR UPDATE
 `
 
@@ -1862,6 +2362,7 @@ func (q *Queries) ContactMethodFindOneUpdate(ctx context.Context, id uuid.UUID)
 	row := q.db.QueryRowContext(ctx, contactMethodFindOneUpdate, id)
 	var i UserContactMethod
 	err := row.Scan(
+		&i.ActiveWindow,
 		&i.Dest,
 		&i.Disabled,
 		&i.EnableStatusUpdates,
@@ -1869,6 +2370,7 @@ func (q *Queries) ContactMethodFindOneUpdate(ctx context.Context, id uuid.UUID)
 		&i.LastTestVerifyAt,
 		&i.Metadata,
 		&i.Name,
+		&i.PausedUntil,
 		&i.Pending,
 		&i.Type,
 		&i.UserID,
@@ -1879,7 +2381,7 @@ func (q *Queries) ContactMethodFindOneUpdate(ctx context.Context, id uuid.UUID)
 
 const contactMethodFineOne = `-- name: ContactMethodFineOne :one
 SELECT
-    dest, disabled, enable_status_updates, id, last_test_verify_at, metadata, name, pending, type, user_id, value
+    active_window, dest, disabled, enable_status_updates, id, last_test_verify_at, metadata, name, paused_until, pending, type, user_id, value
 FROM
     user_contact_methods
 WHERE
@@ -1890,6 +2392,7 @@ func (q *Queries) ContactMethodFineOne(ctx context.Context, id uuid.UUID) (UserC
 	row := q.db.QueryRowContext(ctx, contactMethodFineOne, id)
 	var i UserContactMethod
 	err := row.Scan(
+		&i.ActiveWindow,
 		&i.Dest,
 		&i.Disabled,
 		&i.EnableStatusUpdates,
@@ -1897,6 +2400,7 @@ func (q *Queries) ContactMethodFineOne(ctx context.Context, id uuid.UUID) (UserC
 		&i.LastTestVerifyAt,
 		&i.Metadata,
 		&i.Name,
+		&i.PausedUntil,
 		&i.Pending,
 		&i.Type,
 		&i.UserID,
@@ -1959,13 +2463,38 @@ func (q *Queries) ContactMethodMetaDest(ctx context.Context, dest NullDestV1) (C
 	return i, err
 }
 
+const contactMethodPause = `-- name: ContactMethodPause :exec
+UPDATE
+    user_contact_methods
+SET
+    paused_until = CASE WHEN $1::int > 0 THEN
+        now() + '1 minute'::interval * $1::int
+    ELSE
+        NULL
+    END
+WHERE
+    id = $2
+`
+
+type ContactMethodPauseParams struct {
+	PauseMinutes int32
+	ID           uuid.UUID
+}
+
+// Pauses alert notifications to a contact method for the given number of minutes, or resumes them if zero.
+func (q *Queries) ContactMethodPause(ctx context.Context, arg ContactMethodPauseParams) error {
+	_, err := q.db.ExecContext(ctx, contactMethodPause, arg.PauseMinutes, arg.ID)
+	return err
+}
+
 const contactMethodUpdate = `-- name: ContactMethodUpdate :exec
 UPDATE
     user_contact_methods
 SET
     name = $2,
     disabled = $3,
-    enable_status_updates = $4
+    enable_status_updates = $4,
+    active_window = $5
 WHERE
     id = $1
 `
@@ -1975,6 +2504,7 @@ type ContactMethodUpdateParams struct {
 	Name                string
 	Disabled            bool
 	EnableStatusUpdates bool
+	ActiveWindow        pqtype.NullRawMessage
 }
 
 func (q *Queries) ContactMethodUpdate(ctx context.Context, arg ContactMethodUpdateParams) error {
@@ -1983,6 +2513,7 @@ func (q *Queries) ContactMethodUpdate(ctx context.Context, arg ContactMethodUpda
 		arg.Name,
 		arg.Disabled,
 		arg.EnableStatusUpdates,
+		arg.ActiveWindow,
 	)
 	return err
 }
@@ -2688,32 +3219,439 @@ SET
     additional_details = $3,
     muted = $4
 WHERE
-    id = $5
+    id = $5
+`
+
+type HBUpdateParams struct {
+	Name              string
+	HeartbeatInterval sqlutil.Interval
+	AdditionalDetails sql.NullString
+	Muted             sql.NullString
+	ID                uuid.UUID
+}
+
+// HBUpdate will update a heartbeat record.
+func (q *Queries) HBUpdate(ctx context.Context, arg HBUpdateParams) error {
+	_, err := q.db.ExecContext(ctx, hBUpdate,
+		arg.Name,
+		arg.HeartbeatInterval,
+		arg.AdditionalDetails,
+		arg.Muted,
+		arg.ID,
+	)
+	return err
+}
+
+const incidentAddAlerts = `-- name: IncidentAddAlerts :many
+INSERT INTO incident_alerts(incident_id, alert_id)
+SELECT
+    $1,
+    a.id
+FROM
+    alerts a
+WHERE
+    a.id = ANY ($2::bigint[])
+ON CONFLICT (alert_id)
+    DO UPDATE SET
+        incident_id = excluded.incident_id, created_at = now()
+    WHERE
+        incident_alerts.incident_id != excluded.incident_id
+    RETURNING
+        alert_id
+`
+
+type IncidentAddAlertsParams struct {
+	IncidentID int64
+	AlertIds   []int64
+}
+
+// Adds alerts to an incident, moving them from any incident they currently belong to.
+func (q *Queries) IncidentAddAlerts(ctx context.Context, arg IncidentAddAlertsParams) ([]int64, error) {
+	rows, err := q.db.QueryContext(ctx, incidentAddAlerts, arg.IncidentID, pq.Array(arg.AlertIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var alert_id int64
+		if err := rows.Scan(&alert_id); err != nil {
+			return nil, err
+		}
+		items = append(items, alert_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const incidentAddUpdate = `-- name: IncidentAddUpdate :exec
+INSERT INTO incident_updates(incident_id, user_id, message)
+    VALUES ($1, $2, $3)
+`
+
+type IncidentAddUpdateParams struct {
+	IncidentID int64
+	UserID     uuid.NullUUID
+	Message    string
+}
+
+// Adds an entry to the incident's update feed.
+func (q *Queries) IncidentAddUpdate(ctx context.Context, arg IncidentAddUpdateParams) error {
+	_, err := q.db.ExecContext(ctx, incidentAddUpdate, arg.IncidentID, arg.UserID, arg.Message)
+	return err
+}
+
+const incidentAlertCount = `-- name: IncidentAlertCount :one
+SELECT
+    count(*)
+FROM
+    incident_alerts
+WHERE
+    incident_id = $1
+`
+
+// Returns the number of alerts in an incident.
+func (q *Queries) IncidentAlertCount(ctx context.Context, incidentID int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, incidentAlertCount, incidentID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const incidentAlertIDs = `-- name: IncidentAlertIDs :many
+SELECT
+    alert_id
+FROM
+    incident_alerts
+WHERE
+    incident_id = $1
+ORDER BY
+    alert_id
+`
+
+// Returns the IDs of all alerts in an incident.
+func (q *Queries) IncidentAlertIDs(ctx context.Context, incidentID int64) ([]int64, error) {
+	rows, err := q.db.QueryContext(ctx, incidentAlertIDs, incidentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var alert_id int64
+		if err := rows.Scan(&alert_id); err != nil {
+			return nil, err
+		}
+		items = append(items, alert_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const incidentCreate = `-- name: IncidentCreate :one
+INSERT INTO incidents(title, description)
+    VALUES ($1, $2)
+RETURNING
+    id, created_at
+`
+
+type IncidentCreateParams struct {
+	Title       string
+	Description string
+}
+
+type IncidentCreateRow struct {
+	ID        int64
+	CreatedAt time.Time
+}
+
+// Creates a new incident.
+func (q *Queries) IncidentCreate(ctx context.Context, arg IncidentCreateParams) (IncidentCreateRow, error) {
+	row := q.db.QueryRowContext(ctx, incidentCreate, arg.Title, arg.Description)
+	var i IncidentCreateRow
+	err := row.Scan(&i.ID, &i.CreatedAt)
+	return i, err
+}
+
+const incidentFindMany = `-- name: IncidentFindMany :many
+SELECT
+    id,
+    title,
+    description,
+    status,
+    created_at,
+    closed_at
+FROM
+    incidents
+WHERE
+    id = ANY ($1::bigint[])
+`
+
+type IncidentFindManyRow struct {
+	ID          int64
+	Title       string
+	Description string
+	Status      EnumAlertStatus
+	CreatedAt   time.Time
+	ClosedAt    sql.NullTime
+}
+
+// Returns the incidents with the given IDs.
+func (q *Queries) IncidentFindMany(ctx context.Context, ids []int64) ([]IncidentFindManyRow, error) {
+	rows, err := q.db.QueryContext(ctx, incidentFindMany, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []IncidentFindManyRow
+	for rows.Next() {
+		var i IncidentFindManyRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Description,
+			&i.Status,
+			&i.CreatedAt,
+			&i.ClosedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const incidentLock = `-- name: IncidentLock :one
+SELECT
+    status
+FROM
+    incidents
+WHERE
+    id = $1
+FOR UPDATE
+`
+
+// Locks the incident for updating and returns its status.
+func (q *Queries) IncidentLock(ctx context.Context, id int64) (EnumAlertStatus, error) {
+	row := q.db.QueryRowContext(ctx, incidentLock, id)
+	var status EnumAlertStatus
+	err := row.Scan(&status)
+	return status, err
+}
+
+const incidentOpenAlertIDs = `-- name: IncidentOpenAlertIDs :many
+SELECT
+    a.id
+FROM
+    incident_alerts ia
+    JOIN alerts a ON a.id = ia.alert_id
+WHERE
+    ia.incident_id = $1
+    AND a.status != 'closed'
+ORDER BY
+    a.id
+`
+
+// Returns the IDs of all alerts in an incident that are not closed.
+func (q *Queries) IncidentOpenAlertIDs(ctx context.Context, incidentID int64) ([]int64, error) {
+	rows, err := q.db.QueryContext(ctx, incidentOpenAlertIDs, incidentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const incidentRemoveAlerts = `-- name: IncidentRemoveAlerts :many
+DELETE FROM incident_alerts
+WHERE incident_id = $1
+    AND alert_id = ANY ($2::bigint[])
+RETURNING
+    alert_id
+`
+
+type IncidentRemoveAlertsParams struct {
+	IncidentID int64
+	AlertIds   []int64
+}
+
+// Removes alerts from an incident.
+func (q *Queries) IncidentRemoveAlerts(ctx context.Context, arg IncidentRemoveAlertsParams) ([]int64, error) {
+	rows, err := q.db.QueryContext(ctx, incidentRemoveAlerts, arg.IncidentID, pq.Array(arg.AlertIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var alert_id int64
+		if err := rows.Scan(&alert_id); err != nil {
+			return nil, err
+		}
+		items = append(items, alert_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const incidentSetStatus = `-- name: IncidentSetStatus :exec
+UPDATE
+    incidents
+SET
+    status = $1,
+    closed_at = CASE WHEN $1 = 'closed'::enum_alert_status THEN
+        now()
+    END
+WHERE
+    id = $2
+`
+
+type IncidentSetStatusParams struct {
+	Status EnumAlertStatus
+	ID     int64
+}
+
+// Updates the status of an incident.
+func (q *Queries) IncidentSetStatus(ctx context.Context, arg IncidentSetStatusParams) error {
+	_, err := q.db.ExecContext(ctx, incidentSetStatus, arg.Status, arg.ID)
+	return err
+}
+
+const incidentUpdate = `-- name: IncidentUpdate :exec
+UPDATE
+    incidents
+SET
+    title = $1,
+    description = $2
+WHERE
+    id = $3
+`
+
+type IncidentUpdateParams struct {
+	Title       string
+	Description string
+	ID          int64
+}
+
+// Updates the title and description of an incident.
+func (q *Queries) IncidentUpdate(ctx context.Context, arg IncidentUpdateParams) error {
+	_, err := q.db.ExecContext(ctx, incidentUpdate, arg.Title, arg.Description, arg.ID)
+	return err
+}
+
+const incidentUpdates = `-- name: IncidentUpdates :many
+SELECT
+    id,
+    incident_id,
+    user_id,
+    message,
+    created_at
+FROM
+    incident_updates
+WHERE
+    incident_id = $1
+ORDER BY
+    id DESC
+LIMIT $2
+`
+
+type IncidentUpdatesParams struct {
+	IncidentID int64
+	MaxResults int32
+}
+
+type IncidentUpdatesRow struct {
+	ID         int64
+	IncidentID int64
+	UserID     uuid.NullUUID
+	Message    string
+	CreatedAt  time.Time
+}
+
+// Returns the update feed for an incident, newest first.
+func (q *Queries) IncidentUpdates(ctx context.Context, arg IncidentUpdatesParams) ([]IncidentUpdatesRow, error) {
+	rows, err := q.db.QueryContext(ctx, incidentUpdates, arg.IncidentID, arg.MaxResults)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []IncidentUpdatesRow
+	for rows.Next() {
+		var i IncidentUpdatesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.IncidentID,
+			&i.UserID,
+			&i.Message,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const intKeyConfigRevision = `-- name: IntKeyConfigRevision :one
+SELECT
+    revision
+FROM
+    uik_config
+WHERE
+    id = $1
 `
 
-type HBUpdateParams struct {
-	Name              string
-	HeartbeatInterval sqlutil.Interval
-	AdditionalDetails sql.NullString
-	Muted             sql.NullString
-	ID                uuid.UUID
-}
-
-// HBUpdate will update a heartbeat record.
-func (q *Queries) HBUpdate(ctx context.Context, arg HBUpdateParams) error {
-	_, err := q.db.ExecContext(ctx, hBUpdate,
-		arg.Name,
-		arg.HeartbeatInterval,
-		arg.AdditionalDetails,
-		arg.Muted,
-		arg.ID,
-	)
-	return err
+func (q *Queries) IntKeyConfigRevision(ctx context.Context, id uuid.UUID) (int64, error) {
+	row := q.db.QueryRowContext(ctx, intKeyConfigRevision, id)
+	var revision int64
+	err := row.Scan(&revision)
+	return revision, err
 }
 
 const intKeyCreate = `-- name: IntKeyCreate :exec
-INSERT INTO integration_keys(id, name, type, service_id, external_system_name)
-    VALUES ($1, $2, $3, $4, $5)
+INSERT INTO integration_keys(id, name, type, service_id, external_system_name, auto_close_minutes)
+    VALUES ($1, $2, $3, $4, $5, $6)
 `
 
 type IntKeyCreateParams struct {
@@ -2722,6 +3660,7 @@ type IntKeyCreateParams struct {
 	Type               EnumIntegrationKeysType
 	ServiceID          uuid.UUID
 	ExternalSystemName sql.NullString
+	AutoCloseMinutes   sql.NullInt32
 }
 
 func (q *Queries) IntKeyCreate(ctx context.Context, arg IntKeyCreateParams) error {
@@ -2731,6 +3670,7 @@ func (q *Queries) IntKeyCreate(ctx context.Context, arg IntKeyCreateParams) erro
 		arg.Type,
 		arg.ServiceID,
 		arg.ExternalSystemName,
+		arg.AutoCloseMinutes,
 	)
 	return err
 }
@@ -2776,7 +3716,8 @@ SELECT
     name,
     type,
     service_id,
-    external_system_name
+    external_system_name,
+    auto_close_minutes
 FROM
     integration_keys
 WHERE
@@ -2789,6 +3730,7 @@ type IntKeyFindByServiceRow struct {
 	Type               EnumIntegrationKeysType
 	ServiceID          uuid.UUID
 	ExternalSystemName sql.NullString
+	AutoCloseMinutes   sql.NullInt32
 }
 
 func (q *Queries) IntKeyFindByService(ctx context.Context, serviceID uuid.UUID) ([]IntKeyFindByServiceRow, error) {
@@ -2806,6 +3748,7 @@ func (q *Queries) IntKeyFindByService(ctx context.Context, serviceID uuid.UUID)
 			&i.Type,
 			&i.ServiceID,
 			&i.ExternalSystemName,
+			&i.AutoCloseMinutes,
 		); err != nil {
 			return nil, err
 		}
@@ -2826,7 +3769,8 @@ SELECT
     name,
     type,
     service_id,
-    external_system_name
+    external_system_name,
+    auto_close_minutes
 FROM
     integration_keys
 WHERE
@@ -2839,6 +3783,7 @@ type IntKeyFindOneRow struct {
 	Type               EnumIntegrationKeysType
 	ServiceID          uuid.UUID
 	ExternalSystemName sql.NullString
+	AutoCloseMinutes   sql.NullInt32
 }
 
 func (q *Queries) IntKeyFindOne(ctx context.Context, id uuid.UUID) (IntKeyFindOneRow, error) {
@@ -2850,6 +3795,50 @@ func (q *Queries) IntKeyFindOne(ctx context.Context, id uuid.UUID) (IntKeyFindOn
 		&i.Type,
 		&i.ServiceID,
 		&i.ExternalSystemName,
+		&i.AutoCloseMinutes,
+	)
+	return i, err
+}
+
+const intKeyFindSample = `-- name: IntKeyFindSample :one
+SELECT
+    id,
+    received_at,
+    body,
+    query,
+    user_agent,
+    remote_addr
+FROM
+    uik_samples
+WHERE
+    key_id = $1
+    AND id = $2
+`
+
+type IntKeyFindSampleParams struct {
+	KeyID uuid.UUID
+	ID    uuid.UUID
+}
+
+type IntKeyFindSampleRow struct {
+	ID         uuid.UUID
+	ReceivedAt time.Time
+	Body       json.RawMessage
+	Query      string
+	UserAgent  string
+	RemoteAddr string
+}
+
+func (q *Queries) IntKeyFindSample(ctx context.Context, arg IntKeyFindSampleParams) (IntKeyFindSampleRow, error) {
+	row := q.db.QueryRowContext(ctx, intKeyFindSample, arg.KeyID, arg.ID)
+	var i IntKeyFindSampleRow
+	err := row.Scan(
+		&i.ID,
+		&i.ReceivedAt,
+		&i.Body,
+		&i.Query,
+		&i.UserAgent,
+		&i.RemoteAddr,
 	)
 	return i, err
 }
@@ -2871,6 +3860,28 @@ func (q *Queries) IntKeyGetConfig(ctx context.Context, id uuid.UUID) (UIKConfig,
 	return config, err
 }
 
+const intKeyGetConfigRevision = `-- name: IntKeyGetConfigRevision :one
+SELECT
+    config,
+    revision
+FROM
+    uik_config
+WHERE
+    id = $1
+`
+
+type IntKeyGetConfigRevisionRow struct {
+	Config   UIKConfig
+	Revision int64
+}
+
+func (q *Queries) IntKeyGetConfigRevision(ctx context.Context, id uuid.UUID) (IntKeyGetConfigRevisionRow, error) {
+	row := q.db.QueryRowContext(ctx, intKeyGetConfigRevision, id)
+	var i IntKeyGetConfigRevisionRow
+	err := row.Scan(&i.Config, &i.Revision)
+	return i, err
+}
+
 const intKeyGetServiceID = `-- name: IntKeyGetServiceID :one
 SELECT
     service_id
@@ -2909,6 +3920,32 @@ func (q *Queries) IntKeyGetType(ctx context.Context, id uuid.UUID) (EnumIntegrat
 	return type_, err
 }
 
+const intKeyInsertSample = `-- name: IntKeyInsertSample :exec
+INSERT INTO uik_samples(id, key_id, body, query, user_agent, remote_addr)
+    VALUES ($1, $2, $3, $4, $5, $6)
+`
+
+type IntKeyInsertSampleParams struct {
+	ID         uuid.UUID
+	KeyID      uuid.UUID
+	Body       json.RawMessage
+	Query      string
+	UserAgent  string
+	RemoteAddr string
+}
+
+func (q *Queries) IntKeyInsertSample(ctx context.Context, arg IntKeyInsertSampleParams) error {
+	_, err := q.db.ExecContext(ctx, intKeyInsertSample,
+		arg.ID,
+		arg.KeyID,
+		arg.Body,
+		arg.Query,
+		arg.UserAgent,
+		arg.RemoteAddr,
+	)
+	return err
+}
+
 const intKeyInsertSignalMessage = `-- name: IntKeyInsertSignalMessage :exec
 INSERT INTO pending_signals(dest_id, service_id, params)
     VALUES ($1, $2, $3)
@@ -2946,12 +3983,97 @@ func (q *Queries) IntKeyPromoteSecondary(ctx context.Context, id uuid.UUID) (sql
 	return primary_token_hint, err
 }
 
+const intKeySamples = `-- name: IntKeySamples :many
+SELECT
+    id,
+    received_at,
+    body,
+    query,
+    user_agent,
+    remote_addr
+FROM
+    uik_samples
+WHERE
+    key_id = $1
+ORDER BY
+    received_at DESC
+LIMIT $2
+`
+
+type IntKeySamplesParams struct {
+	KeyID    uuid.UUID
+	MaxCount int32
+}
+
+type IntKeySamplesRow struct {
+	ID         uuid.UUID
+	ReceivedAt time.Time
+	Body       json.RawMessage
+	Query      string
+	UserAgent  string
+	RemoteAddr string
+}
+
+func (q *Queries) IntKeySamples(ctx context.Context, arg IntKeySamplesParams) ([]IntKeySamplesRow, error) {
+	rows, err := q.db.QueryContext(ctx, intKeySamples, arg.KeyID, arg.MaxCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []IntKeySamplesRow
+	for rows.Next() {
+		var i IntKeySamplesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ReceivedAt,
+			&i.Body,
+			&i.Query,
+			&i.UserAgent,
+			&i.RemoteAddr,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const intKeySetAutoClose = `-- name: IntKeySetAutoClose :execrows
+UPDATE
+    integration_keys
+SET
+    auto_close_minutes = $1
+WHERE
+    id = $2
+`
+
+type IntKeySetAutoCloseParams struct {
+	AutoCloseMinutes sql.NullInt32
+	ID               uuid.UUID
+}
+
+// Sets (or clears, if NULL) the auto-close duration for an integration key.
+func (q *Queries) IntKeySetAutoClose(ctx context.Context, arg IntKeySetAutoCloseParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, intKeySetAutoClose, arg.AutoCloseMinutes, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const intKeySetConfig = `-- name: IntKeySetConfig :exec
 INSERT INTO uik_config(id, config)
     VALUES ($1, $2)
 ON CONFLICT (id)
     DO UPDATE SET
-        config = $2
+        config = $2,
+        revision = uik_config.revision + 1
 `
 
 type IntKeySetConfigParams struct {
@@ -3047,18 +4169,19 @@ FROM
     JOIN integration_keys k ON k.id = c.id
 WHERE
     c.id = $1
-    AND k.type = 'universal'
-    AND (c.primary_token = $2
-        OR c.secondary_token = $2)
+    AND k.type = $2
+    AND (c.primary_token = $3
+        OR c.secondary_token = $3)
 `
 
 type IntKeyUIKValidateServiceParams struct {
 	KeyID   uuid.UUID
+	KeyType EnumIntegrationKeysType
 	TokenID uuid.NullUUID
 }
 
 func (q *Queries) IntKeyUIKValidateService(ctx context.Context, arg IntKeyUIKValidateServiceParams) (uuid.UUID, error) {
-	row := q.db.QueryRowContext(ctx, intKeyUIKValidateService, arg.KeyID, arg.TokenID)
+	row := q.db.QueryRowContext(ctx, intKeyUIKValidateService, arg.KeyID, arg.KeyType, arg.TokenID)
 	var service_id uuid.UUID
 	err := row.Scan(&service_id)
 	return service_id, err
@@ -3138,6 +4261,42 @@ func (q *Queries) Keyring_GetKeyringSecrets(ctx context.Context) ([]Keyring_GetK
 	return items, nil
 }
 
+const keyring_GetWebhookSecrets = `-- name: Keyring_GetWebhookSecrets :many
+SELECT
+    webhook_url,
+    secret
+FROM
+    webhook_signing_secrets
+`
+
+type Keyring_GetWebhookSecretsRow struct {
+	WebhookUrl string
+	Secret     []byte
+}
+
+func (q *Queries) Keyring_GetWebhookSecrets(ctx context.Context) ([]Keyring_GetWebhookSecretsRow, error) {
+	rows, err := q.db.QueryContext(ctx, keyring_GetWebhookSecrets)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Keyring_GetWebhookSecretsRow
+	for rows.Next() {
+		var i Keyring_GetWebhookSecretsRow
+		if err := rows.Scan(&i.WebhookUrl, &i.Secret); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const keyring_LockConfig = `-- name: Keyring_LockConfig :exec
 LOCK TABLE config IN ACCESS EXCLUSIVE MODE
 `
@@ -3158,6 +4317,16 @@ func (q *Queries) Keyring_LockKeyrings(ctx context.Context) error {
 	return err
 }
 
+const keyring_LockWebhookSecrets = `-- name: Keyring_LockWebhookSecrets :exec
+LOCK TABLE webhook_signing_secrets IN ACCESS EXCLUSIVE MODE
+`
+
+// Locks the webhook_signing_secrets table so no new secrets can be created.
+func (q *Queries) Keyring_LockWebhookSecrets(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, keyring_LockWebhookSecrets)
+	return err
+}
+
 const keyring_UpdateConfigPayload = `-- name: Keyring_UpdateConfigPayload :exec
 UPDATE
     config
@@ -3198,6 +4367,25 @@ func (q *Queries) Keyring_UpdateKeyringSecrets(ctx context.Context, arg Keyring_
 	return err
 }
 
+const keyring_UpdateWebhookSecret = `-- name: Keyring_UpdateWebhookSecret :exec
+UPDATE
+    webhook_signing_secrets
+SET
+    secret = $1
+WHERE
+    webhook_url = $2
+`
+
+type Keyring_UpdateWebhookSecretParams struct {
+	Secret     []byte
+	WebhookUrl string
+}
+
+func (q *Queries) Keyring_UpdateWebhookSecret(ctx context.Context, arg Keyring_UpdateWebhookSecretParams) error {
+	_, err := q.db.ExecContext(ctx, keyring_UpdateWebhookSecret, arg.Secret, arg.WebhookUrl)
+	return err
+}
+
 const labelDeleteKeyByTarget = `-- name: LabelDeleteKeyByTarget :exec
 DELETE FROM labels
 WHERE key = $1
@@ -3920,7 +5108,10 @@ SELECT
     msg.created_at,
     msg.sent_at,
     msg.status_alert_ids,
-    msg.schedule_id
+    msg.schedule_id,
+    msg.status_details,
+    cm.active_window,
+    cm.paused_until
 FROM
     outgoing_messages msg
     LEFT JOIN user_contact_methods cm ON cm.id = msg.contact_method_id
@@ -3948,6 +5139,9 @@ type MessageMgrGetPendingRow struct {
 	SentAt                 sql.NullTime
 	StatusAlertIds         []int64
 	ScheduleID             uuid.NullUUID
+	StatusDetails          string
+	ActiveWindow           pqtype.NullRawMessage
+	PausedUntil            sql.NullTime
 }
 
 func (q *Queries) MessageMgrGetPending(ctx context.Context, sentAt sql.NullTime) ([]MessageMgrGetPendingRow, error) {
@@ -3974,6 +5168,67 @@ func (q *Queries) MessageMgrGetPending(ctx context.Context, sentAt sql.NullTime)
 			&i.SentAt,
 			pq.Array(&i.StatusAlertIds),
 			&i.ScheduleID,
+			&i.StatusDetails,
+			&i.ActiveWindow,
+			&i.PausedUntil,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const msgTemplateDelete = `-- name: MsgTemplateDelete :exec
+DELETE FROM service_message_templates
+WHERE service_id = $1
+    AND dest_type = $2
+`
+
+type MsgTemplateDeleteParams struct {
+	ServiceID uuid.UUID
+	DestType  string
+}
+
+// Deletes the message template for a service and destination type.
+func (q *Queries) MsgTemplateDelete(ctx context.Context, arg MsgTemplateDeleteParams) error {
+	_, err := q.db.ExecContext(ctx, msgTemplateDelete, arg.ServiceID, arg.DestType)
+	return err
+}
+
+const msgTemplateFindAll = `-- name: MsgTemplateFindAll :many
+SELECT
+    dest_type, details_template, service_id, summary_template, updated_at
+FROM
+    service_message_templates
+WHERE
+    service_id = $1
+ORDER BY
+    dest_type
+`
+
+// Returns all message templates for a service.
+func (q *Queries) MsgTemplateFindAll(ctx context.Context, serviceID uuid.UUID) ([]ServiceMessageTemplate, error) {
+	rows, err := q.db.QueryContext(ctx, msgTemplateFindAll, serviceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ServiceMessageTemplate
+	for rows.Next() {
+		var i ServiceMessageTemplate
+		if err := rows.Scan(
+			&i.DestType,
+			&i.DetailsTemplate,
+			&i.ServiceID,
+			&i.SummaryTemplate,
+			&i.UpdatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -3988,6 +5243,64 @@ func (q *Queries) MessageMgrGetPending(ctx context.Context, sentAt sql.NullTime)
 	return items, nil
 }
 
+const msgTemplateFindForDest = `-- name: MsgTemplateFindForDest :one
+SELECT
+    dest_type, details_template, service_id, summary_template, updated_at
+FROM
+    service_message_templates
+WHERE
+    service_id = $1
+    AND dest_type IN ($2, '')
+ORDER BY
+    dest_type DESC
+LIMIT 1
+`
+
+type MsgTemplateFindForDestParams struct {
+	ServiceID uuid.UUID
+	DestType  string
+}
+
+// Returns the message template for a service and destination type, falling back to the default (empty dest_type) template.
+func (q *Queries) MsgTemplateFindForDest(ctx context.Context, arg MsgTemplateFindForDestParams) (ServiceMessageTemplate, error) {
+	row := q.db.QueryRowContext(ctx, msgTemplateFindForDest, arg.ServiceID, arg.DestType)
+	var i ServiceMessageTemplate
+	err := row.Scan(
+		&i.DestType,
+		&i.DetailsTemplate,
+		&i.ServiceID,
+		&i.SummaryTemplate,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const msgTemplateSet = `-- name: MsgTemplateSet :exec
+INSERT INTO service_message_templates(service_id, dest_type, summary_template, details_template)
+    VALUES ($1, $2, $3, $4)
+ON CONFLICT (service_id, dest_type)
+    DO UPDATE SET
+        summary_template = excluded.summary_template, details_template = excluded.details_template, updated_at = now()
+`
+
+type MsgTemplateSetParams struct {
+	ServiceID       uuid.UUID
+	DestType        string
+	SummaryTemplate string
+	DetailsTemplate string
+}
+
+// Creates or updates the message template for a service and destination type.
+func (q *Queries) MsgTemplateSet(ctx context.Context, arg MsgTemplateSetParams) error {
+	_, err := q.db.ExecContext(ctx, msgTemplateSet,
+		arg.ServiceID,
+		arg.DestType,
+		arg.SummaryTemplate,
+		arg.DetailsTemplate,
+	)
+	return err
+}
+
 const nfyLastMessageStatus = `-- name: NfyLastMessageStatus :one
 SELECT
     om.alert_id, om.alert_log_id, om.channel_id, om.contact_method_id, om.created_at, om.cycle_id, om.escalation_policy_id, om.fired_at, om.id, om.last_status, om.last_status_at, om.message_type, om.next_retry_at, om.provider_msg_id, om.provider_seq, om.retry_count, om.schedule_id, om.sending_deadline, om.sent_at, om.service_id, om.src_value, om.status_alert_ids, om.status_details, om.user_id, om.user_verification_code_id,
@@ -4186,6 +5499,115 @@ func (q *Queries) NfyOriginalMessageStatus(ctx context.Context, arg NfyOriginalM
 	return i, err
 }
 
+const noticeUGSyncsByPolicy = `-- name: NoticeUGSyncsByPolicy :many
+SELECT
+    stp.step_number,
+    s.usergroup_id,
+    s.last_error,
+    (
+        CASE WHEN s.drift_detected_at > now() - '1 day'::interval THEN
+            s.drift_details
+        ELSE
+            ''
+        END)::text AS drift_details
+FROM
+    slack_usergroup_syncs s
+    JOIN escalation_policy_steps stp ON stp.id = s.ep_step_id
+WHERE
+    stp.escalation_policy_id = $1
+    AND (s.last_error != ''
+        OR s.drift_detected_at > now() - '1 day'::interval)
+ORDER BY
+    stp.step_number,
+    s.usergroup_id
+`
+
+type NoticeUGSyncsByPolicyRow struct {
+	StepNumber   int32
+	UsergroupID  string
+	LastError    string
+	DriftDetails string
+}
+
+// Returns Slack user group syncs for the steps of an escalation policy that have an error or recently detected drift.
+func (q *Queries) NoticeUGSyncsByPolicy(ctx context.Context, escalationPolicyID uuid.UUID) ([]NoticeUGSyncsByPolicyRow, error) {
+	rows, err := q.db.QueryContext(ctx, noticeUGSyncsByPolicy, escalationPolicyID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []NoticeUGSyncsByPolicyRow
+	for rows.Next() {
+		var i NoticeUGSyncsByPolicyRow
+		if err := rows.Scan(
+			&i.StepNumber,
+			&i.UsergroupID,
+			&i.LastError,
+			&i.DriftDetails,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const noticeUGSyncsByRotation = `-- name: NoticeUGSyncsByRotation :many
+SELECT
+    usergroup_id,
+    last_error,
+    (
+        CASE WHEN drift_detected_at > now() - '1 day'::interval THEN
+            drift_details
+        ELSE
+            ''
+        END)::text AS drift_details
+FROM
+    slack_usergroup_syncs
+WHERE
+    rotation_id = $1
+    AND (last_error != ''
+        OR drift_detected_at > now() - '1 day'::interval)
+ORDER BY
+    usergroup_id
+`
+
+type NoticeUGSyncsByRotationRow struct {
+	UsergroupID  string
+	LastError    string
+	DriftDetails string
+}
+
+// Returns Slack user group syncs for a rotation that have an error or recently detected drift.
+func (q *Queries) NoticeUGSyncsByRotation(ctx context.Context, rotationID uuid.NullUUID) ([]NoticeUGSyncsByRotationRow, error) {
+	rows, err := q.db.QueryContext(ctx, noticeUGSyncsByRotation, rotationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []NoticeUGSyncsByRotationRow
+	for rows.Next() {
+		var i NoticeUGSyncsByRotationRow
+		if err := rows.Scan(&i.UsergroupID, &i.LastError, &i.DriftDetails); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const noticeUnackedAlertsByService = `-- name: NoticeUnackedAlertsByService :one
 SELECT
     count(*),
@@ -5365,6 +6787,47 @@ func (q *Queries) SchedMgrTimezones(ctx context.Context) ([]SchedMgrTimezonesRow
 	return items, nil
 }
 
+const schedSyncedUserGroupChannels = `-- name: SchedSyncedUserGroupChannels :many
+SELECT
+    nc.id
+FROM
+    notification_channels nc
+WHERE
+    nc.id = ANY ($1::uuid[])
+    AND nc.dest ->> 'Type' = 'builtin-slack-usergroup'
+    AND EXISTS (
+        SELECT
+            1
+        FROM
+            slack_usergroup_syncs s
+        WHERE
+            s.usergroup_id = nc.dest -> 'Args' ->> 'slack_usergroup_id')
+`
+
+// Returns the IDs of the given notification channels that update a Slack user group whose membership is synced by GoAlert.
+func (q *Queries) SchedSyncedUserGroupChannels(ctx context.Context, channelIds []uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := q.db.QueryContext(ctx, schedSyncedUserGroupChannels, pq.Array(channelIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const schedUpdate = `-- name: SchedUpdate :exec
 UPDATE schedules
 SET name = $2, description = $3, time_zone = $4
@@ -5818,15 +7281,15 @@ FROM
     alert_logs
 WHERE
     alert_id = $1::bigint
-    AND event = $2::enum_alert_log_event
+    AND event = ANY ($2::enum_alert_log_event[])
 ORDER BY
     id DESC
 LIMIT 1
 `
 
 type StatusMgrLogEntryParams struct {
-	AlertID   int64
-	EventType EnumAlertLogEvent
+	AlertID    int64
+	EventTypes []EnumAlertLogEvent
 }
 
 type StatusMgrLogEntryRow struct {
@@ -5835,7 +7298,7 @@ type StatusMgrLogEntryRow struct {
 }
 
 func (q *Queries) StatusMgrLogEntry(ctx context.Context, arg StatusMgrLogEntryParams) (StatusMgrLogEntryRow, error) {
-	row := q.db.QueryRowContext(ctx, statusMgrLogEntry, arg.AlertID, arg.EventType)
+	row := q.db.QueryRowContext(ctx, statusMgrLogEntry, arg.AlertID, pq.Array(arg.EventTypes))
 	var i StatusMgrLogEntryRow
 	err := row.Scan(&i.ID, &i.UserID)
 	return i, err
@@ -5992,6 +7455,317 @@ func (q *Queries) TableColumns(ctx context.Context) ([]TableColumnsRow, error) {
 	return items, nil
 }
 
+const uGMgrFindAll = `-- name: UGMgrFindAll :many
+SELECT
+    s.id,
+    s.usergroup_id,
+    s.members,
+    s.last_sync_at,
+    ARRAY (
+        SELECT
+            rp.user_id
+        FROM
+            rotation_state rs
+            JOIN rotation_participants rp ON rp.id = rs.rotation_participant_id
+        WHERE
+            rs.rotation_id = s.rotation_id
+        UNION
+        SELECT
+            oc.user_id
+        FROM
+            ep_step_on_call_users oc
+        WHERE
+            oc.ep_step_id = s.ep_step_id
+            AND oc.end_time IS NULL)::uuid[] AS user_ids
+FROM
+    slack_usergroup_syncs s
+ORDER BY
+    s.id
+`
+
+type UGMgrFindAllRow struct {
+	ID          uuid.UUID
+	UsergroupID string
+	Members     []string
+	LastSyncAt  sql.NullTime
+	UserIds     []uuid.UUID
+}
+
+// Returns all Slack user group syncs along with the users that should currently be members.
+func (q *Queries) UGMgrFindAll(ctx context.Context) ([]UGMgrFindAllRow, error) {
+	rows, err := q.db.QueryContext(ctx, uGMgrFindAll)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UGMgrFindAllRow
+	for rows.Next() {
+		var i UGMgrFindAllRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UsergroupID,
+			pq.Array(&i.Members),
+			&i.LastSyncAt,
+			pq.Array(&i.UserIds),
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const uGMgrSlackUserIDs = `-- name: UGMgrSlackUserIDs :many
+SELECT DISTINCT ON (user_id)
+    user_id,
+    subject_id
+FROM
+    auth_subjects
+WHERE
+    provider_id = $1
+    AND user_id = ANY ($2::uuid[])
+ORDER BY
+    user_id,
+    id
+`
+
+type UGMgrSlackUserIDsParams struct {
+	ProviderID string
+	UserIds    []uuid.UUID
+}
+
+type UGMgrSlackUserIDsRow struct {
+	UserID    uuid.UUID
+	SubjectID string
+}
+
+// Returns the Slack user ID for each of the given users that have linked their Slack account.
+func (q *Queries) UGMgrSlackUserIDs(ctx context.Context, arg UGMgrSlackUserIDsParams) ([]UGMgrSlackUserIDsRow, error) {
+	rows, err := q.db.QueryContext(ctx, uGMgrSlackUserIDs, arg.ProviderID, pq.Array(arg.UserIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UGMgrSlackUserIDsRow
+	for rows.Next() {
+		var i UGMgrSlackUserIDsRow
+		if err := rows.Scan(&i.UserID, &i.SubjectID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const uGMgrUpdate = `-- name: UGMgrUpdate :exec
+UPDATE
+    slack_usergroup_syncs
+SET
+    members = $1,
+    last_sync_at = now(),
+    last_error = $2,
+    drift_detected_at = CASE WHEN $3::boolean THEN
+        now()
+    ELSE
+        drift_detected_at
+    END,
+    drift_details = CASE WHEN $3::boolean THEN
+        $4
+    ELSE
+        drift_details
+    END
+WHERE
+    id = $5
+`
+
+type UGMgrUpdateParams struct {
+	Members       []string
+	LastError     string
+	DriftDetected bool
+	DriftDetails  string
+	ID            uuid.UUID
+}
+
+// Records the result of a sync. Drift details are only updated when drift_detected is true.
+func (q *Queries) UGMgrUpdate(ctx context.Context, arg UGMgrUpdateParams) error {
+	_, err := q.db.ExecContext(ctx, uGMgrUpdate,
+		pq.Array(arg.Members),
+		arg.LastError,
+		arg.DriftDetected,
+		arg.DriftDetails,
+		arg.ID,
+	)
+	return err
+}
+
+const uGSyncCreate = `-- name: UGSyncCreate :exec
+INSERT INTO slack_usergroup_syncs(id, usergroup_id, rotation_id, ep_step_id)
+    VALUES ($1, $2, $3, $4)
+`
+
+type UGSyncCreateParams struct {
+	ID          uuid.UUID
+	UsergroupID string
+	RotationID  uuid.NullUUID
+	EpStepID    uuid.NullUUID
+}
+
+// Creates a new Slack user group sync for a rotation or escalation policy step.
+func (q *Queries) UGSyncCreate(ctx context.Context, arg UGSyncCreateParams) error {
+	_, err := q.db.ExecContext(ctx, uGSyncCreate,
+		arg.ID,
+		arg.UsergroupID,
+		arg.RotationID,
+		arg.EpStepID,
+	)
+	return err
+}
+
+const uGSyncDelete = `-- name: UGSyncDelete :exec
+DELETE FROM slack_usergroup_syncs
+WHERE id = $1
+`
+
+// Deletes a Slack user group sync.
+func (q *Queries) UGSyncDelete(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, uGSyncDelete, id)
+	return err
+}
+
+const uGSyncFindManyByEPStep = `-- name: UGSyncFindManyByEPStep :many
+SELECT
+    created_at, drift_detected_at, drift_details, ep_step_id, id, last_error, last_sync_at, members, rotation_id, usergroup_id
+FROM
+    slack_usergroup_syncs
+WHERE
+    ep_step_id = $1
+ORDER BY
+    created_at,
+    id
+`
+
+// Returns all Slack user group syncs for an escalation policy step.
+func (q *Queries) UGSyncFindManyByEPStep(ctx context.Context, epStepID uuid.NullUUID) ([]SlackUsergroupSync, error) {
+	rows, err := q.db.QueryContext(ctx, uGSyncFindManyByEPStep, epStepID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SlackUsergroupSync
+	for rows.Next() {
+		var i SlackUsergroupSync
+		if err := rows.Scan(
+			&i.CreatedAt,
+			&i.DriftDetectedAt,
+			&i.DriftDetails,
+			&i.EpStepID,
+			&i.ID,
+			&i.LastError,
+			&i.LastSyncAt,
+			pq.Array(&i.Members),
+			&i.RotationID,
+			&i.UsergroupID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const uGSyncFindManyByRotation = `-- name: UGSyncFindManyByRotation :many
+SELECT
+    created_at, drift_detected_at, drift_details, ep_step_id, id, last_error, last_sync_at, members, rotation_id, usergroup_id
+FROM
+    slack_usergroup_syncs
+WHERE
+    rotation_id = $1
+ORDER BY
+    created_at,
+    id
+`
+
+// Returns all Slack user group syncs for a rotation.
+func (q *Queries) UGSyncFindManyByRotation(ctx context.Context, rotationID uuid.NullUUID) ([]SlackUsergroupSync, error) {
+	rows, err := q.db.QueryContext(ctx, uGSyncFindManyByRotation, rotationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SlackUsergroupSync
+	for rows.Next() {
+		var i SlackUsergroupSync
+		if err := rows.Scan(
+			&i.CreatedAt,
+			&i.DriftDetectedAt,
+			&i.DriftDetails,
+			&i.EpStepID,
+			&i.ID,
+			&i.LastError,
+			&i.LastSyncAt,
+			pq.Array(&i.Members),
+			&i.RotationID,
+			&i.UsergroupID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const uGSyncScheduleRuleExists = `-- name: UGSyncScheduleRuleExists :one
+SELECT
+    EXISTS (
+        SELECT
+            1
+        FROM
+            schedule_data sd
+            CROSS JOIN LATERAL jsonb_array_elements(
+                CASE jsonb_typeof(sd.data -> 'V1' -> 'OnCallNotificationRules')
+                WHEN 'array' THEN
+                    sd.data -> 'V1' -> 'OnCallNotificationRules'
+                ELSE
+                    '[]'::jsonb
+                END) r
+            JOIN notification_channels nc ON nc.id =(r ->> 'ChannelID')::uuid
+        WHERE
+            nc.dest ->> 'Type' = 'builtin-slack-usergroup'
+            AND nc.dest -> 'Args' ->> 'slack_usergroup_id' = $1::text)
+`
+
+// Returns true if a schedule on-call notification rule updates the user group.
+func (q *Queries) UGSyncScheduleRuleExists(ctx context.Context, usergroupID string) (bool, error) {
+	row := q.db.QueryRowContext(ctx, uGSyncScheduleRuleExists, usergroupID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
 const updateCalSub = `-- name: UpdateCalSub :exec
 UPDATE
     user_calendar_subscriptions
@@ -6158,3 +7932,47 @@ func (q *Queries) UserFavUnset(ctx context.Context, arg UserFavUnsetParams) erro
 	)
 	return err
 }
+
+const webhookDeleteSigningSecret = `-- name: WebhookDeleteSigningSecret :exec
+DELETE FROM webhook_signing_secrets
+WHERE webhook_url = $1
+`
+
+func (q *Queries) WebhookDeleteSigningSecret(ctx context.Context, webhookUrl string) error {
+	_, err := q.db.ExecContext(ctx, webhookDeleteSigningSecret, webhookUrl)
+	return err
+}
+
+const webhookSetSigningSecret = `-- name: WebhookSetSigningSecret :exec
+INSERT INTO webhook_signing_secrets(webhook_url, secret)
+    VALUES ($1, $2)
+ON CONFLICT (webhook_url)
+    DO UPDATE SET
+        secret = $2, created_at = now()
+`
+
+type WebhookSetSigningSecretParams struct {
+	WebhookUrl string
+	Secret     []byte
+}
+
+func (q *Queries) WebhookSetSigningSecret(ctx context.Context, arg WebhookSetSigningSecretParams) error {
+	_, err := q.db.ExecContext(ctx, webhookSetSigningSecret, arg.WebhookUrl, arg.Secret)
+	return err
+}
+
+const webhookSigningSecret = `-- name: WebhookSigningSecret :one
+SELECT
+    secret
+FROM
+    webhook_signing_secrets
+WHERE
+    webhook_url = $1
+`
+
+func (q *Queries) WebhookSigningSecret(ctx context.Context, webhookUrl string) ([]byte, error) {
+	row := q.db.QueryRowContext(ctx, webhookSigningSecret, webhookUrl)
+	var secret []byte
+	err := row.Scan(&secret)
+	return secret, err
+}
@@ -65,6 +65,9 @@ type UIKRuleV1 struct {
 	Actions       []UIKActionV1
 
 	ContinueAfterMatch bool
+
+	// ResponseStatus, if set, is the HTTP status code returned to the caller when the rule matches.
+	ResponseStatus int
 }
 
 // UIKActionV1 is a single action to take if a rule matches.
@@ -65,7 +65,7 @@ func (h *Handler) ServeHeartbeatCheck(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// ServeCreateAlert allows creating or closing an alert.
+// ServeCreateAlert allows creating, acknowledging, or closing an alert.
 func (h *Handler) ServeCreateAlert(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -79,6 +79,7 @@ func (h *Handler) ServeCreateAlert(w http.ResponseWriter, r *http.Request) {
 	details := r.FormValue("details")
 	action := r.FormValue("action")
 	dedup := r.FormValue("dedup")
+	severity := r.FormValue("severity")
 
 	meta := make(map[string]string)
 	for _, v := range r.Form["meta"] {
@@ -97,8 +98,8 @@ func (h *Handler) ServeCreateAlert(w http.ResponseWriter, r *http.Request) {
 		}
 
 		var b struct {
-			Summary, Details, Action, Dedup *string
-			Meta                            map[string]string
+			Summary, Details, Action, Dedup, Severity *string
+			Meta                                      map[string]string
 		}
 		err = json.Unmarshal(data, &b)
 		if errutil.HTTPError(ctx, w, validation.WrapError(err)) {
@@ -117,14 +118,30 @@ func (h *Handler) ServeCreateAlert(w http.ResponseWriter, r *http.Request) {
 		if b.Action != nil {
 			action = *b.Action
 		}
+		if b.Severity != nil {
+			severity = *b.Severity
+		}
 		if b.Meta != nil {
 			meta = b.Meta
 		}
 	}
 
 	status := alert.StatusTriggered
-	if action == "close" {
+	switch action {
+	case "close":
 		status = alert.StatusClosed
+	case "ack":
+		status = alert.StatusActive
+	}
+
+	var sev alert.Severity
+	if severity != "" {
+		var ok bool
+		sev, ok = alert.ParseSeverity(severity)
+		if !ok {
+			errutil.HTTPError(ctx, w, validation.NewFieldError("severity", "unknown severity "+severity))
+			return
+		}
 	}
 
 	summary = validate.SanitizeText(summary, alert.MaxSummaryLength)
@@ -137,6 +154,7 @@ func (h *Handler) ServeCreateAlert(w http.ResponseWriter, r *http.Request) {
 		ServiceID: serviceID,
 		Dedup:     alert.NewUserDedup(dedup),
 		Status:    status,
+		Severity:  sev,
 	}
 
 	var resp struct {
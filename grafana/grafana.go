@@ -158,6 +158,7 @@ func alertsFromV1(ctx context.Context, serviceID string, data []byte) ([]alert.A
 		if summary == "" {
 			summary = a.Labels["alertname"]
 		}
+		sev, _ := alert.ParseSeverity(a.Labels["severity"])
 
 		alerts = append(alerts, alert.Alert{
 			Summary:   validate.SanitizeText(summary, alert.MaxSummaryLength),
@@ -165,6 +166,7 @@ func alertsFromV1(ctx context.Context, serviceID string, data []byte) ([]alert.A
 			Status:    alertStatus,
 			ServiceID: serviceID,
 			Source:    alert.SourceGrafana,
+			Severity:  sev,
 			Dedup:     alert.NewUserDedup(a.Fingerprint),
 		})
 	}
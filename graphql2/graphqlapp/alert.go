@@ -47,6 +47,15 @@ func (a *AlertLogEntry) MessageID(ctx context.Context, obj *alertlog.Entry) (*st
 	return &id, nil
 }
 
+func (a *AlertLogEntry) Note(ctx context.Context, obj *alertlog.Entry) (*string, error) {
+	meta, ok := obj.Meta(ctx).(*alertlog.NoteMetaData)
+	if !ok || meta == nil {
+		return nil, nil
+	}
+
+	return &meta.Note, nil
+}
+
 func (a *AlertLogEntry) ID(ctx context.Context, obj *alertlog.Entry) (int, error) {
 	e := *obj
 	return e.ID(), nil
@@ -280,6 +289,8 @@ func (q *Query) Alerts(ctx context.Context, opts *graphql2.AlertSearchOptions) (
 			}
 		}
 
+		s.Severity = opts.FilterBySeverity
+
 		for _, f := range opts.FilterByStatus {
 			switch f {
 			case graphql2.AlertStatusStatusAcknowledged:
@@ -405,6 +416,9 @@ func (m *Mutation) CreateAlert(ctx context.Context, input graphql2.CreateAlertIn
 	if input.Details != nil {
 		a.Details = *input.Details
 	}
+	if input.Severity != nil {
+		a.Severity = *input.Severity
+	}
 
 	if input.Sanitize != nil && *input.Sanitize {
 		a.Summary = validate.SanitizeText(a.Summary, alert.MaxSummaryLength)
@@ -478,6 +492,28 @@ func (m *Mutation) SetAlertNoiseReason(ctx context.Context, input graphql2.SetAl
 	return true, nil
 }
 
+func (m *Mutation) AddAlertNote(ctx context.Context, input graphql2.AddAlertNoteInput) (bool, error) {
+	var notify bool
+	if input.NotifySubscribers != nil {
+		notify = *input.NotifySubscribers
+	}
+
+	err := m.AlertStore.AddNote(ctx, input.AlertID, input.Note, notify)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (m *Mutation) ReassignAlert(ctx context.Context, input graphql2.ReassignAlertInput) (*alert.Alert, error) {
+	err := m.AlertStore.Reassign(ctx, input.AlertID, input.ServiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.AlertStore.FindOne(ctx, input.AlertID)
+}
+
 func (a *Alert) RecentEvents(ctx context.Context, obj *alert.Alert, opts *graphql2.AlertRecentEventsOptions) (*graphql2.AlertLogEntryConnection, error) {
 	return (*App)(a).RecentAlertEvents(ctx, opts, alertlog.SearchOptions{FilterAlertIDs: []int{obj.ID}})
 }
@@ -603,6 +639,9 @@ func (m *Mutation) UpdateAlerts(ctx context.Context, args graphql2.UpdateAlertsI
 	if args.NewStatus != nil && args.NoiseReason != nil {
 		return nil, validation.NewGenericError("cannot set both 'newStatus' and 'noiseReason'")
 	}
+	if args.SnoozeMinutes != nil && (args.NewStatus != nil || args.NoiseReason != nil) {
+		return nil, validation.NewGenericError("cannot set 'snoozeMinutes' with 'newStatus' or 'noiseReason'")
+	}
 
 	var updatedIDs []int
 	if args.NewStatus != nil {
@@ -633,6 +672,14 @@ func (m *Mutation) UpdateAlerts(ctx context.Context, args graphql2.UpdateAlertsI
 		}
 	}
 
+	if args.SnoozeMinutes != nil {
+		var err error
+		updatedIDs, err = m.AlertStore.SnoozeMany(ctx, args.AlertIDs, *args.SnoozeMinutes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return m.AlertStore.FindMany(ctx, updatedIDs)
 }
 
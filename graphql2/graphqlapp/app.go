@@ -29,6 +29,7 @@ import (
 	"github.com/target/goalert/escalation"
 	"github.com/target/goalert/graphql2"
 	"github.com/target/goalert/heartbeat"
+	"github.com/target/goalert/incident"
 	"github.com/target/goalert/integrationkey"
 	"github.com/target/goalert/keyring"
 	"github.com/target/goalert/label"
@@ -38,6 +39,7 @@ import (
 	"github.com/target/goalert/notification/nfydest"
 	"github.com/target/goalert/notification/slack"
 	"github.com/target/goalert/notification/twilio"
+	"github.com/target/goalert/notification/webhook"
 	"github.com/target/goalert/notificationchannel"
 	"github.com/target/goalert/oncall"
 	"github.com/target/goalert/override"
@@ -46,12 +48,14 @@ import (
 	"github.com/target/goalert/schedule/rotation"
 	"github.com/target/goalert/schedule/rule"
 	"github.com/target/goalert/service"
+	"github.com/target/goalert/service/msgtemplate"
 	"github.com/target/goalert/swo"
 	"github.com/target/goalert/timezone"
 	"github.com/target/goalert/user"
 	"github.com/target/goalert/user/contactmethod"
 	"github.com/target/goalert/user/favorite"
 	"github.com/target/goalert/user/notificationrule"
+	"github.com/target/goalert/usergroupsync"
 	"github.com/target/goalert/util/calllimiter"
 	"github.com/target/goalert/util/errutil"
 	"github.com/target/goalert/util/log"
@@ -70,7 +74,9 @@ type App struct {
 	AlertStore        *alert.Store
 	AlertMetricsStore *alertmetrics.Store
 	AlertLogStore     *alertlog.Store
+	IncidentStore     *incident.Store
 	ServiceStore      *service.Store
+	MsgTemplateStore  *msgtemplate.Store
 	FavoriteStore     *favorite.Store
 	PolicyStore       *escalation.Store
 	ScheduleStore     *schedule.Store
@@ -86,6 +92,7 @@ type App struct {
 	SlackStore        *slack.ChannelSender
 	HeartbeatStore    *heartbeat.Store
 	NoticeStore       *notice.Store
+	UGSyncStore       *usergroupsync.Store
 	APIKeyStore       *apikey.Store
 
 	AuthLinkStore *authlink.Store
@@ -101,6 +108,8 @@ type App struct {
 
 	EncryptionKeys keyring.Keys
 
+	WebhookSecretStore *webhook.SecretStore
+
 	SWO *swo.Manager
 
 	DestReg *nfydest.Registry
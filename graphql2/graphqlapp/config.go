@@ -17,10 +17,12 @@ func (a *Query) IntegrationKeyTypes(ctx context.Context) ([]graphql2.Integration
 		{ID: "grafana", Name: "Grafana", Label: "Grafana Webhook URL", Enabled: true},
 		{ID: "site24x7", Name: "Site 24x7", Label: "Site24x7 Webhook URL", Enabled: true},
 		{ID: "prometheusAlertmanager", Label: "Alertmanager Webhook URL", Name: "Prometheus Alertmanager", Enabled: true},
+		{ID: "pagerduty", Label: "Events API v2 URL (use the key ID as the routing key)", Name: "PagerDuty Events API v2", Enabled: true},
 	}
 
 	if expflag.ContextHas(ctx, expflag.UnivKeys) {
 		typeInfo = append(typeInfo, graphql2.IntegrationKeyTypeInfo{ID: "universal", Label: "Universal Integration Key URL", Name: "Universal Integration Key", Enabled: true})
+		typeInfo = append(typeInfo, graphql2.IntegrationKeyTypeInfo{ID: "otlp", Label: "OTLP/HTTP Endpoint", Name: "OpenTelemetry (OTLP)", Enabled: true})
 	}
 
 	return typeInfo, nil
@@ -121,6 +121,7 @@ func (m *Mutation) CreateUserContactMethod(ctx context.Context, input graphql2.C
 		UserID:        input.UserID,
 		Disabled:      true,
 		StatusUpdates: input.EnableStatusUpdates != nil && *input.EnableStatusUpdates,
+		ActiveWindow:  input.ActiveWindow,
 	}
 
 	if input.Dest != nil {
@@ -194,6 +195,10 @@ func (m *Mutation) UpdateUserContactMethod(ctx context.Context, input graphql2.U
 			cm.StatusUpdates = *input.EnableStatusUpdates
 		}
 
+		if input.ActiveWindow != nil {
+			cm.ActiveWindow = input.ActiveWindow
+		}
+
 		return m.CMStore.Update(ctx, tx, cm)
 	})
 	return err == nil, err
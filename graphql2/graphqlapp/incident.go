@@ -0,0 +1,207 @@
+package graphqlapp
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/target/goalert/alert"
+	"github.com/target/goalert/graphql2"
+	"github.com/target/goalert/incident"
+	"github.com/target/goalert/search"
+	"github.com/target/goalert/user"
+	"github.com/target/goalert/validation"
+	"github.com/target/goalert/validation/validate"
+)
+
+type (
+	Incident       App
+	IncidentUpdate App
+)
+
+func (a *App) Incident() graphql2.IncidentResolver             { return (*Incident)(a) }
+func (a *App) IncidentUpdate() graphql2.IncidentUpdateResolver { return (*IncidentUpdate)(a) }
+
+func (q *Query) Incident(ctx context.Context, id int) (*incident.Incident, error) {
+	inc, err := q.IncidentStore.FindOne(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return inc, nil
+}
+
+func (q *Query) Incidents(ctx context.Context, opts *graphql2.IncidentSearchOptions) (conn *graphql2.IncidentConnection, err error) {
+	if opts == nil {
+		opts = new(graphql2.IncidentSearchOptions)
+	}
+
+	var s incident.SearchOptions
+	if opts.First != nil {
+		s.Limit = *opts.First
+	}
+	if s.Limit == 0 {
+		s.Limit = 15
+	}
+	if opts.Search != nil {
+		s.Search = *opts.Search
+	}
+	s.Omit = opts.Omit
+
+	err = validate.Range("First", s.Limit, 1, 100)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.After != nil && *opts.After != "" {
+		err = search.ParseCursor(*opts.After, &s)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		for _, f := range opts.FilterByStatus {
+			s.Status = append(s.Status, alertStatus(f))
+		}
+	}
+
+	s.Limit++
+
+	incs, err := q.IncidentStore.Search(ctx, &s)
+	if err != nil {
+		return nil, err
+	}
+
+	conn = new(graphql2.IncidentConnection)
+	conn.PageInfo = &graphql2.PageInfo{}
+	if len(incs) == s.Limit {
+		conn.PageInfo.HasNextPage = true
+		incs = incs[:len(incs)-1]
+	}
+	conn.Nodes = incs
+	if len(incs) > 0 {
+		s.After.ID = incs[len(incs)-1].ID
+		cur, err := search.Cursor(s)
+		if err != nil {
+			return nil, err
+		}
+		conn.PageInfo.EndCursor = &cur
+	}
+
+	return conn, nil
+}
+
+// alertStatus converts a GraphQL AlertStatus to the alert.Status it represents.
+func alertStatus(s graphql2.AlertStatus) alert.Status {
+	switch s {
+	case graphql2.AlertStatusStatusAcknowledged:
+		return alert.StatusActive
+	case graphql2.AlertStatusStatusClosed:
+		return alert.StatusClosed
+	}
+
+	return alert.StatusTriggered
+}
+
+func (i *Incident) Status(ctx context.Context, obj *incident.Incident) (graphql2.AlertStatus, error) {
+	return (*Alert)(i).Status(ctx, &alert.Alert{Status: obj.Status})
+}
+
+func (i *Incident) Alerts(ctx context.Context, obj *incident.Incident) ([]alert.Alert, error) {
+	ids, err := i.IncidentStore.AlertIDs(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.AlertStore.FindMany(ctx, ids)
+}
+
+func (i *Incident) Updates(ctx context.Context, obj *incident.Incident) ([]incident.Update, error) {
+	return i.IncidentStore.Updates(ctx, obj.ID)
+}
+
+func (i *IncidentUpdate) User(ctx context.Context, obj *incident.Update) (*user.User, error) {
+	if obj.UserID == "" {
+		return nil, nil
+	}
+
+	return (*App)(i).FindOneUser(ctx, obj.UserID)
+}
+
+func (m *Mutation) CreateIncident(ctx context.Context, input graphql2.CreateIncidentInput) (*incident.Incident, error) {
+	inc := &incident.Incident{Title: input.Title}
+	if input.Description != nil {
+		inc.Description = *input.Description
+	}
+
+	return m.IncidentStore.Create(ctx, inc, input.AlertIDs)
+}
+
+func (m *Mutation) UpdateIncident(ctx context.Context, input graphql2.UpdateIncidentInput) (bool, error) {
+	if input.Title != nil || input.Description != nil {
+		inc, err := m.IncidentStore.FindOne(ctx, input.ID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, validation.NewFieldError("ID", "incident not found")
+		}
+		if err != nil {
+			return false, err
+		}
+		if input.Title != nil {
+			inc.Title = *input.Title
+		}
+		if input.Description != nil {
+			inc.Description = *input.Description
+		}
+
+		err = m.IncidentStore.Update(ctx, inc)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	if input.NewStatus != nil {
+		var err error
+		switch *input.NewStatus {
+		case graphql2.AlertStatusStatusAcknowledged:
+			err = m.IncidentStore.Acknowledge(ctx, input.ID)
+		case graphql2.AlertStatusStatusClosed:
+			err = m.IncidentStore.Close(ctx, input.ID)
+		default:
+			err = validation.NewFieldError("NewStatus", "must be acknowledged or closed")
+		}
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+func (m *Mutation) AddIncidentAlerts(ctx context.Context, input graphql2.IncidentAlertsInput) (bool, error) {
+	err := m.IncidentStore.AddAlerts(ctx, input.IncidentID, input.AlertIDs)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (m *Mutation) RemoveIncidentAlerts(ctx context.Context, input graphql2.IncidentAlertsInput) (bool, error) {
+	err := m.IncidentStore.RemoveAlerts(ctx, input.IncidentID, input.AlertIDs)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (m *Mutation) AddIncidentUpdate(ctx context.Context, input graphql2.AddIncidentUpdateInput) (bool, error) {
+	err := m.IncidentStore.AddUpdate(ctx, input.IncidentID, input.Message)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
@@ -197,12 +197,33 @@ func (m *Mutation) CreateIntegrationKey(ctx context.Context, input graphql2.Crea
 		if input.ExternalSystemName != nil {
 			key.ExternalSystemName = *input.ExternalSystemName
 		}
+		if input.AutoCloseMinutes != nil {
+			key.AutoCloseMinutes = *input.AutoCloseMinutes
+		}
 		key, err = m.IntKeyStore.Create(ctx, tx, key)
 		return err
 	})
 	return key, err
 }
 
+func (m *Mutation) UpdateIntegrationKey(ctx context.Context, input graphql2.UpdateIntegrationKeyInput) (bool, error) {
+	err := withContextTx(ctx, m.DB, func(ctx context.Context, tx *sql.Tx) error {
+		if input.AutoCloseMinutes != nil {
+			err := m.IntKeyStore.SetAutoClose(ctx, tx, input.ID, *input.AutoCloseMinutes)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
 func (key *IntegrationKey) Config(ctx context.Context, raw *integrationkey.IntegrationKey) (*gadb.UIKConfigV1, error) {
 	id, err := validate.ParseUUID("IntegrationKey.ID", raw.ID)
 	if err != nil {
@@ -229,6 +250,8 @@ func (key *IntegrationKey) Href(ctx context.Context, raw *integrationkey.Integra
 		return cfg.CallbackURL("/api/v2/site24x7/incoming", q), nil
 	case integrationkey.TypePrometheusAlertmanager:
 		return cfg.CallbackURL("/api/v2/prometheusalertmanager/incoming", q), nil
+	case integrationkey.TypePagerDuty:
+		return cfg.CallbackURL("/api/v2/pagerduty/enqueue", q), nil
 	case integrationkey.TypeEmail:
 		if !cfg.EmailIngressEnabled() {
 			return "", nil
@@ -236,6 +259,9 @@ func (key *IntegrationKey) Href(ctx context.Context, raw *integrationkey.Integra
 		return "mailto:" + raw.ID + "@" + cfg.EmailIngressDomain(), nil
 	case integrationkey.TypeUniversal:
 		return cfg.CallbackURL("/api/v2/uik"), nil
+	case integrationkey.TypeOTLP:
+		// OTLP exporters append the signal path (e.g., /v1/logs) to the configured endpoint
+		return cfg.CallbackURL("/api/v2/otlp"), nil
 	}
 
 	return "", nil
@@ -0,0 +1,90 @@
+package graphqlapp
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/graphql2"
+	"github.com/target/goalert/integrationkey"
+	"github.com/target/goalert/integrationkey/uik"
+	"github.com/target/goalert/validation"
+	"github.com/target/goalert/validation/validate"
+)
+
+type KeySample App
+
+func (a *App) KeySample() graphql2.KeySampleResolver { return (*KeySample)(a) }
+
+func (s *KeySample) Body(ctx context.Context, raw *integrationkey.Sample) (string, error) {
+	return string(raw.Body), nil
+}
+
+func (key *IntegrationKey) Samples(ctx context.Context, raw *integrationkey.IntegrationKey) ([]integrationkey.Sample, error) {
+	id, err := validate.ParseUUID("IntegrationKey.ID", raw.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return key.IntKeyStore.Samples(ctx, key.DB, id)
+}
+
+func (q *Query) TestKeyConfig(ctx context.Context, input graphql2.TestKeyConfigInput) (*graphql2.KeyConfigTestResult, error) {
+	keyID, err := validate.ParseUUID("KeyID", input.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	var smp *integrationkey.Sample
+	switch {
+	case input.SampleID != nil && input.Body != nil:
+		return nil, validation.NewFieldError("Body", "cannot be used with SampleID")
+	case input.SampleID != nil:
+		smpID, err := validate.ParseUUID("SampleID", *input.SampleID)
+		if err != nil {
+			return nil, err
+		}
+		smp, err = q.IntKeyStore.FindSample(ctx, q.DB, keyID, smpID)
+		if err != nil {
+			return nil, err
+		}
+		if smp == nil {
+			return nil, validation.NewFieldError("SampleID", "not found")
+		}
+	case input.Body != nil:
+		smp = &integrationkey.Sample{Body: json.RawMessage(*input.Body)}
+		if input.Query != nil {
+			smp.Query = *input.Query
+		}
+	default:
+		return nil, validation.NewFieldError("Body", "required if SampleID is not provided")
+	}
+
+	cfg, err := q.IntKeyStore.Config(ctx, q.DB, keyID)
+	if err != nil {
+		return nil, err
+	}
+	if input.Rules != nil {
+		cfg.Rules = input.Rules
+	}
+	if input.DefaultActions != nil {
+		cfg.DefaultActions = input.DefaultActions
+	}
+
+	res, err := uik.DryRun(*cfg, *smp)
+	if err != nil {
+		return nil, err
+	}
+
+	matched := make([]gadb.UIKRuleV1, len(res.MatchedRules))
+	for i, idx := range res.MatchedRules {
+		matched[i] = cfg.Rules[idx]
+	}
+
+	return &graphql2.KeyConfigTestResult{
+		MatchedRules:       matched,
+		UsedDefaultActions: res.UsedDefaultActions,
+		Actions:            res.Actions,
+		ResponseStatus:     res.ResponseStatus,
+	}, nil
+}
@@ -0,0 +1,88 @@
+package graphqlapp
+
+import (
+	"context"
+
+	"github.com/target/goalert/graphql2"
+	"github.com/target/goalert/service"
+	"github.com/target/goalert/service/msgtemplate"
+	"github.com/target/goalert/validation"
+)
+
+func (s *Service) MessageTemplates(ctx context.Context, raw *service.Service) ([]msgtemplate.Template, error) {
+	return s.MsgTemplateStore.FindAll(ctx, raw.ID)
+}
+
+func (m *Mutation) SetServiceMessageTemplate(ctx context.Context, input graphql2.SetServiceMessageTemplateInput) (bool, error) {
+	t := msgtemplate.Template{
+		ServiceID:       input.ServiceID,
+		SummaryTemplate: input.SummaryTemplate,
+		DetailsTemplate: input.DetailsTemplate,
+	}
+	if input.DestType != nil {
+		t.DestType = *input.DestType
+	}
+
+	err := m.MsgTemplateStore.Set(ctx, t)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (m *Mutation) RenderServiceMessageTemplate(ctx context.Context, input graphql2.RenderServiceMessageTemplateInput) (*graphql2.RenderedServiceMessageTemplate, error) {
+	t, err := msgtemplate.Template{
+		ServiceID:       input.ServiceID,
+		SummaryTemplate: input.SummaryTemplate,
+		DetailsTemplate: input.DetailsTemplate,
+	}.Normalize()
+	if err != nil {
+		return nil, err
+	}
+
+	svc, err := m.ServiceStore.FindOne(ctx, input.ServiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	data := msgtemplate.Data{
+		Summary:     "Example alert summary",
+		Details:     "Example alert details.",
+		ServiceID:   svc.ID,
+		ServiceName: svc.Name,
+		Meta:        make(map[string]string, len(input.Meta)),
+	}
+	for _, md := range input.Meta {
+		data.Meta[md.Key] = md.Value
+	}
+
+	if input.AlertID != nil {
+		a, err := m.AlertStore.FindOne(ctx, *input.AlertID)
+		if err != nil {
+			return nil, err
+		}
+		if a.ServiceID != svc.ID {
+			return nil, validation.NewFieldError("AlertID", "alert does not belong to the service")
+		}
+		meta, err := m.AlertStore.Metadata(ctx, m.DB, a.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		data.AlertID = a.ID
+		data.Summary = a.Summary
+		data.Details = a.Details
+		data.Meta = meta
+	}
+
+	r, err := t.Render(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &graphql2.RenderedServiceMessageTemplate{
+		Summary: r.Summary,
+		Details: r.Details,
+	}, nil
+}
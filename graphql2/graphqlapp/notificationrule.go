@@ -33,6 +33,21 @@ func (m *Mutation) CreateUserNotificationRule(ctx context.Context, input graphql
 		nr.ContactMethodID = id
 	}
 
+	if input.Filter != nil {
+		if input.Filter.MinSeverity != nil {
+			nr.Filter.MinSeverity = *input.Filter.MinSeverity
+		}
+		if input.Filter.ServiceID != nil {
+			nr.Filter.ServiceID = *input.Filter.ServiceID
+		}
+		if input.Filter.LabelKey != nil {
+			nr.Filter.LabelKey = *input.Filter.LabelKey
+		}
+		if input.Filter.LabelValue != nil {
+			nr.Filter.LabelValue = *input.Filter.LabelValue
+		}
+	}
+
 	err := withContextTx(ctx, m.DB, func(ctx context.Context, tx *sql.Tx) error {
 		var err error
 		nr, err = m.NRStore.CreateTx(ctx, tx, nr)
@@ -48,3 +63,23 @@ func (m *Mutation) CreateUserNotificationRule(ctx context.Context, input graphql
 func (nr *UserNotificationRule) ContactMethod(ctx context.Context, raw *notificationrule.NotificationRule) (*contactmethod.ContactMethod, error) {
 	return (*App)(nr).FindOneCM(ctx, raw.ContactMethodID)
 }
+
+func (nr *UserNotificationRule) Filter(ctx context.Context, raw *notificationrule.NotificationRule) (*graphql2.NotificationRuleFilter, error) {
+	if raw.Filter.IsZero() {
+		return nil, nil
+	}
+
+	var f graphql2.NotificationRuleFilter
+	if raw.Filter.MinSeverity != "" {
+		f.MinSeverity = &raw.Filter.MinSeverity
+	}
+	if raw.Filter.ServiceID != "" {
+		f.ServiceID = &raw.Filter.ServiceID
+	}
+	if raw.Filter.LabelKey != "" {
+		f.LabelKey = &raw.Filter.LabelKey
+		f.LabelValue = &raw.Filter.LabelValue
+	}
+
+	return &f, nil
+}
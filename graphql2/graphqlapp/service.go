@@ -216,6 +216,9 @@ func (m *Mutation) CreateService(ctx context.Context, input graphql2.CreateServi
 		if input.Description != nil {
 			svc.Description = *input.Description
 		}
+		if input.ConferenceNumber != nil {
+			svc.ConferenceNumber = *input.ConferenceNumber
+		}
 		if input.NewEscalationPolicy != nil {
 			// Set tempUUID so that Normalize won't fail on the yet-to-be-created
 			// escalation policy.
@@ -242,6 +245,14 @@ func (m *Mutation) CreateService(ctx context.Context, input graphql2.CreateServi
 			return err
 		}
 
+		if svc.ConferenceNumber != "" {
+			err = m.ServiceStore.SetConferenceNumberTx(ctx, tx, result.ID, svc.ConferenceNumber)
+			if err != nil {
+				return err
+			}
+			result.ConferenceNumber = svc.ConferenceNumber
+		}
+
 		if input.Favorite != nil && *input.Favorite {
 			err = m.FavoriteStore.Set(ctx, tx, permission.UserID(ctx), assignment.ServiceTarget(result.ID))
 			if err != nil {
@@ -318,6 +329,14 @@ func (a *Mutation) UpdateService(ctx context.Context, input graphql2.UpdateServi
 		return false, err
 	}
 
+	// only attempt to change the number if it differs, so non-admins can still update other fields
+	if input.ConferenceNumber != nil && *input.ConferenceNumber != svc.ConferenceNumber {
+		err = a.ServiceStore.SetConferenceNumberTx(ctx, tx, svc.ID, *input.ConferenceNumber)
+		if err != nil {
+			return false, err
+		}
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return false, err
@@ -0,0 +1,51 @@
+package graphqlapp
+
+import (
+	"context"
+
+	"github.com/target/goalert/escalation"
+	"github.com/target/goalert/graphql2"
+	"github.com/target/goalert/notice"
+	"github.com/target/goalert/schedule/rotation"
+	"github.com/target/goalert/usergroupsync"
+)
+
+func (r *Rotation) SlackUserGroupSyncs(ctx context.Context, raw *rotation.Rotation) ([]usergroupsync.Sync, error) {
+	return r.UGSyncStore.FindManyByRotation(ctx, raw.ID)
+}
+
+func (r *Rotation) Notices(ctx context.Context, raw *rotation.Rotation) ([]notice.Notice, error) {
+	return r.NoticeStore.FindAllRotationNotices(ctx, raw.ID)
+}
+
+func (step *EscalationPolicyStep) SlackUserGroupSyncs(ctx context.Context, raw *escalation.Step) ([]usergroupsync.Sync, error) {
+	return step.UGSyncStore.FindManyByEPStep(ctx, raw.ID.String())
+}
+
+func (m *Mutation) CreateSlackUserGroupSync(ctx context.Context, input graphql2.CreateSlackUserGroupSyncInput) (*usergroupsync.Sync, error) {
+	s := usergroupsync.Sync{
+		UserGroupID: input.UserGroupID,
+	}
+	if input.RotationID != nil {
+		s.RotationID = *input.RotationID
+	}
+	if input.EscalationPolicyStepID != nil {
+		s.EPStepID = *input.EscalationPolicyStepID
+	}
+
+	err := m.SlackStore.ValidateUserGroup(ctx, input.UserGroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.UGSyncStore.Create(ctx, s)
+}
+
+func (m *Mutation) DeleteSlackUserGroupSync(ctx context.Context, id string) (bool, error) {
+	err := m.UGSyncStore.Delete(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,46 @@
+package graphqlapp
+
+import (
+	"context"
+
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/notification/webhook"
+	"github.com/target/goalert/validation"
+)
+
+// webhookURL validates that dest is a webhook destination and returns its URL.
+func (a *Mutation) webhookURL(ctx context.Context, dest gadb.DestV1) (string, error) {
+	if dest.Type != webhook.DestTypeWebhook {
+		return "", validation.NewFieldError("Dest.Type", "must be a webhook destination")
+	}
+
+	err := (*App)(a).ValidateDestination(ctx, "dest", &dest)
+	if err != nil {
+		return "", err
+	}
+
+	return dest.Arg(webhook.FieldWebhookURL), nil
+}
+
+func (a *Mutation) GenerateWebhookSigningSecret(ctx context.Context, dest gadb.DestV1) (string, error) {
+	u, err := a.webhookURL(ctx, dest)
+	if err != nil {
+		return "", err
+	}
+
+	return a.WebhookSecretStore.Generate(ctx, u)
+}
+
+func (a *Mutation) DeleteWebhookSigningSecret(ctx context.Context, dest gadb.DestV1) (bool, error) {
+	u, err := a.webhookURL(ctx, dest)
+	if err != nil {
+		return false, err
+	}
+
+	err = a.WebhookSecretStore.Delete(ctx, u)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
@@ -19,6 +19,8 @@ func MapConfigHints(cfg config.Hints) []ConfigHint {
 		{ID: "Twilio.MessageWebhookURL", Value: cfg.Twilio.MessageWebhookURL},
 		{ID: "Twilio.VoiceWebhookURL", Value: cfg.Twilio.VoiceWebhookURL},
 		{ID: "Slack.InteractivityResponseURL", Value: cfg.Slack.InteractivityResponseURL},
+		{ID: "Slack.MenuOptionsURL", Value: cfg.Slack.MenuOptionsURL},
+		{ID: "Slack.SlashCommandURL", Value: cfg.Slack.SlashCommandURL},
 	}
 }
 
@@ -34,6 +36,7 @@ func MapConfigValues(cfg config.Config) []ConfigValue {
 		{ID: "General.DisableSMSLinks", Type: ConfigTypeBoolean, Description: "If set, SMS messages will not contain a URL pointing to GoAlert.", Value: fmt.Sprintf("%t", cfg.General.DisableSMSLinks)},
 		{ID: "General.DisableLabelCreation", Type: ConfigTypeBoolean, Description: "Disables the ability to create new labels for services.", Value: fmt.Sprintf("%t", cfg.General.DisableLabelCreation)},
 		{ID: "General.DisableCalendarSubscriptions", Type: ConfigTypeBoolean, Description: "If set, disables all active calendar subscriptions as well as the ability to create new calendar subscriptions.", Value: fmt.Sprintf("%t", cfg.General.DisableCalendarSubscriptions)},
+		{ID: "General.AlertSnoozeMinutes", Type: ConfigTypeInteger, Description: "Number of minutes an alert is snoozed for when using Slack or SMS replies (0 means 60 minutes).", Value: fmt.Sprintf("%d", cfg.General.AlertSnoozeMinutes)},
 		{ID: "Services.RequiredLabels", Type: ConfigTypeStringList, Description: "List of label names to require new services to define.", Value: strings.Join(cfg.Services.RequiredLabels, "\n")},
 		{ID: "Maintenance.AlertCleanupDays", Type: ConfigTypeInteger, Description: "Closed alerts will be deleted after this many days (0 means disable cleanup).", Value: fmt.Sprintf("%d", cfg.Maintenance.AlertCleanupDays)},
 		{ID: "Maintenance.AlertAutoCloseDays", Type: ConfigTypeInteger, Description: "Unacknowledged alerts will automatically be closed after this many days of inactivity. (0 means disable auto-close).", Value: fmt.Sprintf("%d", cfg.Maintenance.AlertAutoCloseDays)},
@@ -67,8 +70,14 @@ func MapConfigValues(cfg config.Config) []ConfigValue {
 		{ID: "Slack.ClientSecret", Type: ConfigTypeString, Description: "", Value: cfg.Slack.ClientSecret, Password: true},
 		{ID: "Slack.AccessToken", Type: ConfigTypeString, Description: "Slack app bot user OAuth access token (should start with xoxb-).", Value: cfg.Slack.AccessToken, Password: true},
 		{ID: "Slack.SigningSecret", Type: ConfigTypeString, Description: "Signing secret to verify requests from slack.", Value: cfg.Slack.SigningSecret, Password: true},
-		{ID: "Slack.InteractiveMessages", Type: ConfigTypeBoolean, Description: "Enable interactive messages (e.g. buttons).", Value: fmt.Sprintf("%t", cfg.Slack.InteractiveMessages)},
+		{ID: "Slack.InteractiveMessages", Type: ConfigTypeBoolean, Description: "Enable interactive messages (e.g. buttons), modals, and the /goalert slash command.", Value: fmt.Sprintf("%t", cfg.Slack.InteractiveMessages)},
 		{ID: "Slack.DisableBroadcastThreadReplies", Type: ConfigTypeBoolean, Description: "Disable broadcasting alert status updates in threads to the main channel.", Value: fmt.Sprintf("%t", cfg.Slack.DisableBroadcastThreadReplies)},
+		{ID: "Discord.Enable", Type: ConfigTypeBoolean, Description: "Enables sending notifications to Discord channels via webhooks.", Value: fmt.Sprintf("%t", cfg.Discord.Enable)},
+		{ID: "Mattermost.Enable", Type: ConfigTypeBoolean, Description: "Enables sending notifications to Mattermost channels via incoming webhooks.", Value: fmt.Sprintf("%t", cfg.Mattermost.Enable)},
+		{ID: "Mattermost.URL", Type: ConfigTypeString, Description: "Base URL of the Mattermost server (e.g., https://mattermost.example.com). Required to use the Bot Token.", Value: cfg.Mattermost.URL},
+		{ID: "Mattermost.BotToken", Type: ConfigTypeString, Description: "Access token of a Mattermost bot account. If set, messages to channels with a Channel ID are posted by the bot so alert updates can be threaded.", Value: cfg.Mattermost.BotToken, Password: true},
+		{ID: "Teams.Enable", Type: ConfigTypeBoolean, Description: "Enables sending notifications to Microsoft Teams channels via incoming webhooks (Workflows).", Value: fmt.Sprintf("%t", cfg.Teams.Enable)},
+		{ID: "Teams.InteractiveMessages", Type: ConfigTypeBoolean, Description: "Add Acknowledge and Close buttons to alert messages. Buttons open the alert in GoAlert, where the action is confirmed by the logged-in user.", Value: fmt.Sprintf("%t", cfg.Teams.InteractiveMessages)},
 		{ID: "Twilio.Enable", Type: ConfigTypeBoolean, Description: "Enables sending and processing of Voice and SMS messages through the Twilio notification provider.", Value: fmt.Sprintf("%t", cfg.Twilio.Enable)},
 		{ID: "Twilio.VoiceName", Type: ConfigTypeString, Description: "The Twilio voice to use for Text To Speech for phone calls. See https://www.twilio.com/docs/voice/twiml/say/text-speech#polly-standard-and-neural-voices", Value: cfg.Twilio.VoiceName},
 		{ID: "Twilio.VoiceLanguage", Type: ConfigTypeString, Description: "The Twilio voice language to use for Text To Speech for phone calls. See https://www.twilio.com/docs/voice/twiml/say/text-speech#polly-standard-and-neural-voices", Value: cfg.Twilio.VoiceLanguage},
@@ -78,6 +87,7 @@ func MapConfigValues(cfg config.Config) []ConfigValue {
 		{ID: "Twilio.FromNumber", Type: ConfigTypeString, Description: "The Twilio number to use for outgoing notifications.", Value: cfg.Twilio.FromNumber},
 		{ID: "Twilio.MessagingServiceSID", Type: ConfigTypeString, Description: "If set, replaces the use of From Number for SMS notifications.", Value: cfg.Twilio.MessagingServiceSID},
 		{ID: "Twilio.RCSSenderID", Type: ConfigTypeString, Description: "The sender ID for RCS messages. Required if RCS is enabled for the MessagingServiceSID.", Value: cfg.Twilio.RCSSenderID},
+		{ID: "Twilio.VoiceMinSeverity", Type: ConfigTypeString, Description: "If set, voice calls will only be made for alerts of at least this severity (critical, high, low, or info). Other contact methods are unaffected.", Value: cfg.Twilio.VoiceMinSeverity},
 		{ID: "Twilio.DisableTwoWaySMS", Type: ConfigTypeBoolean, Description: "Disables SMS reply codes for alert messages.", Value: fmt.Sprintf("%t", cfg.Twilio.DisableTwoWaySMS)},
 		{ID: "Twilio.SMSCarrierLookup", Type: ConfigTypeBoolean, Description: "Perform carrier lookup of SMS contact methods (required for SMSFromNumberOverride). Extra charges may apply.", Value: fmt.Sprintf("%t", cfg.Twilio.SMSCarrierLookup)},
 		{ID: "Twilio.SMSFromNumberOverride", Type: ConfigTypeStringList, Description: "List of 'carrier=number' pairs, SMS messages to numbers of the provided carrier string (exact match) will use the alternate From Number.", Value: strings.Join(cfg.Twilio.SMSFromNumberOverride, "\n")},
@@ -107,6 +117,7 @@ func MapPublicConfigValues(cfg config.Config) []ConfigValue {
 		{ID: "General.DisableSMSLinks", Type: ConfigTypeBoolean, Description: "If set, SMS messages will not contain a URL pointing to GoAlert.", Value: fmt.Sprintf("%t", cfg.General.DisableSMSLinks)},
 		{ID: "General.DisableLabelCreation", Type: ConfigTypeBoolean, Description: "Disables the ability to create new labels for services.", Value: fmt.Sprintf("%t", cfg.General.DisableLabelCreation)},
 		{ID: "General.DisableCalendarSubscriptions", Type: ConfigTypeBoolean, Description: "If set, disables all active calendar subscriptions as well as the ability to create new calendar subscriptions.", Value: fmt.Sprintf("%t", cfg.General.DisableCalendarSubscriptions)},
+		{ID: "General.AlertSnoozeMinutes", Type: ConfigTypeInteger, Description: "Number of minutes an alert is snoozed for when using Slack or SMS replies (0 means 60 minutes).", Value: fmt.Sprintf("%d", cfg.General.AlertSnoozeMinutes)},
 		{ID: "Services.RequiredLabels", Type: ConfigTypeStringList, Description: "List of label names to require new services to define.", Value: strings.Join(cfg.Services.RequiredLabels, "\n")},
 		{ID: "Maintenance.AlertCleanupDays", Type: ConfigTypeInteger, Description: "Closed alerts will be deleted after this many days (0 means disable cleanup).", Value: fmt.Sprintf("%d", cfg.Maintenance.AlertCleanupDays)},
 		{ID: "Maintenance.AlertAutoCloseDays", Type: ConfigTypeInteger, Description: "Unacknowledged alerts will automatically be closed after this many days of inactivity. (0 means disable auto-close).", Value: fmt.Sprintf("%d", cfg.Maintenance.AlertAutoCloseDays)},
@@ -119,6 +130,9 @@ func MapPublicConfigValues(cfg config.Config) []ConfigValue {
 		{ID: "Mailgun.Enable", Type: ConfigTypeBoolean, Description: "", Value: fmt.Sprintf("%t", cfg.Mailgun.Enable)},
 		{ID: "Slack.Enable", Type: ConfigTypeBoolean, Description: "", Value: fmt.Sprintf("%t", cfg.Slack.Enable)},
 		{ID: "Slack.DisableBroadcastThreadReplies", Type: ConfigTypeBoolean, Description: "Disable broadcasting alert status updates in threads to the main channel.", Value: fmt.Sprintf("%t", cfg.Slack.DisableBroadcastThreadReplies)},
+		{ID: "Discord.Enable", Type: ConfigTypeBoolean, Description: "Enables sending notifications to Discord channels via webhooks.", Value: fmt.Sprintf("%t", cfg.Discord.Enable)},
+		{ID: "Mattermost.Enable", Type: ConfigTypeBoolean, Description: "Enables sending notifications to Mattermost channels via incoming webhooks.", Value: fmt.Sprintf("%t", cfg.Mattermost.Enable)},
+		{ID: "Teams.Enable", Type: ConfigTypeBoolean, Description: "Enables sending notifications to Microsoft Teams channels via incoming webhooks (Workflows).", Value: fmt.Sprintf("%t", cfg.Teams.Enable)},
 		{ID: "Twilio.Enable", Type: ConfigTypeBoolean, Description: "Enables sending and processing of Voice and SMS messages through the Twilio notification provider.", Value: fmt.Sprintf("%t", cfg.Twilio.Enable)},
 		{ID: "Twilio.FromNumber", Type: ConfigTypeString, Description: "The Twilio number to use for outgoing notifications.", Value: cfg.Twilio.FromNumber},
 		{ID: "Twilio.MessagingServiceSID", Type: ConfigTypeString, Description: "If set, replaces the use of From Number for SMS notifications.", Value: cfg.Twilio.MessagingServiceSID},
@@ -195,6 +209,12 @@ func ApplyConfigValues(cfg config.Config, vals []ConfigValueInput) (config.Confi
 				return cfg, err
 			}
 			cfg.General.DisableCalendarSubscriptions = val
+		case "General.AlertSnoozeMinutes":
+			val, err := parseInt(v.ID, v.Value)
+			if err != nil {
+				return cfg, err
+			}
+			cfg.General.AlertSnoozeMinutes = val
 		case "Services.RequiredLabels":
 			cfg.Services.RequiredLabels = parseStringList(v.Value)
 		case "Maintenance.AlertCleanupDays":
@@ -321,6 +341,34 @@ func ApplyConfigValues(cfg config.Config, vals []ConfigValueInput) (config.Confi
 				return cfg, err
 			}
 			cfg.Slack.DisableBroadcastThreadReplies = val
+		case "Discord.Enable":
+			val, err := parseBool(v.ID, v.Value)
+			if err != nil {
+				return cfg, err
+			}
+			cfg.Discord.Enable = val
+		case "Mattermost.Enable":
+			val, err := parseBool(v.ID, v.Value)
+			if err != nil {
+				return cfg, err
+			}
+			cfg.Mattermost.Enable = val
+		case "Mattermost.URL":
+			cfg.Mattermost.URL = v.Value
+		case "Mattermost.BotToken":
+			cfg.Mattermost.BotToken = v.Value
+		case "Teams.Enable":
+			val, err := parseBool(v.ID, v.Value)
+			if err != nil {
+				return cfg, err
+			}
+			cfg.Teams.Enable = val
+		case "Teams.InteractiveMessages":
+			val, err := parseBool(v.ID, v.Value)
+			if err != nil {
+				return cfg, err
+			}
+			cfg.Teams.InteractiveMessages = val
 		case "Twilio.Enable":
 			val, err := parseBool(v.ID, v.Value)
 			if err != nil {
@@ -343,6 +391,8 @@ func ApplyConfigValues(cfg config.Config, vals []ConfigValueInput) (config.Confi
 			cfg.Twilio.MessagingServiceSID = v.Value
 		case "Twilio.RCSSenderID":
 			cfg.Twilio.RCSSenderID = v.Value
+		case "Twilio.VoiceMinSeverity":
+			cfg.Twilio.VoiceMinSeverity = v.Value
 		case "Twilio.DisableTwoWaySMS":
 			val, err := parseBool(v.ID, v.Value)
 			if err != nil {
@@ -15,6 +15,7 @@ import (
 	"github.com/target/goalert/assignment"
 	"github.com/target/goalert/escalation"
 	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/incident"
 	"github.com/target/goalert/integrationkey"
 	"github.com/target/goalert/label"
 	"github.com/target/goalert/limit"
@@ -26,6 +27,7 @@ import (
 	"github.com/target/goalert/schedule/rule"
 	"github.com/target/goalert/service"
 	"github.com/target/goalert/user"
+	"github.com/target/goalert/user/contactmethod"
 	"github.com/target/goalert/util/timeutil"
 )
 
@@ -33,6 +35,18 @@ type InlineDisplayInfo interface {
 	IsInlineDisplayInfo()
 }
 
+type AddAlertNoteInput struct {
+	AlertID int    `json:"alertID"`
+	Note    string `json:"note"`
+	// If true, the note will be sent as a status update to all destinations subscribed to the alert.
+	NotifySubscribers *bool `json:"notifySubscribers,omitempty"`
+}
+
+type AddIncidentUpdateInput struct {
+	IncidentID int    `json:"incidentID"`
+	Message    string `json:"message"`
+}
+
 type AlertConnection struct {
 	Nodes    []alert.Alert `json:"nodes"`
 	PageInfo *PageInfo     `json:"pageInfo"`
@@ -75,6 +89,7 @@ type AlertRecentEventsOptions struct {
 
 type AlertSearchOptions struct {
 	FilterByStatus    []AlertStatus    `json:"filterByStatus,omitempty"`
+	FilterBySeverity  []alert.Severity `json:"filterBySeverity,omitempty"`
 	FilterByServiceID []string         `json:"filterByServiceID,omitempty"`
 	Search            *string          `json:"search,omitempty"`
 	First             *int             `json:"first,omitempty"`
@@ -193,8 +208,10 @@ type CreateAlertInput struct {
 	// Dedup allows setting a unique value to de-duplicate multiple alerts.
 	//
 	// It can also be used to close an alert using closeMatchingAlert mutation.
-	Dedup *string              `json:"dedup,omitempty"`
-	Meta  []AlertMetadataInput `json:"meta,omitempty"`
+	Dedup *string `json:"dedup,omitempty"`
+	// Severity of the alert, defaults to high.
+	Severity *alert.Severity      `json:"severity,omitempty"`
+	Meta     []AlertMetadataInput `json:"meta,omitempty"`
 }
 
 type CreateBasicAuthInput struct {
@@ -241,12 +258,20 @@ type CreateHeartbeatMonitorInput struct {
 	Muted *string `json:"muted,omitempty"`
 }
 
+type CreateIncidentInput struct {
+	Title       string  `json:"title"`
+	Description *string `json:"description,omitempty"`
+	AlertIDs    []int   `json:"alertIDs,omitempty"`
+}
+
 type CreateIntegrationKeyInput struct {
 	ServiceID *string            `json:"serviceID,omitempty"`
 	Type      IntegrationKeyType `json:"type"`
 	Name      string             `json:"name"`
 	// Name of the external system this key is managed by.
 	ExternalSystemName *string `json:"externalSystemName,omitempty"`
+	// If set, open alerts from this key are closed automatically after this many minutes without a new or duplicate event.
+	AutoCloseMinutes *int `json:"autoCloseMinutes,omitempty"`
 }
 
 type CreateRotationInput struct {
@@ -272,8 +297,10 @@ type CreateScheduleInput struct {
 }
 
 type CreateServiceInput struct {
-	Name                 string                        `json:"name"`
-	Description          *string                       `json:"description,omitempty"`
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+	// Conference number for the service, can only be set by admins.
+	ConferenceNumber     *string                       `json:"conferenceNumber,omitempty"`
 	Favorite             *bool                         `json:"favorite,omitempty"`
 	EscalationPolicyID   *string                       `json:"escalationPolicyID,omitempty"`
 	NewEscalationPolicy  *CreateEscalationPolicyInput  `json:"newEscalationPolicy,omitempty"`
@@ -282,6 +309,13 @@ type CreateServiceInput struct {
 	NewHeartbeatMonitors []CreateHeartbeatMonitorInput `json:"newHeartbeatMonitors,omitempty"`
 }
 
+type CreateSlackUserGroupSyncInput struct {
+	UserGroupID string `json:"userGroupID"`
+	// Exactly one of rotationID or escalationPolicyStepID must be provided.
+	RotationID             *string `json:"rotationID,omitempty"`
+	EscalationPolicyStepID *string `json:"escalationPolicyStepID,omitempty"`
+}
+
 type CreateUserCalendarSubscriptionInput struct {
 	Name            string `json:"name"`
 	ReminderMinutes []int  `json:"reminderMinutes,omitempty"`
@@ -302,6 +336,10 @@ type CreateUserContactMethodInput struct {
 	//
 	// Note: Some contact method types, like Slack, will always receive status updates and this value is ignored.
 	EnableStatusUpdates *bool `json:"enableStatusUpdates,omitempty"`
+	// If set, notifications will only be sent to this contact method during the window.
+	//
+	// A window that is active every day with the same start and end time removes the restriction.
+	ActiveWindow *contactmethod.ActiveWindow `json:"activeWindow,omitempty"`
 }
 
 type CreateUserInput struct {
@@ -317,6 +355,8 @@ type CreateUserNotificationRuleInput struct {
 	UserID          *string `json:"userID,omitempty"`
 	ContactMethodID *string `json:"contactMethodID,omitempty"`
 	DelayMinutes    int     `json:"delayMinutes"`
+	// If set, the rule will only be used for alerts matching the filter.
+	Filter *NotificationRuleFilterInput `json:"filter,omitempty"`
 }
 
 type CreateUserOverrideInput struct {
@@ -489,6 +529,24 @@ type GQLAPIKeyUsage struct {
 	IP   string    `json:"ip"`
 }
 
+type IncidentAlertsInput struct {
+	IncidentID int   `json:"incidentID"`
+	AlertIDs   []int `json:"alertIDs"`
+}
+
+type IncidentConnection struct {
+	Nodes    []incident.Incident `json:"nodes"`
+	PageInfo *PageInfo           `json:"pageInfo"`
+}
+
+type IncidentSearchOptions struct {
+	First          *int          `json:"first,omitempty"`
+	After          *string       `json:"after,omitempty"`
+	Search         *string       `json:"search,omitempty"`
+	FilterByStatus []AlertStatus `json:"filterByStatus,omitempty"`
+	Omit           []int         `json:"omit,omitempty"`
+}
+
 type IntegrationKeyConnection struct {
 	Nodes    []integrationkey.IntegrationKey `json:"nodes"`
 	PageInfo *PageInfo                       `json:"pageInfo"`
@@ -511,6 +569,17 @@ type IntegrationKeyTypeInfo struct {
 	Enabled bool `json:"enabled"`
 }
 
+type KeyConfigTestResult struct {
+	// matchedRules are the rules whose conditions matched, in evaluation order.
+	MatchedRules []gadb.UIKRuleV1 `json:"matchedRules"`
+	// usedDefaultActions is true if no rules matched and the default actions were evaluated.
+	UsedDefaultActions bool `json:"usedDefaultActions"`
+	// responseStatus is the HTTP status code set by the matched rules, or 0 for the default.
+	ResponseStatus int `json:"responseStatus"`
+	// actions are the actions that would have been taken, with params evaluated.
+	Actions []gadb.UIKActionV1 `json:"actions"`
+}
+
 type LabelConnection struct {
 	Nodes    []label.Label `json:"nodes"`
 	PageInfo *PageInfo     `json:"pageInfo"`
@@ -569,6 +638,24 @@ type MessageStatusHistory struct {
 type Mutation struct {
 }
 
+// NotificationRuleFilter restricts a notification rule to matching alerts. All set fields must match.
+type NotificationRuleFilter struct {
+	// Matches alerts of at least the given severity.
+	MinSeverity *alert.Severity `json:"minSeverity,omitempty"`
+	// Matches alerts from the given service.
+	ServiceID *string `json:"serviceID,omitempty"`
+	// Matches alerts from services with the given label key and value.
+	LabelKey   *string `json:"labelKey,omitempty"`
+	LabelValue *string `json:"labelValue,omitempty"`
+}
+
+type NotificationRuleFilterInput struct {
+	MinSeverity *alert.Severity `json:"minSeverity,omitempty"`
+	ServiceID   *string         `json:"serviceID,omitempty"`
+	LabelKey    *string         `json:"labelKey,omitempty"`
+	LabelValue  *string         `json:"labelValue,omitempty"`
+}
+
 type NotificationState struct {
 	Details           string              `json:"details"`
 	Status            *NotificationStatus `json:"status,omitempty"`
@@ -606,6 +693,26 @@ type PhoneNumberInfo struct {
 type Query struct {
 }
 
+type ReassignAlertInput struct {
+	AlertID   int    `json:"alertID"`
+	ServiceID string `json:"serviceID"`
+}
+
+type RenderServiceMessageTemplateInput struct {
+	ServiceID string `json:"serviceID"`
+	// alertID, if provided, renders the templates using an existing alert of the service. Otherwise example data is used.
+	AlertID *int `json:"alertID,omitempty"`
+	// meta provides additional metadata for the example alert; ignored if alertID is provided.
+	Meta            []AlertMetadataInput `json:"meta,omitempty"`
+	SummaryTemplate string               `json:"summaryTemplate"`
+	DetailsTemplate string               `json:"detailsTemplate"`
+}
+
+type RenderedServiceMessageTemplate struct {
+	Summary string `json:"summary"`
+	Details string `json:"details"`
+}
+
 type RotationConnection struct {
 	Nodes    []rotation.Rotation `json:"nodes"`
 	PageInfo *PageInfo           `json:"pageInfo"`
@@ -741,6 +848,13 @@ type SetScheduleOnCallNotificationRulesInput struct {
 	Rules      []OnCallNotificationRuleInput `json:"rules"`
 }
 
+type SetServiceMessageTemplateInput struct {
+	ServiceID       string  `json:"serviceID"`
+	DestType        *string `json:"destType,omitempty"`
+	SummaryTemplate string  `json:"summaryTemplate"`
+	DetailsTemplate string  `json:"detailsTemplate"`
+}
+
 type SetTemporaryScheduleInput struct {
 	ScheduleID string                `json:"scheduleID"`
 	ClearStart *time.Time            `json:"clearStart,omitempty"`
@@ -790,6 +904,20 @@ type SystemLimitInput struct {
 	Value int      `json:"value"`
 }
 
+type TestKeyConfigInput struct {
+	KeyID string `json:"keyID"`
+	// sampleID is the ID of a recorded request to replay. If omitted, body must be provided.
+	SampleID *string `json:"sampleID,omitempty"`
+	// body is a JSON request body to test with.
+	Body *string `json:"body,omitempty"`
+	// query is a URL query string to test with, used along with body.
+	Query *string `json:"query,omitempty"`
+	// rules, if provided, are evaluated instead of the saved rules (e.g., to test unsaved changes).
+	Rules []gadb.UIKRuleV1 `json:"rules,omitempty"`
+	// defaultActions, if provided, are evaluated instead of the saved default actions.
+	DefaultActions []gadb.UIKActionV1 `json:"defaultActions,omitempty"`
+}
+
 type TimeSeriesBucket struct {
 	Start time.Time `json:"start"`
 	End   time.Time `json:"end"`
@@ -835,6 +963,8 @@ type UpdateAlertsInput struct {
 	AlertIDs    []int        `json:"alertIDs"`
 	NewStatus   *AlertStatus `json:"newStatus,omitempty"`
 	NoiseReason *string      `json:"noiseReason,omitempty"`
+	// If set, the alerts will be acknowledged and automatically return to unacknowledged, restarting escalation, after the given number of minutes.
+	SnoozeMinutes *int `json:"snoozeMinutes,omitempty"`
 }
 
 type UpdateBasicAuthInput struct {
@@ -876,6 +1006,20 @@ type UpdateHeartbeatMonitorInput struct {
 	Muted *string `json:"muted,omitempty"`
 }
 
+type UpdateIncidentInput struct {
+	ID          int     `json:"id"`
+	Title       *string `json:"title,omitempty"`
+	Description *string `json:"description,omitempty"`
+	// If set, the incident and all of its open alerts will be updated to the given status.
+	NewStatus *AlertStatus `json:"newStatus,omitempty"`
+}
+
+type UpdateIntegrationKeyInput struct {
+	ID string `json:"id"`
+	// Number of minutes without a new or duplicate event after which open alerts from this key are closed automatically. Set to 0 to disable.
+	AutoCloseMinutes *int `json:"autoCloseMinutes,omitempty"`
+}
+
 type UpdateKeyConfigInput struct {
 	KeyID string           `json:"keyID"`
 	Rules []gadb.UIKRuleV1 `json:"rules,omitempty"`
@@ -917,6 +1061,8 @@ type UpdateServiceInput struct {
 	Description          *string    `json:"description,omitempty"`
 	EscalationPolicyID   *string    `json:"escalationPolicyID,omitempty"`
 	MaintenanceExpiresAt *time.Time `json:"maintenanceExpiresAt,omitempty"`
+	// Conference number for the service, can only be changed by admins.
+	ConferenceNumber *string `json:"conferenceNumber,omitempty"`
 }
 
 type UpdateUserCalendarSubscriptionInput struct {
@@ -935,6 +1081,10 @@ type UpdateUserContactMethodInput struct {
 	//
 	// Note: Some contact method types, like Slack, will always receive status updates and this value is ignored.
 	EnableStatusUpdates *bool `json:"enableStatusUpdates,omitempty"`
+	// If set, notifications will only be sent to this contact method during the window.
+	//
+	// A window that is active every day with the same start and end time removes the restriction.
+	ActiveWindow *contactmethod.ActiveWindow `json:"activeWindow,omitempty"`
 }
 
 type UpdateUserInput struct {
@@ -1327,6 +1477,8 @@ const (
 	IntegrationKeyTypePrometheusAlertmanager IntegrationKeyType = "prometheusAlertmanager"
 	IntegrationKeyTypeEmail                  IntegrationKeyType = "email"
 	IntegrationKeyTypeUniversal              IntegrationKeyType = "universal"
+	IntegrationKeyTypePagerduty              IntegrationKeyType = "pagerduty"
+	IntegrationKeyTypeOtlp                   IntegrationKeyType = "otlp"
 )
 
 var AllIntegrationKeyType = []IntegrationKeyType{
@@ -1336,11 +1488,13 @@ var AllIntegrationKeyType = []IntegrationKeyType{
 	IntegrationKeyTypePrometheusAlertmanager,
 	IntegrationKeyTypeEmail,
 	IntegrationKeyTypeUniversal,
+	IntegrationKeyTypePagerduty,
+	IntegrationKeyTypeOtlp,
 }
 
 func (e IntegrationKeyType) IsValid() bool {
 	switch e {
-	case IntegrationKeyTypeGeneric, IntegrationKeyTypeGrafana, IntegrationKeyTypeSite24x7, IntegrationKeyTypePrometheusAlertmanager, IntegrationKeyTypeEmail, IntegrationKeyTypeUniversal:
+	case IntegrationKeyTypeGeneric, IntegrationKeyTypeGrafana, IntegrationKeyTypeSite24x7, IntegrationKeyTypePrometheusAlertmanager, IntegrationKeyTypeEmail, IntegrationKeyTypeUniversal, IntegrationKeyTypePagerduty, IntegrationKeyTypeOtlp:
 		return true
 	}
 	return false
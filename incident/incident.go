@@ -0,0 +1,37 @@
+package incident
+
+import (
+	"time"
+
+	"github.com/target/goalert/alert"
+	"github.com/target/goalert/validation/validate"
+)
+
+// MaxAlerts is the maximum number of alerts that may be grouped into a single incident.
+const MaxAlerts = 500
+
+// An Incident groups related alerts, across any number of services, so they can be tracked and managed as a unit.
+type Incident struct {
+	ID          int
+	Title       string
+	Description string
+
+	// Status follows the same lifecycle as alerts (triggered, active, closed).
+	Status alert.Status
+
+	CreatedAt time.Time
+	ClosedAt  time.Time
+}
+
+// Normalize will validate and return a normalized copy of the Incident.
+func (inc Incident) Normalize() (*Incident, error) {
+	err := validate.Many(
+		validate.RequiredText("Title", inc.Title, 1, alert.MaxSummaryLength),
+		validate.Text("Description", inc.Description, 0, alert.MaxDetailsLength),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &inc, nil
+}
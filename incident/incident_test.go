@@ -0,0 +1,54 @@
+package incident
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/target/goalert/alert"
+)
+
+func TestIncident_Normalize(t *testing.T) {
+	test := func(valid bool, inc Incident) {
+		name := "valid"
+		if !valid {
+			name = "invalid"
+		}
+		t.Run(name, func(t *testing.T) {
+			t.Logf("%+v", inc)
+			_, err := inc.Normalize()
+			if valid && err != nil {
+				t.Errorf("got %v; want nil", err)
+			} else if !valid && err == nil {
+				t.Errorf("got nil err; want non-nil")
+			}
+		})
+	}
+
+	valid := []Incident{
+		{Title: "Database outage"},
+		{Title: "Database outage", Description: "Primary is unreachable."},
+	}
+	invalid := []Incident{
+		{},
+		{Title: strings.Repeat("a", alert.MaxSummaryLength+1)},
+		{Title: "Database outage", Description: strings.Repeat("a", alert.MaxDetailsLength+1)},
+	}
+	for _, inc := range valid {
+		test(true, inc)
+	}
+	for _, inc := range invalid {
+		test(false, inc)
+	}
+}
+
+func TestSearchOptions_Normalize(t *testing.T) {
+	_, err := (*renderData)(&SearchOptions{Status: []alert.Status{alert.StatusActive, alert.StatusClosed}}).Normalize()
+	if err != nil {
+		t.Errorf("got %v; want nil", err)
+	}
+
+	_, err = (*renderData)(&SearchOptions{Status: []alert.Status{"bad"}}).Normalize()
+	if err == nil {
+		t.Error("got nil err for invalid status; want non-nil")
+	}
+}
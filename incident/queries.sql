@@ -0,0 +1,132 @@
+-- name: IncidentCreate :one
+-- Creates a new incident.
+INSERT INTO incidents(title, description)
+    VALUES (@title, @description)
+RETURNING
+    id, created_at;
+
+-- name: IncidentFindMany :many
+-- Returns the incidents with the given IDs.
+SELECT
+    id,
+    title,
+    description,
+    status,
+    created_at,
+    closed_at
+FROM
+    incidents
+WHERE
+    id = ANY (@ids::bigint[]);
+
+-- name: IncidentLock :one
+-- Locks the incident for updating and returns its status.
+SELECT
+    status
+FROM
+    incidents
+WHERE
+    id = @id
+FOR UPDATE;
+
+-- name: IncidentUpdate :exec
+-- Updates the title and description of an incident.
+UPDATE
+    incidents
+SET
+    title = @title,
+    description = @description
+WHERE
+    id = @id;
+
+-- name: IncidentSetStatus :exec
+-- Updates the status of an incident.
+UPDATE
+    incidents
+SET
+    status = @status,
+    closed_at = CASE WHEN @status = 'closed'::enum_alert_status THEN
+        now()
+    END
+WHERE
+    id = @id;
+
+-- name: IncidentAddAlerts :many
+-- Adds alerts to an incident, moving them from any incident they currently belong to.
+INSERT INTO incident_alerts(incident_id, alert_id)
+SELECT
+    @incident_id,
+    a.id
+FROM
+    alerts a
+WHERE
+    a.id = ANY (@alert_ids::bigint[])
+ON CONFLICT (alert_id)
+    DO UPDATE SET
+        incident_id = excluded.incident_id, created_at = now()
+    WHERE
+        incident_alerts.incident_id != excluded.incident_id
+    RETURNING
+        alert_id;
+
+-- name: IncidentRemoveAlerts :many
+-- Removes alerts from an incident.
+DELETE FROM incident_alerts
+WHERE incident_id = @incident_id
+    AND alert_id = ANY (@alert_ids::bigint[])
+RETURNING
+    alert_id;
+
+-- name: IncidentAlertIDs :many
+-- Returns the IDs of all alerts in an incident.
+SELECT
+    alert_id
+FROM
+    incident_alerts
+WHERE
+    incident_id = @incident_id
+ORDER BY
+    alert_id;
+
+-- name: IncidentOpenAlertIDs :many
+-- Returns the IDs of all alerts in an incident that are not closed.
+SELECT
+    a.id
+FROM
+    incident_alerts ia
+    JOIN alerts a ON a.id = ia.alert_id
+WHERE
+    ia.incident_id = @incident_id
+    AND a.status != 'closed'
+ORDER BY
+    a.id;
+
+-- name: IncidentAlertCount :one
+-- Returns the number of alerts in an incident.
+SELECT
+    count(*)
+FROM
+    incident_alerts
+WHERE
+    incident_id = @incident_id;
+
+-- name: IncidentAddUpdate :exec
+-- Adds an entry to the incident's update feed.
+INSERT INTO incident_updates(incident_id, user_id, message)
+    VALUES (@incident_id, @user_id, @message);
+
+-- name: IncidentUpdates :many
+-- Returns the update feed for an incident, newest first.
+SELECT
+    id,
+    incident_id,
+    user_id,
+    message,
+    created_at
+FROM
+    incident_updates
+WHERE
+    incident_id = @incident_id
+ORDER BY
+    id DESC
+LIMIT @max_results;
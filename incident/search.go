@@ -0,0 +1,152 @@
+package incident
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"text/template"
+
+	"github.com/pkg/errors"
+	"github.com/target/goalert/alert"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/search"
+	"github.com/target/goalert/util/sqlutil"
+	"github.com/target/goalert/validation/validate"
+)
+
+// SearchOptions contains criteria for filtering and sorting incidents.
+type SearchOptions struct {
+	// Search is matched case-insensitive against the incident title and id.
+	Search string `json:"s,omitempty"`
+
+	// Status, if specified, will restrict incidents to those with a matching status.
+	Status []alert.Status `json:"t,omitempty"`
+
+	// Omit specifies a list of incident IDs to exclude from the results.
+	Omit []int `json:"o,omitempty"`
+
+	// Limit restricts the maximum number of rows returned.
+	Limit int `json:"-"`
+
+	After SearchCursor `json:"a,omitempty"`
+}
+
+// SearchCursor is used to indicate a position in a paginated list.
+type SearchCursor struct {
+	ID int `json:"i,omitempty"`
+}
+
+var searchTemplate = template.Must(template.New("incident-search").Funcs(search.Helpers()).Parse(`
+	SELECT
+		inc.id,
+		inc.title,
+		inc.description,
+		inc.status,
+		inc.created_at,
+		inc.closed_at
+	FROM incidents inc
+	WHERE true
+	{{ if .Omit }}
+		AND not inc.id = any(:omit)
+	{{ end }}
+	{{ if .Search }}
+		AND (inc.id = :searchID OR {{textSearch "search" "inc.title"}})
+	{{ end }}
+	{{ if .Status }}
+		AND inc.status = any(:status::enum_alert_status[])
+	{{ end }}
+	{{ if .After.ID }}
+		AND inc.id < :afterID
+	{{ end }}
+	ORDER BY inc.id DESC
+	LIMIT {{.Limit}}
+`))
+
+type renderData SearchOptions
+
+func (opts renderData) Normalize() (*renderData, error) {
+	if opts.Limit == 0 {
+		opts.Limit = search.DefaultMaxResults
+	}
+
+	err := validate.Many(
+		validate.Search("Search", opts.Search),
+		validate.Range("Limit", opts.Limit, 0, search.MaxResults),
+		validate.Range("Status", len(opts.Status), 0, 3),
+		validate.Range("Omit", len(opts.Omit), 0, 50),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, stat := range opts.Status {
+		err = validate.OneOf("Status["+strconv.Itoa(i)+"]", stat, alert.StatusTriggered, alert.StatusActive, alert.StatusClosed)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &opts, nil
+}
+
+func (opts renderData) QueryArgs() []sql.NamedArg {
+	var searchID sql.NullInt64
+	if i, err := strconv.ParseInt(opts.Search, 10, 64); err == nil {
+		searchID.Valid = true
+		searchID.Int64 = i
+	}
+
+	stat := make(sqlutil.StringArray, len(opts.Status))
+	for i := range opts.Status {
+		stat[i] = string(opts.Status[i])
+	}
+
+	return []sql.NamedArg{
+		sql.Named("search", opts.Search),
+		sql.Named("searchID", searchID),
+		sql.Named("status", stat),
+		sql.Named("afterID", opts.After.ID),
+		sql.Named("omit", sqlutil.IntArray(opts.Omit)),
+	}
+}
+
+// Search will return a list of matching incidents, newest first.
+func (s *Store) Search(ctx context.Context, opts *SearchOptions) ([]Incident, error) {
+	err := permission.LimitCheckAny(ctx, permission.System, permission.User)
+	if err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		opts = new(SearchOptions)
+	}
+
+	data, err := (*renderData)(opts).Normalize()
+	if err != nil {
+		return nil, err
+	}
+
+	query, args, err := search.RenderQuery(ctx, searchTemplate, data)
+	if err != nil {
+		return nil, errors.Wrap(err, "render query")
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "query")
+	}
+	defer rows.Close()
+
+	var result []Incident
+	for rows.Next() {
+		var inc Incident
+		var closedAt sql.NullTime
+		err = rows.Scan(&inc.ID, &inc.Title, &inc.Description, &inc.Status, &inc.CreatedAt, &closedAt)
+		if err != nil {
+			return nil, errors.Wrap(err, "scan")
+		}
+		inc.ClosedAt = closedAt.Time
+		result = append(result, inc)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,459 @@
+package incident
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/target/goalert/alert"
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/util/sqlutil"
+	"github.com/target/goalert/validation"
+	"github.com/target/goalert/validation/validate"
+)
+
+// maxUpdates is the maximum number of feed entries returned by Updates.
+const maxUpdates = 100
+
+// Store manages incidents and their member alerts.
+type Store struct {
+	db     *sql.DB
+	alerts *alert.Store
+}
+
+// NewStore creates a new Store. Status changes are applied to member alerts through the provided alert.Store.
+func NewStore(ctx context.Context, db *sql.DB, alerts *alert.Store) (*Store, error) {
+	return &Store{
+		db:     db,
+		alerts: alerts,
+	}, nil
+}
+
+func int64s(ids []int) []int64 {
+	res := make([]int64, len(ids))
+	for i, id := range ids {
+		res[i] = int64(id)
+	}
+	return res
+}
+
+func ints(ids []int64) []int {
+	res := make([]int, len(ids))
+	for i, id := range ids {
+		res[i] = int(id)
+	}
+	return res
+}
+
+func alertCountString(n int) string {
+	if n == 1 {
+		return "1 alert"
+	}
+	return strconv.Itoa(n) + " alerts"
+}
+
+func (s *Store) addUpdate(ctx context.Context, q *gadb.Queries, id int, msg string) error {
+	return q.IncidentAddUpdate(ctx, gadb.IncidentAddUpdateParams{
+		IncidentID: int64(id),
+		UserID:     permission.UserNullUUID(ctx),
+		Message:    msg,
+	})
+}
+
+// lock will lock the incident for updating, returning an error if it does not exist.
+func (s *Store) lock(ctx context.Context, q *gadb.Queries, id int) (alert.Status, error) {
+	stat, err := q.IncidentLock(ctx, int64(id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", validation.NewFieldError("ID", "incident not found")
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return alert.Status(stat), nil
+}
+
+// lockOpen works like lock, but also returns an error if the incident is closed.
+func (s *Store) lockOpen(ctx context.Context, q *gadb.Queries, id int) error {
+	stat, err := s.lock(ctx, q, id)
+	if err != nil {
+		return err
+	}
+	if stat == alert.StatusClosed {
+		return validation.NewFieldError("ID", "incident is closed")
+	}
+
+	return nil
+}
+
+// addAlerts will add the alerts to the incident, enforcing MaxAlerts.
+func (s *Store) addAlerts(ctx context.Context, q *gadb.Queries, id int, alertIDs []int) error {
+	added, err := q.IncidentAddAlerts(ctx, gadb.IncidentAddAlertsParams{
+		IncidentID: int64(id),
+		AlertIds:   int64s(alertIDs),
+	})
+	if err != nil {
+		return err
+	}
+	if len(added) == 0 {
+		return nil
+	}
+
+	count, err := q.IncidentAlertCount(ctx, int64(id))
+	if err != nil {
+		return err
+	}
+	if count > MaxAlerts {
+		return validation.NewFieldError("AlertIDs", "incident cannot have more than "+strconv.Itoa(MaxAlerts)+" alerts")
+	}
+
+	return s.addUpdate(ctx, q, id, "Added "+alertCountString(len(added)))
+}
+
+// Create will create a new incident containing the provided alerts.
+func (s *Store) Create(ctx context.Context, inc *Incident, alertIDs []int) (*Incident, error) {
+	err := permission.LimitCheckAny(ctx, permission.User)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := inc.Normalize()
+	if err != nil {
+		return nil, err
+	}
+	err = validate.Range("AlertIDs", len(alertIDs), 0, MaxAlerts)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer sqlutil.Rollback(ctx, "incident: create", tx)
+
+	q := gadb.New(tx)
+	row, err := q.IncidentCreate(ctx, gadb.IncidentCreateParams{
+		Title:       n.Title,
+		Description: n.Description,
+	})
+	if err != nil {
+		return nil, err
+	}
+	n.ID = int(row.ID)
+	n.CreatedAt = row.CreatedAt
+	n.Status = alert.StatusTriggered
+
+	err = s.addUpdate(ctx, q, n.ID, "Incident created")
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.addAlerts(ctx, q, n.ID, alertIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return n, nil
+}
+
+// Update will update the title and description of an open incident.
+func (s *Store) Update(ctx context.Context, inc *Incident) error {
+	err := permission.LimitCheckAny(ctx, permission.User)
+	if err != nil {
+		return err
+	}
+
+	n, err := inc.Normalize()
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer sqlutil.Rollback(ctx, "incident: update", tx)
+
+	q := gadb.New(tx)
+	err = s.lockOpen(ctx, q, n.ID)
+	if err != nil {
+		return err
+	}
+
+	err = q.IncidentUpdate(ctx, gadb.IncidentUpdateParams{
+		ID:          int64(n.ID),
+		Title:       n.Title,
+		Description: n.Description,
+	})
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// FindOne will return the incident with the given ID.
+func (s *Store) FindOne(ctx context.Context, id int) (*Incident, error) {
+	incs, err := s.FindMany(ctx, []int{id})
+	if err != nil {
+		return nil, err
+	}
+	if len(incs) == 0 {
+		return nil, sql.ErrNoRows
+	}
+
+	return &incs[0], nil
+}
+
+// FindMany will return all incidents matching the provided IDs.
+func (s *Store) FindMany(ctx context.Context, ids []int) ([]Incident, error) {
+	err := permission.LimitCheckAny(ctx, permission.System, permission.User)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	err = validate.Range("IDs", len(ids), 1, 500)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := gadb.New(s.db).IncidentFindMany(ctx, int64s(ids))
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]Incident, len(rows))
+	for i, r := range rows {
+		res[i] = Incident{
+			ID:          int(r.ID),
+			Title:       r.Title,
+			Description: r.Description,
+			Status:      alert.Status(r.Status),
+			CreatedAt:   r.CreatedAt,
+			ClosedAt:    r.ClosedAt.Time,
+		}
+	}
+
+	return res, nil
+}
+
+// AlertIDs will return the IDs of all alerts in the incident.
+func (s *Store) AlertIDs(ctx context.Context, id int) ([]int, error) {
+	err := permission.LimitCheckAny(ctx, permission.System, permission.User)
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := gadb.New(s.db).IncidentAlertIDs(ctx, int64(id))
+	if err != nil {
+		return nil, err
+	}
+
+	return ints(ids), nil
+}
+
+// AddAlerts will add alerts to an open incident. Alerts that belong to a different incident are moved.
+func (s *Store) AddAlerts(ctx context.Context, id int, alertIDs []int) error {
+	err := permission.LimitCheckAny(ctx, permission.User)
+	if err != nil {
+		return err
+	}
+	err = validate.Range("AlertIDs", len(alertIDs), 1, MaxAlerts)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer sqlutil.Rollback(ctx, "incident: add alerts", tx)
+
+	q := gadb.New(tx)
+	err = s.lockOpen(ctx, q, id)
+	if err != nil {
+		return err
+	}
+
+	err = s.addAlerts(ctx, q, id, alertIDs)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// RemoveAlerts will remove alerts from an open incident.
+func (s *Store) RemoveAlerts(ctx context.Context, id int, alertIDs []int) error {
+	err := permission.LimitCheckAny(ctx, permission.User)
+	if err != nil {
+		return err
+	}
+	err = validate.Range("AlertIDs", len(alertIDs), 1, MaxAlerts)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer sqlutil.Rollback(ctx, "incident: remove alerts", tx)
+
+	q := gadb.New(tx)
+	err = s.lockOpen(ctx, q, id)
+	if err != nil {
+		return err
+	}
+
+	removed, err := q.IncidentRemoveAlerts(ctx, gadb.IncidentRemoveAlertsParams{
+		IncidentID: int64(id),
+		AlertIds:   int64s(alertIDs),
+	})
+	if err != nil {
+		return err
+	}
+	if len(removed) > 0 {
+		err = s.addUpdate(ctx, q, id, "Removed "+alertCountString(len(removed)))
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// Acknowledge will acknowledge the incident and all of its open alerts.
+func (s *Store) Acknowledge(ctx context.Context, id int) error {
+	return s.updateStatus(ctx, id, alert.StatusActive)
+}
+
+// Close will close the incident and all of its open alerts.
+func (s *Store) Close(ctx context.Context, id int) error {
+	return s.updateStatus(ctx, id, alert.StatusClosed)
+}
+
+func (s *Store) updateStatus(ctx context.Context, id int, stat alert.Status) error {
+	err := permission.LimitCheckAny(ctx, permission.User)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer sqlutil.Rollback(ctx, "incident: update status", tx)
+
+	q := gadb.New(tx)
+	err = s.lockOpen(ctx, q, id)
+	if err != nil {
+		return err
+	}
+
+	alertIDs, err := q.IncidentOpenAlertIDs(ctx, int64(id))
+	if err != nil {
+		return err
+	}
+
+	updated, err := s.alerts.UpdateManyAlertStatusTx(ctx, tx, stat, ints(alertIDs), nil)
+	if err != nil {
+		return fmt.Errorf("update incident alerts: %w", err)
+	}
+
+	err = q.IncidentSetStatus(ctx, gadb.IncidentSetStatusParams{
+		ID:     int64(id),
+		Status: gadb.EnumAlertStatus(stat),
+	})
+	if err != nil {
+		return err
+	}
+
+	msg := "Acknowledged"
+	if stat == alert.StatusClosed {
+		msg = "Closed"
+	}
+	if len(updated) > 0 {
+		msg += " (" + alertCountString(len(updated)) + ")"
+	}
+	err = s.addUpdate(ctx, q, id, msg)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// AddUpdate will post a user-authored message to the incident's update feed. Updates may be
+// added to closed incidents (e.g., for follow-up notes).
+func (s *Store) AddUpdate(ctx context.Context, id int, message string) error {
+	err := permission.LimitCheckAny(ctx, permission.User)
+	if err != nil {
+		return err
+	}
+	err = validate.RequiredText("Message", message, 1, MaxUpdateLength)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer sqlutil.Rollback(ctx, "incident: add update", tx)
+
+	q := gadb.New(tx)
+	_, err = s.lock(ctx, q, id)
+	if err != nil {
+		return err
+	}
+
+	err = s.addUpdate(ctx, q, id, message)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Updates will return the most recent entries in the incident's update feed, newest first.
+func (s *Store) Updates(ctx context.Context, id int) ([]Update, error) {
+	err := permission.LimitCheckAny(ctx, permission.System, permission.User)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := gadb.New(s.db).IncidentUpdates(ctx, gadb.IncidentUpdatesParams{
+		IncidentID: int64(id),
+		MaxResults: maxUpdates,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]Update, len(rows))
+	for i, r := range rows {
+		res[i] = Update{
+			ID:         int(r.ID),
+			IncidentID: int(r.IncidentID),
+			Message:    r.Message,
+			CreatedAt:  r.CreatedAt,
+		}
+		if r.UserID.Valid {
+			res[i].UserID = r.UserID.UUID.String()
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,18 @@
+package incident
+
+import "time"
+
+// MaxUpdateLength is the maximum number of characters allowed in a user-provided update.
+const MaxUpdateLength = 1000
+
+// An Update is an entry in an incident's status-update feed.
+type Update struct {
+	ID         int
+	IncidentID int
+
+	// UserID is the user that caused the update, if any.
+	UserID string
+
+	Message   string
+	CreatedAt time.Time
+}
@@ -82,6 +82,12 @@ func (s *Store) ValidateUIKConfigV1(ctx context.Context, cfg gadb.UIKConfigV1) e
 		if err != nil {
 			return err
 		}
+		if r.ResponseStatus != 0 {
+			err = validate.Range(field+".ResponseStatus", r.ResponseStatus, 200, 599)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	data, err := json.Marshal(cfg)
@@ -117,6 +123,43 @@ func (s *Store) Config(ctx context.Context, db gadb.DBTX, keyID uuid.UUID) (*gad
 	return &cfg.V1, nil
 }
 
+// RevisionedConfig returns the config for the key along with its revision, which is incremented each time the config changes.
+func (s *Store) RevisionedConfig(ctx context.Context, db gadb.DBTX, keyID uuid.UUID) (*gadb.UIKConfigV1, int64, error) {
+	err := permission.LimitCheckAny(ctx, permission.User, permission.Service)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	row, err := gadb.New(db).IntKeyGetConfigRevision(ctx, keyID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &gadb.UIKConfigV1{}, 0, nil
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if row.Config.Version != 1 {
+		return nil, 0, fmt.Errorf("unsupported config version: %d", row.Config.Version)
+	}
+
+	return &row.Config.V1, row.Revision, nil
+}
+
+// ConfigRevision returns the current config revision for the key.
+func (s *Store) ConfigRevision(ctx context.Context, db gadb.DBTX, keyID uuid.UUID) (int64, error) {
+	err := permission.LimitCheckAny(ctx, permission.User, permission.Service)
+	if err != nil {
+		return 0, err
+	}
+
+	rev, err := gadb.New(db).IntKeyConfigRevision(ctx, keyID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+
+	return rev, err
+}
+
 func (s *Store) SetConfig(ctx context.Context, db gadb.DBTX, keyID uuid.UUID, cfg *gadb.UIKConfigV1) error {
 	err := permission.LimitCheckAny(ctx, permission.User)
 	if err != nil {
@@ -135,8 +178,8 @@ func (s *Store) SetConfig(ctx context.Context, db gadb.DBTX, keyID uuid.UUID, cf
 	if err != nil {
 		return err
 	}
-	if keyType != gadb.EnumIntegrationKeysTypeUniversal {
-		return validation.NewGenericError("config only supported for universal keys")
+	if !Type(keyType).IsRuleBased() {
+		return validation.NewGenericError("config only supported for universal and OTLP keys")
 	}
 
 	if cfg == nil {
@@ -11,14 +11,22 @@ type IntegrationKey struct {
 	ServiceID string `json:"service_id"`
 
 	ExternalSystemName string
+
+	// AutoCloseMinutes, if non-zero, is the number of minutes without a new (or duplicate) event
+	// after which open alerts from this key are closed automatically.
+	AutoCloseMinutes int
 }
 
+// MaxAutoCloseMinutes is the maximum value for AutoCloseMinutes (one week).
+const MaxAutoCloseMinutes = 7 * 24 * 60
+
 func (i IntegrationKey) Normalize() (*IntegrationKey, error) {
 	err := validate.Many(
 		validate.IDName("Name", i.Name),
 		validate.UUID("ServiceID", i.ServiceID),
-		validate.OneOf("Type", i.Type, TypeGrafana, TypeSite24x7, TypePrometheusAlertmanager, TypeGeneric, TypeEmail, TypeUniversal),
+		validate.OneOf("Type", i.Type, TypeGrafana, TypeSite24x7, TypePrometheusAlertmanager, TypeGeneric, TypeEmail, TypeUniversal, TypePagerDuty, TypeOTLP),
 		validate.ASCII("ExternalSystemName", i.ExternalSystemName, 0, 255),
+		validate.Range("AutoCloseMinutes", i.AutoCloseMinutes, 0, MaxAutoCloseMinutes),
 	)
 	if err != nil {
 		return nil, err
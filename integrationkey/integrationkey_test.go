@@ -23,9 +23,12 @@ func TestIntegrationKey_Normalize(t *testing.T) {
 
 	valid := []IntegrationKey{
 		{Name: "SampleIntegrationKey", ServiceID: "e93facc0-4764-012d-7bfb-002500d5d1a6", Type: TypeGrafana},
+		{Name: "SampleIntegrationKey", ServiceID: "e93facc0-4764-012d-7bfb-002500d5d1a6", Type: TypeGrafana, AutoCloseMinutes: 30},
 	}
 	invalid := []IntegrationKey{
 		{},
+		{Name: "SampleIntegrationKey", ServiceID: "e93facc0-4764-012d-7bfb-002500d5d1a6", Type: TypeGrafana, AutoCloseMinutes: -1},
+		{Name: "SampleIntegrationKey", ServiceID: "e93facc0-4764-012d-7bfb-002500d5d1a6", Type: TypeGrafana, AutoCloseMinutes: MaxAutoCloseMinutes + 1},
 	}
 	for _, k := range valid {
 		test(true, k)
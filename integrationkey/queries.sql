@@ -8,8 +8,8 @@ WHERE
     AND type = $2;
 
 -- name: IntKeyCreate :exec
-INSERT INTO integration_keys(id, name, type, service_id, external_system_name)
-    VALUES ($1, $2, $3, $4, $5);
+INSERT INTO integration_keys(id, name, type, service_id, external_system_name, auto_close_minutes)
+    VALUES ($1, $2, $3, $4, $5, $6);
 
 -- name: IntKeyFindOne :one
 SELECT
@@ -17,7 +17,8 @@ SELECT
     name,
     type,
     service_id,
-    external_system_name
+    external_system_name,
+    auto_close_minutes
 FROM
     integration_keys
 WHERE
@@ -29,12 +30,22 @@ SELECT
     name,
     type,
     service_id,
-    external_system_name
+    external_system_name,
+    auto_close_minutes
 FROM
     integration_keys
 WHERE
     service_id = $1;
 
+-- name: IntKeySetAutoClose :execrows
+-- Sets (or clears, if NULL) the auto-close duration for an integration key.
+UPDATE
+    integration_keys
+SET
+    auto_close_minutes = @auto_close_minutes
+WHERE
+    id = @id;
+
 -- name: IntKeyDelete :exec
 DELETE FROM integration_keys
 WHERE id = ANY (@ids::uuid[]);
@@ -48,12 +59,30 @@ WHERE
     id = $1
 FOR UPDATE;
 
+-- name: IntKeyGetConfigRevision :one
+SELECT
+    config,
+    revision
+FROM
+    uik_config
+WHERE
+    id = $1;
+
+-- name: IntKeyConfigRevision :one
+SELECT
+    revision
+FROM
+    uik_config
+WHERE
+    id = $1;
+
 -- name: IntKeySetConfig :exec
 INSERT INTO uik_config(id, config)
     VALUES ($1, $2)
 ON CONFLICT (id)
     DO UPDATE SET
-        config = $2;
+        config = $2,
+        revision = uik_config.revision + 1;
 
 -- name: IntKeyDeleteConfig :exec
 DELETE FROM uik_config
@@ -122,7 +151,7 @@ FROM
     JOIN integration_keys k ON k.id = c.id
 WHERE
     c.id = sqlc.arg(key_id)
-    AND k.type = 'universal'
+    AND k.type = sqlc.arg(key_type)
     AND (c.primary_token = sqlc.arg(token_id)
         OR c.secondary_token = sqlc.arg(token_id));
 
@@ -139,3 +168,37 @@ WHERE
 INSERT INTO pending_signals(dest_id, service_id, params)
     VALUES ($1, $2, $3);
 
+
+-- name: IntKeyInsertSample :exec
+INSERT INTO uik_samples(id, key_id, body, query, user_agent, remote_addr)
+    VALUES (@id, @key_id, @body, @query, @user_agent, @remote_addr);
+
+-- name: IntKeySamples :many
+SELECT
+    id,
+    received_at,
+    body,
+    query,
+    user_agent,
+    remote_addr
+FROM
+    uik_samples
+WHERE
+    key_id = @key_id
+ORDER BY
+    received_at DESC
+LIMIT @max_count;
+
+-- name: IntKeyFindSample :one
+SELECT
+    id,
+    received_at,
+    body,
+    query,
+    user_agent,
+    remote_addr
+FROM
+    uik_samples
+WHERE
+    key_id = @key_id
+    AND id = @id;
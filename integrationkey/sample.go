@@ -0,0 +1,104 @@
+package integrationkey
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/permission"
+)
+
+const (
+	// MaxSamples is the number of recent requests kept per key for testing rules.
+	MaxSamples = 10
+
+	// MaxSampleSize is the largest request body that will be recorded as a sample.
+	MaxSampleSize = 64 * 1024
+)
+
+// Sample is a recorded request to a universal integration key.
+type Sample struct {
+	ID         uuid.UUID
+	ReceivedAt time.Time
+
+	// Body is the JSON request body.
+	Body json.RawMessage
+
+	// Query is the URL query string, without any token parameters.
+	Query string
+
+	UserAgent  string
+	RemoteAddr string
+}
+
+// RecordSample will store a request for the key. Samples beyond the most recent
+// MaxSamples are removed periodically by the cleanup manager.
+//
+// Bodies larger than MaxSampleSize are ignored.
+func (s *Store) RecordSample(ctx context.Context, db gadb.DBTX, keyID uuid.UUID, smp Sample) error {
+	err := permission.LimitCheckAny(ctx, permission.Service)
+	if err != nil {
+		return err
+	}
+	if len(smp.Body) > MaxSampleSize {
+		return nil
+	}
+
+	return gadb.New(db).IntKeyInsertSample(ctx, gadb.IntKeyInsertSampleParams{
+		ID:         uuid.New(),
+		KeyID:      keyID,
+		Body:       smp.Body,
+		Query:      smp.Query,
+		UserAgent:  smp.UserAgent,
+		RemoteAddr: smp.RemoteAddr,
+	})
+}
+
+// Samples will return the recorded requests for the key, newest first.
+func (s *Store) Samples(ctx context.Context, db gadb.DBTX, keyID uuid.UUID) ([]Sample, error) {
+	err := permission.LimitCheckAny(ctx, permission.User)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := gadb.New(db).IntKeySamples(ctx, gadb.IntKeySamplesParams{
+		KeyID:    keyID,
+		MaxCount: MaxSamples,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]Sample, len(rows))
+	for i, r := range rows {
+		res[i] = Sample(r)
+	}
+
+	return res, nil
+}
+
+// FindSample will return a single recorded request for the key, or nil if it no longer exists.
+func (s *Store) FindSample(ctx context.Context, db gadb.DBTX, keyID, id uuid.UUID) (*Sample, error) {
+	err := permission.LimitCheckAny(ctx, permission.User)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := gadb.New(db).IntKeyFindSample(ctx, gadb.IntKeyFindSampleParams{
+		KeyID: keyID,
+		ID:    id,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	smp := Sample(row)
+	return &smp, nil
+}
@@ -53,7 +53,7 @@ func (s *Store) GetServiceID(ctx context.Context, id string, t Type) (string, er
 	keyUUID, err := validate.ParseUUID("IntegrationKeyID", id)
 	err = validate.Many(
 		err,
-		validate.OneOf("IntegrationType", t, TypeGrafana, TypeSite24x7, TypePrometheusAlertmanager, TypeGeneric, TypeEmail, TypeUniversal),
+		validate.OneOf("IntegrationType", t, TypeGrafana, TypeSite24x7, TypePrometheusAlertmanager, TypeGeneric, TypeEmail, TypeUniversal, TypePagerDuty, TypeOTLP),
 	)
 	if err != nil {
 		return "", err
@@ -89,7 +89,7 @@ func (s *Store) Create(ctx context.Context, dbtx gadb.DBTX, i *IntegrationKey) (
 		return nil, err
 	}
 
-	if i.Type == TypeUniversal && !expflag.ContextHas(ctx, expflag.UnivKeys) {
+	if i.Type.IsRuleBased() && !expflag.ContextHas(ctx, expflag.UnivKeys) {
 		return nil, validation.NewGenericError("experimental flag not enabled")
 	}
 
@@ -107,12 +107,13 @@ func (s *Store) Create(ctx context.Context, dbtx gadb.DBTX, i *IntegrationKey) (
 		ServiceID: serviceUUID,
 
 		ExternalSystemName: sql.NullString{String: n.ExternalSystemName, Valid: n.ExternalSystemName != ""},
+		AutoCloseMinutes:   autoCloseMinutes(n.AutoCloseMinutes),
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	if n.Type == TypeUniversal {
+	if n.Type.IsRuleBased() {
 		// ensure a config exists
 		err = s.SetConfig(ctx, dbtx, keyUUID, &gadb.UIKConfigV1{})
 		if err != nil {
@@ -123,6 +124,41 @@ func (s *Store) Create(ctx context.Context, dbtx gadb.DBTX, i *IntegrationKey) (
 	return n, nil
 }
 
+func autoCloseMinutes(min int) sql.NullInt32 {
+	return sql.NullInt32{Int32: int32(min), Valid: min > 0}
+}
+
+// SetAutoClose will set the number of minutes without new events after which alerts from the key
+// are closed automatically. A value of zero disables auto-close.
+func (s *Store) SetAutoClose(ctx context.Context, dbtx gadb.DBTX, id string, minutes int) error {
+	err := permission.LimitCheckAny(ctx, permission.Admin, permission.User)
+	if err != nil {
+		return err
+	}
+
+	keyUUID, err := validate.ParseUUID("IntegrationKeyID", id)
+	if err != nil {
+		return err
+	}
+	err = validate.Range("AutoCloseMinutes", minutes, 0, MaxAutoCloseMinutes)
+	if err != nil {
+		return err
+	}
+
+	n, err := gadb.New(dbtx).IntKeySetAutoClose(ctx, gadb.IntKeySetAutoCloseParams{
+		ID:               keyUUID,
+		AutoCloseMinutes: autoCloseMinutes(minutes),
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return validation.NewFieldError("IntegrationKeyID", "not found")
+	}
+
+	return nil
+}
+
 func (s *Store) Delete(ctx context.Context, dbtx gadb.DBTX, id string) error {
 	return s.DeleteMany(ctx, dbtx, []string{id})
 }
@@ -168,6 +204,7 @@ func (s *Store) FindOne(ctx context.Context, id string) (*IntegrationKey, error)
 		ServiceID: row.ServiceID.String(),
 
 		ExternalSystemName: row.ExternalSystemName.String,
+		AutoCloseMinutes:   int(row.AutoCloseMinutes.Int32),
 	}, nil
 }
 
@@ -195,6 +232,7 @@ func (s *Store) FindAllByService(ctx context.Context, serviceID string) ([]Integ
 			ServiceID: row.ServiceID.String(),
 
 			ExternalSystemName: row.ExternalSystemName.String,
+			AutoCloseMinutes:   int(row.AutoCloseMinutes.Int32),
 		}
 	}
 	return keys, nil
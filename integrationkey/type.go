@@ -16,8 +16,16 @@ const (
 	TypeGeneric                Type = "generic"
 	TypeEmail                  Type = "email"
 	TypeUniversal              Type = "universal"
+	TypePagerDuty              Type = "pagerduty"
+	TypeOTLP                   Type = "otlp"
 )
 
+// IsRuleBased returns true if keys of this type are processed by a universal-key
+// rule config (and authenticate with UIK tokens).
+func (s Type) IsRuleBased() bool {
+	return s == TypeUniversal || s == TypeOTLP
+}
+
 func (s Type) Value() (driver.Value, error) {
 	str := string(s)
 	return str, nil
@@ -35,7 +35,17 @@ func newClaims(keyID, tokenID uuid.UUID) jwt.Claims {
 	}
 }
 
+// AuthorizeUIK will authorize a token for a universal integration key.
 func (s *Store) AuthorizeUIK(ctx context.Context, tokStr string) (context.Context, error) {
+	return s.authorizeRuleKey(ctx, tokStr, TypeUniversal)
+}
+
+// AuthorizeOTLP will authorize a token for an OTLP integration key.
+func (s *Store) AuthorizeOTLP(ctx context.Context, tokStr string) (context.Context, error) {
+	return s.authorizeRuleKey(ctx, tokStr, TypeOTLP)
+}
+
+func (s *Store) authorizeRuleKey(ctx context.Context, tokStr string, t Type) (context.Context, error) {
 	if !expflag.ContextHas(ctx, expflag.UnivKeys) {
 		return ctx, permission.Unauthorized()
 	}
@@ -59,6 +69,7 @@ func (s *Store) AuthorizeUIK(ctx context.Context, tokStr string) (context.Contex
 
 	serviceID, err := gadb.New(s.db).IntKeyUIKValidateService(ctx, gadb.IntKeyUIKValidateServiceParams{
 		KeyID:   keyID,
+		KeyType: gadb.EnumIntegrationKeysType(t),
 		TokenID: uuid.NullUUID{UUID: tokID, Valid: true},
 	})
 	if errors.Is(err, sql.ErrNoRows) {
@@ -103,8 +114,8 @@ func (s *Store) GenerateToken(ctx context.Context, db gadb.DBTX, id uuid.UUID) (
 	if err != nil {
 		return "", err
 	}
-	if key.Type != gadb.EnumIntegrationKeysType(TypeUniversal) {
-		return "", validation.NewFieldError("ID", "key is not a universal key")
+	if !Type(key.Type).IsRuleBased() {
+		return "", validation.NewFieldError("ID", "key does not support tokens")
 	}
 
 	tokID := uuid.New()
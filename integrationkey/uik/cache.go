@@ -0,0 +1,108 @@
+package uik
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ConfigRecheckInterval is how long a cached config is used before its revision is checked against the DB.
+//
+// Changes are normally picked up immediately via NOTIFY; this only bounds staleness if a notification is missed.
+const ConfigRecheckInterval = time.Minute
+
+// configCache holds compiled configs keyed by integration key ID and config revision.
+type configCache struct {
+	mx      sync.Mutex
+	entries map[uuid.UUID]*cacheEntry
+
+	now func() time.Time
+}
+
+type cacheEntry struct {
+	rev     int64
+	checked time.Time
+
+	// cfg is nil if the entry was invalidated and must be reloaded.
+	cfg *CompiledConfig
+}
+
+func newConfigCache() *configCache {
+	return &configCache{
+		entries: make(map[uuid.UUID]*cacheEntry),
+		now:     time.Now,
+	}
+}
+
+// Get returns the cached config and revision for the key. If recheck is true, the revision
+// should be compared against the DB before use.
+func (c *configCache) Get(keyID uuid.UUID) (cfg *CompiledConfig, rev int64, recheck bool) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	e := c.entries[keyID]
+	if e == nil || e.cfg == nil {
+		return nil, 0, false
+	}
+
+	return e.cfg, e.rev, c.now().Sub(e.checked) >= ConfigRecheckInterval
+}
+
+// Touch marks the cached config as current, if it is still at revision rev.
+func (c *configCache) Touch(keyID uuid.UUID, rev int64) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	e := c.entries[keyID]
+	if e == nil || e.rev != rev {
+		return
+	}
+	e.checked = c.now()
+}
+
+// Put stores a compiled config. It is ignored if the cache has already seen a newer revision,
+// so a slow load can't overwrite a later invalidation.
+func (c *configCache) Put(keyID uuid.UUID, rev int64, cfg *CompiledConfig) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if e := c.entries[keyID]; e != nil && e.rev > rev {
+		return
+	}
+	c.entries[keyID] = &cacheEntry{rev: rev, cfg: cfg, checked: c.now()}
+}
+
+// Invalidate drops the cached config for the key if it is older than rev.
+func (c *configCache) Invalidate(keyID uuid.UUID, rev int64) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if e := c.entries[keyID]; e != nil && e.rev >= rev {
+		return
+	}
+	c.entries[keyID] = &cacheEntry{rev: rev}
+}
+
+// HandleConfigChange processes a config change notification, with a payload of the form `<key ID>:<revision>`.
+func (h *Handler) HandleConfigChange(ctx context.Context, payload string) error {
+	idStr, revStr, ok := strings.Cut(payload, ":")
+	if !ok {
+		return fmt.Errorf("invalid uik config change payload: %q", payload)
+	}
+	keyID, err := uuid.Parse(idStr)
+	if err != nil {
+		return fmt.Errorf("invalid uik config change key ID: %w", err)
+	}
+	rev, err := strconv.ParseInt(revStr, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid uik config change revision: %w", err)
+	}
+
+	h.cache.Invalidate(keyID, rev)
+	return nil
+}
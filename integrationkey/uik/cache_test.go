@@ -0,0 +1,65 @@
+package uik
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigCache(t *testing.T) {
+	c := newConfigCache()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	c.now = func() time.Time { return now }
+
+	id := uuid.New()
+	cfg1, cfg2 := &CompiledConfig{}, &CompiledConfig{}
+
+	cfg, _, _ := c.Get(id)
+	require.Nil(t, cfg, "empty cache")
+
+	c.Put(id, 1, cfg1)
+	cfg, rev, recheck := c.Get(id)
+	require.Same(t, cfg1, cfg)
+	require.EqualValues(t, 1, rev)
+	require.False(t, recheck)
+
+	now = now.Add(ConfigRecheckInterval)
+	_, _, recheck = c.Get(id)
+	require.True(t, recheck, "should recheck after interval")
+	c.Touch(id, 1)
+	_, _, recheck = c.Get(id)
+	require.False(t, recheck, "touch should reset recheck")
+
+	c.Invalidate(id, 1)
+	cfg, _, _ = c.Get(id)
+	require.Same(t, cfg1, cfg, "invalidating the current revision is a no-op")
+
+	c.Invalidate(id, 2)
+	cfg, _, _ = c.Get(id)
+	require.Nil(t, cfg, "should drop older revisions")
+
+	c.Put(id, 1, cfg1)
+	cfg, _, _ = c.Get(id)
+	require.Nil(t, cfg, "should not store a revision older than an invalidation")
+
+	c.Put(id, 2, cfg2)
+	cfg, _, _ = c.Get(id)
+	require.Same(t, cfg2, cfg)
+}
+
+func TestHandler_HandleConfigChange(t *testing.T) {
+	h := &Handler{cache: newConfigCache()}
+	id := uuid.New()
+	h.cache.Put(id, 1, &CompiledConfig{})
+
+	require.NoError(t, h.HandleConfigChange(context.Background(), id.String()+":2"))
+	cfg, _, _ := h.cache.Get(id)
+	require.Nil(t, cfg)
+
+	require.Error(t, h.HandleConfigChange(context.Background(), id.String()))
+	require.Error(t, h.HandleConfigChange(context.Background(), "foo:1"))
+	require.Error(t, h.HandleConfigChange(context.Background(), id.String()+":x"))
+}
@@ -56,32 +56,66 @@ func NewCompiledConfig(cfg gadb.UIKConfigV1) (*CompiledConfig, error) {
 	return res, nil
 }
 
+// Result is the outcome of evaluating a CompiledConfig.
+type Result struct {
+	// MatchedRules are the indexes of rules whose condition matched.
+	MatchedRules []int
+
+	// UsedDefaultActions is true if no rules matched and the default actions were used.
+	UsedDefaultActions bool
+
+	// Actions are the evaluated actions (with params resolved to their values).
+	Actions []gadb.UIKActionV1
+
+	// ResponseStatus is the HTTP status code set by the first matched rule that specifies one, or zero.
+	ResponseStatus int
+}
+
 // Run will execute the compiled config against the provided VM and environment.
 func (c *CompiledConfig) Run(vm *vm.VM, env any) (actions []gadb.UIKActionV1, err error) {
-	var anyMatched bool
+	res, err := c.Eval(vm, env)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Actions, nil
+}
+
+// Eval will execute the compiled config against the provided VM and environment, reporting which rules matched.
+func (c *CompiledConfig) Eval(vm *vm.VM, env any) (*Result, error) {
+	var res Result
 	for i, p := range c.CompiledRules {
 		ruleActions, matched, err := p.Run(vm, env)
 		if err != nil {
 			return nil, &RuleError{
 				Index: i,
+				Name:  p.Name,
 				Err:   fmt.Errorf("run rules: %w", err),
 			}
 		}
-		actions = append(actions, ruleActions...)
-		anyMatched = anyMatched || matched
-		if matched && !p.ContinueAfterMatch {
+		if !matched {
+			continue
+		}
+		res.Actions = append(res.Actions, ruleActions...)
+		res.MatchedRules = append(res.MatchedRules, i)
+		if res.ResponseStatus == 0 {
+			res.ResponseStatus = p.ResponseStatus
+		}
+		if !p.ContinueAfterMatch {
 			break
 		}
 	}
 
-	if anyMatched {
-		return actions, nil
+	if len(res.MatchedRules) > 0 {
+		return &res, nil
 	}
 
 	act, err := runActions(vm, c.DefaultActions, env)
 	if err != nil {
 		return nil, fmt.Errorf("run default actions: %w", err)
 	}
+	res.Actions = act
+	res.UsedDefaultActions = true
 
-	return act, nil
+	return &res, nil
 }
@@ -83,3 +83,32 @@ func TestCompiledConfig_Run(t *testing.T) {
 		},
 		[]string{"value2", "value3"})
 }
+
+func TestCompiledConfig_Eval(t *testing.T) {
+	cfg := gadb.UIKConfigV1{
+		Rules: []gadb.UIKRuleV1{
+			{Name: "rule1", ConditionExpr: "false"},
+			{Name: "rule2", ConditionExpr: "true", ContinueAfterMatch: true, Actions: []gadb.UIKActionV1{{Params: map[string]string{"key": `"value2"`}}}},
+			{Name: "rule3", ConditionExpr: "true", Actions: []gadb.UIKActionV1{{Params: map[string]string{"key": `"value3"`}}}},
+		},
+		DefaultActions: []gadb.UIKActionV1{{Params: map[string]string{"key": `"valueDefault"`}}},
+	}
+	cmp, err := NewCompiledConfig(cfg)
+	require.NoError(t, err)
+
+	var vm vm.VM
+	res, err := cmp.Eval(&vm, nil)
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2}, res.MatchedRules, "should report matched rules in order")
+	require.False(t, res.UsedDefaultActions)
+	require.Len(t, res.Actions, 2)
+
+	cfg.Rules = cfg.Rules[:1]
+	cmp, err = NewCompiledConfig(cfg)
+	require.NoError(t, err)
+	res, err = cmp.Eval(&vm, nil)
+	require.NoError(t, err)
+	require.Empty(t, res.MatchedRules)
+	require.True(t, res.UsedDefaultActions)
+	require.Equal(t, "valueDefault", res.Actions[0].Param("key"))
+}
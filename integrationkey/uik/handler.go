@@ -6,17 +6,24 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"maps"
+	"mime"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/expr-lang/expr/vm"
 	"github.com/google/uuid"
 	"github.com/target/goalert/alert"
+	"github.com/target/goalert/auth"
 	"github.com/target/goalert/expflag"
 	"github.com/target/goalert/gadb"
 	"github.com/target/goalert/integrationkey"
 	"github.com/target/goalert/permission"
 	"github.com/target/goalert/util/errutil"
+	"github.com/target/goalert/util/log"
 	"github.com/target/goalert/validation"
 )
 
@@ -24,7 +31,9 @@ type Handler struct {
 	intStore   *integrationkey.Store
 	alertStore *alert.Store
 	db         TxAble
-	hc         *http.Client
+
+	cache   *configCache
+	samples *sampleLimiter
 }
 
 type TxAble interface {
@@ -32,42 +41,111 @@ type TxAble interface {
 	BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error)
 }
 
-func NewHandler(db TxAble, hc *http.Client, intStore *integrationkey.Store, aStore *alert.Store) *Handler {
-	return &Handler{intStore: intStore, hc: hc, db: db, alertStore: aStore}
+func NewHandler(db TxAble, intStore *integrationkey.Store, aStore *alert.Store) *Handler {
+	return &Handler{intStore: intStore, db: db, alertStore: aStore, cache: newConfigCache(), samples: newSampleLimiter()}
 }
 
-func (h *Handler) handleAction(ctx context.Context, act gadb.UIKActionV1) (inserted bool, err error) {
-	var didInsertSignals bool
-	switch act.Dest.Type {
-	case "builtin-webhook":
-		req, err := http.NewRequest("POST", act.Dest.Arg("webhook_url"), strings.NewReader(act.Param("body")))
-		if err != nil {
-			return false, err
-		}
-		req.Header.Set("Content-Type", act.Param("content-type"))
+// Response describes how a request was handled. It is returned as the response body when requested by the caller.
+type Response struct {
+	// MatchedRules are the IDs of the rules that matched the request.
+	MatchedRules []uuid.UUID `json:"matchedRules"`
 
-		_, err = h.hc.Do(req.WithContext(ctx))
-		if err != nil {
-			return false, err
-		}
+	// UsedDefaultActions is true if no rules matched and the default actions were taken.
+	UsedDefaultActions bool `json:"usedDefaultActions"`
+
+	CreatedAlertIDs []int `json:"createdAlertIDs"`
+	UpdatedAlertIDs []int `json:"updatedAlertIDs"`
+
+	// Signals is the number of signals queued for delivery.
+	Signals int `json:"signals"`
+
+	// status is the HTTP status code set by a matched rule, if any.
+	status int
+}
+
+func newResponse() *Response {
+	return &Response{
+		MatchedRules:    []uuid.UUID{},
+		CreatedAlertIDs: []int{},
+		UpdatedAlertIDs: []int{},
+	}
+}
 
+func (r *Response) recordAlert(id int, isNew bool) {
+	if isNew {
+		r.CreatedAlertIDs = append(r.CreatedAlertIDs, id)
+		return
+	}
+	r.UpdatedAlertIDs = append(r.UpdatedAlertIDs, id)
+}
+
+func (h *Handler) handleAction(ctx context.Context, act gadb.UIKActionV1, resp *Response) (inserted bool, err error) {
+	var didInsertSignals bool
+	switch act.Dest.Type {
 	case "builtin-alert":
 		status := alert.StatusTriggered
-		if act.Param("close") == "true" {
+		switch {
+		case act.Param(alert.ParamClose) == "true":
 			status = alert.StatusClosed
+		case act.Param(alert.ParamAck) == "true":
+			status = alert.StatusActive
+		}
+
+		var sev alert.Severity
+		if s := act.Param(alert.ParamSeverity); s != "" {
+			var ok bool
+			sev, ok = alert.ParseSeverity(s)
+			if !ok {
+				return false, validation.NewFieldError("severity", "unknown severity "+s)
+			}
 		}
 
-		_, _, err := h.alertStore.CreateOrUpdate(ctx, &alert.Alert{
+		a := &alert.Alert{
 			ServiceID: permission.ServiceID(ctx),
 			Summary:   act.Param("summary"),
 			Details:   act.Param("details"),
 			Source:    alert.SourceUniversal,
 			Status:    status,
+			Severity:  sev,
+		}
+
+		groupKey := act.Param(alert.ParamGroupKey)
+		if groupKey == "" || status != alert.StatusTriggered {
+			n, isNew, err := h.alertStore.CreateOrUpdate(ctx, a)
+			if err != nil {
+				return false, err
+			}
+			if n != nil {
+				// nil when closing an alert that doesn't exist
+				resp.recordAlert(n.ID, isNew)
+			}
+			break
+		}
+
+		var window time.Duration
+		if w := act.Param(alert.ParamGroupWindow); w != "" {
+			window, err = time.ParseDuration(w)
+			if err != nil {
+				return false, validation.NewFieldError(alert.ParamGroupWindow, "invalid duration "+w)
+			}
+		}
+		keyID, err := uuid.Parse(permission.Source(ctx).ID)
+		if err != nil {
+			return false, err
+		}
+
+		id, isNew, err := h.alertStore.CreateOrGroup(ctx, a, alert.Grouping{
+			IntegrationKeyID: keyID,
+			Key:              groupKey,
+			Window:           window,
 		})
 		if err != nil {
 			return false, err
 		}
+		resp.recordAlert(id, isNew)
 	default:
+		// Everything else (including webhooks) is queued as a signal and delivered through the
+		// outgoing message queue, so failed deliveries are retried and show up in the message log.
 		data, err := json.Marshal(act.Params)
 		if err != nil {
 			return false, err
@@ -82,6 +160,7 @@ func (h *Handler) handleAction(ctx context.Context, act gadb.UIKActionV1) (inser
 			return false, err
 		}
 		didInsertSignals = true
+		resp.Signals++
 	}
 
 	return didInsertSignals, nil
@@ -92,80 +171,220 @@ type EventNewSignals struct {
 	ServiceID uuid.UUID
 }
 
-func (h *Handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
-	ctx := req.Context()
+// keyID will return the ID of the rule-based integration key the request context is authorized for.
+func (h *Handler) keyID(ctx context.Context) (uuid.UUID, error) {
 	if !expflag.ContextHas(ctx, expflag.UnivKeys) {
-		errutil.HTTPError(ctx, w, validation.NewGenericError("universal keys are disabled"))
-		return
+		return uuid.Nil, validation.NewGenericError("universal keys are disabled")
 	}
 
-	err := permission.LimitCheckAny(req.Context(), permission.Service)
-	if errutil.HTTPError(ctx, w, err) {
-		return
+	err := permission.LimitCheckAny(ctx, permission.Service)
+	if err != nil {
+		return uuid.Nil, err
 	}
 	src := permission.Source(ctx)
 	if src.Type != permission.SourceTypeUIK {
 		// we don't want to allow regular API keys to be used here
-		errutil.HTTPError(ctx, w, permission.Unauthorized())
-		return
+		return uuid.Nil, permission.Unauthorized()
 	}
 
-	keyID, err := uuid.Parse(src.ID)
-	if errutil.HTTPError(ctx, w, err) {
-		return
+	return uuid.Parse(src.ID)
+}
+
+func (h *Handler) compiledConfig(ctx context.Context, keyID uuid.UUID) (*CompiledConfig, error) {
+	compiled, rev, recheck := h.cache.Get(keyID)
+	if compiled != nil && !recheck {
+		return compiled, nil
 	}
-	data, err := io.ReadAll(req.Body)
-	if errutil.HTTPError(ctx, w, err) {
-		return
+	if compiled != nil {
+		cur, err := h.intStore.ConfigRevision(ctx, h.db, keyID)
+		if err != nil {
+			return nil, err
+		}
+		if cur == rev {
+			h.cache.Touch(keyID, rev)
+			return compiled, nil
+		}
 	}
-	var body any
-	err = json.Unmarshal(data, &body)
-	if errutil.HTTPError(ctx, w, validation.WrapError(err)) {
-		return
+
+	cfg, rev, err := h.intStore.RevisionedConfig(ctx, h.db, keyID)
+	if err != nil {
+		return nil, err
 	}
 
-	cfg, err := h.intStore.Config(ctx, h.db, keyID)
-	if errutil.HTTPError(ctx, w, err) {
-		return
+	compiled, err = NewCompiledConfig(*cfg)
+	if err != nil {
+		return nil, err
 	}
+	h.cache.Put(keyID, rev, compiled)
 
-	// TODO: cache
-	compiled, err := NewCompiledConfig(*cfg)
-	if errutil.HTTPError(ctx, w, err) {
-		return
+	return compiled, nil
+}
+
+// runEnv will evaluate the config against env and process the resulting actions, recording the results in resp.
+func (h *Handler) runEnv(ctx context.Context, compiled *CompiledConfig, vm *vm.VM, env map[string]any, resp *Response) (insertedAny bool, err error) {
+	res, err := compiled.Eval(vm, env)
+	if err != nil {
+		return false, validation.WrapError(err)
+	}
+	for _, idx := range res.MatchedRules {
+		resp.MatchedRules = append(resp.MatchedRules, compiled.Rules[idx].ID)
+	}
+	resp.UsedDefaultActions = resp.UsedDefaultActions || res.UsedDefaultActions
+	if resp.status == 0 {
+		resp.status = res.ResponseStatus
 	}
 
-	q := req.URL.Query()
+	for _, act := range res.Actions {
+		inserted, err := h.handleAction(ctx, act, resp)
+		if err != nil {
+			return false, err
+		}
+		insertedAny = insertedAny || inserted
+	}
+
+	return insertedAny, nil
+}
+
+// wantsJSON returns true if the caller explicitly accepts a JSON response, with a non-zero quality value.
+func wantsJSON(req *http.Request) bool {
+	for _, part := range strings.Split(req.Header.Get("Accept"), ",") {
+		mt, params, _ := mime.ParseMediaType(strings.TrimSpace(part))
+		if mt != "application/json" {
+			continue
+		}
+		q, ok := params["q"]
+		if !ok {
+			return true
+		}
+		qVal, err := strconv.ParseFloat(q, 64)
+		return err == nil && qVal > 0
+	}
+
+	return false
+}
+
+// allowsBody returns false for status codes that must not include a response body.
+func allowsBody(status int) bool {
+	return status != http.StatusNoContent && status != http.StatusNotModified
+}
+
+// withoutToken returns a copy of q without any parameters that may contain the key's secret,
+// so that it is never recorded or evaluated in a dry-run.
+func withoutToken(q url.Values) url.Values {
+	q = maps.Clone(q)
+	for _, name := range auth.TokenParams {
+		delete(q, name)
+	}
+	return q
+}
+
+func reqEnv(req *http.Request, body any) map[string]any {
+	return newEnv(body, req.URL.Query(), req.UserAgent(), req.RemoteAddr)
+}
+
+func newEnv(body any, q url.Values, ua, ip string) map[string]any {
 	query := make(map[string]string)
 	for key := range q {
 		query[key] = q.Get(key)
 	}
 	querya := map[string][]string(q)
-	env := map[string]any{
+	return map[string]any{
 		"sprintf": fmt.Sprintf,
 		"req": map[string]any{
 			"body":   body,
 			"query":  query,
 			"querya": querya,
-			"ua":     req.UserAgent(),
-			"ip":     req.RemoteAddr,
+			"ua":     ua,
+			"ip":     ip,
 		},
 	}
+}
+
+// DryRun will evaluate cfg against a recorded (or hand-written) request without executing any actions.
+func DryRun(cfg gadb.UIKConfigV1, smp integrationkey.Sample) (*Result, error) {
+	var body any
+	err := json.Unmarshal(smp.Body, &body)
+	if err != nil {
+		return nil, validation.NewFieldError("Body", err.Error())
+	}
+	q, err := url.ParseQuery(smp.Query)
+	if err != nil {
+		return nil, validation.NewFieldError("Query", err.Error())
+	}
+	q = withoutToken(q)
+
+	compiled, err := NewCompiledConfig(cfg)
+	if err != nil {
+		return nil, validation.WrapError(err)
+	}
 
 	var vm vm.VM
-	actions, err := compiled.Run(&vm, env)
+	res, err := compiled.Eval(&vm, newEnv(body, q, smp.UserAgent, smp.RemoteAddr))
+	if err != nil {
+		return nil, validation.WrapError(err)
+	}
+
+	return res, nil
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	keyID, err := h.keyID(ctx)
+	if errutil.HTTPError(ctx, w, err) {
+		return
+	}
+
+	data, err := io.ReadAll(req.Body)
+	if errutil.HTTPError(ctx, w, err) {
+		return
+	}
+	var body any
+	err = json.Unmarshal(data, &body)
 	if errutil.HTTPError(ctx, w, validation.WrapError(err)) {
 		return
 	}
 
-	var insertedAny bool
-	for _, act := range actions {
-		inserted, err := h.handleAction(ctx, act)
-		if errutil.HTTPError(ctx, w, err) {
-			return
+	// record before evaluating, so requests that fail rules can still be debugged
+	if h.samples.Allow(keyID) {
+		err = h.intStore.RecordSample(ctx, h.db, keyID, integrationkey.Sample{
+			Body:       data,
+			Query:      withoutToken(req.URL.Query()).Encode(),
+			UserAgent:  req.UserAgent(),
+			RemoteAddr: req.RemoteAddr,
+		})
+		if err != nil {
+			log.Log(ctx, fmt.Errorf("record uik sample: %w", err))
 		}
-		insertedAny = insertedAny || inserted
 	}
 
-	w.WriteHeader(http.StatusNoContent)
+	compiled, err := h.compiledConfig(ctx, keyID)
+	if errutil.HTTPError(ctx, w, err) {
+		return
+	}
+
+	var vm vm.VM
+	resp := newResponse()
+	_, err = h.runEnv(ctx, compiled, &vm, reqEnv(req, body), resp)
+	if errutil.HTTPError(ctx, w, err) {
+		return
+	}
+
+	status := resp.status
+	if !wantsJSON(req) || !allowsBody(status) {
+		if status == 0 {
+			status = http.StatusNoContent
+		}
+		w.WriteHeader(status)
+		return
+	}
+
+	if status == 0 {
+		status = http.StatusOK
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	err = json.NewEncoder(w).Encode(resp)
+	if err != nil {
+		log.Log(ctx, fmt.Errorf("write uik response: %w", err))
+	}
 }
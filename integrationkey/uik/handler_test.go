@@ -0,0 +1,99 @@
+package uik
+
+import (
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/integrationkey"
+)
+
+func TestDryRun(t *testing.T) {
+	cfg := gadb.UIKConfigV1{
+		Rules: []gadb.UIKRuleV1{{
+			Name:          "firing",
+			ConditionExpr: `req.body.status == "firing" && req.query.env == "prod"`,
+			Actions:       []gadb.UIKActionV1{{Params: map[string]string{"summary": `sprintf("%s (%s)", req.body.name, req.ua)`}}},
+		}},
+	}
+
+	res, err := DryRun(cfg, integrationkey.Sample{
+		Body:      []byte(`{"status": "firing", "name": "disk full"}`),
+		Query:     "env=prod",
+		UserAgent: "curl",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int{0}, res.MatchedRules)
+	require.Equal(t, "disk full (curl)", res.Actions[0].Param("summary"))
+
+	res, err = DryRun(cfg, integrationkey.Sample{Body: []byte(`{"status": "firing"}`)})
+	require.NoError(t, err)
+	require.True(t, res.UsedDefaultActions)
+
+	_, err = DryRun(cfg, integrationkey.Sample{Body: []byte(`{`)})
+	require.Error(t, err, "invalid body")
+}
+
+func TestDryRun_Token(t *testing.T) {
+	cfg := gadb.UIKConfigV1{
+		Rules: []gadb.UIKRuleV1{{
+			Name:          "token",
+			ConditionExpr: `req.query.token != "" || req.query.key != "" || req.query.integrationKey != "" || req.query.integration_key != ""`,
+		}},
+	}
+
+	res, err := DryRun(cfg, integrationkey.Sample{
+		Body:  []byte(`{}`),
+		Query: "token=secret&key=secret&integrationKey=secret&integration_key=secret&env=prod",
+	})
+	require.NoError(t, err)
+	require.Empty(t, res.MatchedRules, "token parameters should not be available to rules")
+}
+
+func TestWithoutToken(t *testing.T) {
+	q := url.Values{"token": {"secret"}, "key": {"secret"}, "integrationKey": {"secret"}, "integration_key": {"secret"}, "env": {"prod"}}
+	require.Equal(t, "env=prod", withoutToken(q).Encode())
+	require.Equal(t, "secret", q.Get("token"), "original should not be modified")
+}
+
+func TestWantsJSON(t *testing.T) {
+	check := func(accept string, exp bool) {
+		t.Helper()
+		req := httptest.NewRequest("POST", "/api/v2/uik", nil)
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		require.Equal(t, exp, wantsJSON(req), accept)
+	}
+
+	check("", false)
+	check("*/*", false)
+	check("application/json", true)
+	check("text/html, application/json;q=0.9", true)
+	check("text/html, application/json;q=0", false)
+	check("application/json; q=0.0", false)
+	check("application/json;q=bad", false)
+}
+
+func TestAllowsBody(t *testing.T) {
+	require.True(t, allowsBody(200))
+	require.True(t, allowsBody(202))
+	require.False(t, allowsBody(204))
+	require.False(t, allowsBody(304))
+}
+
+func TestDryRun_ResponseStatus(t *testing.T) {
+	cfg := gadb.UIKConfigV1{
+		Rules: []gadb.UIKRuleV1{
+			{Name: "no status", ConditionExpr: "true", ContinueAfterMatch: true},
+			{Name: "accepted", ConditionExpr: "true", ContinueAfterMatch: true, ResponseStatus: 202},
+			{Name: "ignored", ConditionExpr: "true", ResponseStatus: 200},
+		},
+	}
+
+	res, err := DryRun(cfg, integrationkey.Sample{Body: []byte(`{}`)})
+	require.NoError(t, err)
+	require.Equal(t, 202, res.ResponseStatus, "first matched rule with a status wins")
+}
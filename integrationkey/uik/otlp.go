@@ -0,0 +1,378 @@
+package uik
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/expr-lang/expr/vm"
+	"github.com/target/goalert/util/errutil"
+	"github.com/target/goalert/util/log"
+	"github.com/target/goalert/validation"
+)
+
+// MaxOTLPRecords is the maximum number of log records (or span events) accepted in a single OTLP request.
+const MaxOTLPRecords = 1000
+
+// OTLP record kinds, available to rules as `otlp.kind`.
+const (
+	OTLPKindLog       = "log"
+	OTLPKindSpanEvent = "span_event"
+)
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpAnyValue struct {
+	StringValue *string         `json:"stringValue"`
+	BoolValue   *bool           `json:"boolValue"`
+	IntValue    json.RawMessage `json:"intValue"`
+	DoubleValue *float64        `json:"doubleValue"`
+	BytesValue  *string         `json:"bytesValue"`
+	ArrayValue  *struct {
+		Values []otlpAnyValue `json:"values"`
+	} `json:"arrayValue"`
+	KvlistValue *struct {
+		Values []otlpKeyValue `json:"values"`
+	} `json:"kvlistValue"`
+}
+
+// Value returns the plain Go value for use in expressions.
+func (v otlpAnyValue) Value() any {
+	switch {
+	case v.StringValue != nil:
+		return *v.StringValue
+	case v.BoolValue != nil:
+		return *v.BoolValue
+	case v.IntValue != nil:
+		// int64 values are encoded as JSON strings, but some exporters send numbers
+		n, err := strconv.ParseInt(string(bytes.Trim(v.IntValue, `"`)), 10, 64)
+		if err != nil {
+			return nil
+		}
+		return n
+	case v.DoubleValue != nil:
+		return *v.DoubleValue
+	case v.BytesValue != nil:
+		return *v.BytesValue
+	case v.ArrayValue != nil:
+		res := make([]any, len(v.ArrayValue.Values))
+		for i, val := range v.ArrayValue.Values {
+			res[i] = val.Value()
+		}
+		return res
+	case v.KvlistValue != nil:
+		return otlpAttrs(v.KvlistValue.Values)
+	}
+
+	return nil
+}
+
+func otlpAttrs(kv []otlpKeyValue) map[string]any {
+	res := make(map[string]any, len(kv))
+	for _, a := range kv {
+		res[a.Key] = a.Value.Value()
+	}
+	return res
+}
+
+// otlpNanos is a unix timestamp in nanoseconds, encoded as either a JSON string or number.
+type otlpNanos int64
+
+func (n *otlpNanos) UnmarshalJSON(data []byte) error {
+	v, err := strconv.ParseInt(string(bytes.Trim(data, `"`)), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid timestamp: %w", err)
+	}
+	*n = otlpNanos(v)
+	return nil
+}
+
+func (n otlpNanos) String() string {
+	if n == 0 {
+		return ""
+	}
+	return time.Unix(0, int64(n)).UTC().Format(time.RFC3339Nano)
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+type otlpScope struct {
+	Name       string         `json:"name"`
+	Version    string         `json:"version"`
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+func (s otlpScope) env() map[string]any {
+	return map[string]any{
+		"name":       s.Name,
+		"version":    s.Version,
+		"attributes": otlpAttrs(s.Attributes),
+	}
+}
+
+type otlpLogsRequest struct {
+	ResourceLogs []struct {
+		Resource  otlpResource `json:"resource"`
+		ScopeLogs []struct {
+			Scope      otlpScope `json:"scope"`
+			LogRecords []struct {
+				TimeUnixNano         otlpNanos      `json:"timeUnixNano"`
+				ObservedTimeUnixNano otlpNanos      `json:"observedTimeUnixNano"`
+				SeverityNumber       int            `json:"severityNumber"`
+				SeverityText         string         `json:"severityText"`
+				Body                 otlpAnyValue   `json:"body"`
+				Attributes           []otlpKeyValue `json:"attributes"`
+				TraceID              string         `json:"traceId"`
+				SpanID               string         `json:"spanId"`
+			} `json:"logRecords"`
+		} `json:"scopeLogs"`
+	} `json:"resourceLogs"`
+}
+
+type otlpTracesRequest struct {
+	ResourceSpans []struct {
+		Resource   otlpResource `json:"resource"`
+		ScopeSpans []struct {
+			Scope otlpScope `json:"scope"`
+			Spans []struct {
+				TraceID    string         `json:"traceId"`
+				SpanID     string         `json:"spanId"`
+				Name       string         `json:"name"`
+				Kind       int            `json:"kind"`
+				Attributes []otlpKeyValue `json:"attributes"`
+				Status     struct {
+					Code    int    `json:"code"`
+					Message string `json:"message"`
+				} `json:"status"`
+				Events []struct {
+					TimeUnixNano otlpNanos      `json:"timeUnixNano"`
+					Name         string         `json:"name"`
+					Attributes   []otlpKeyValue `json:"attributes"`
+				} `json:"events"`
+			} `json:"spans"`
+		} `json:"scopeSpans"`
+	} `json:"resourceSpans"`
+}
+
+// otlpLogRecords flattens an OTLP/JSON logs export request into one rule environment per log record.
+func otlpLogRecords(data []byte) ([]map[string]any, error) {
+	var r otlpLogsRequest
+	err := json.Unmarshal(data, &r)
+	if err != nil {
+		return nil, validation.WrapError(err)
+	}
+
+	var records []map[string]any
+	for _, rl := range r.ResourceLogs {
+		res := otlpAttrs(rl.Resource.Attributes)
+		for _, sl := range rl.ScopeLogs {
+			scope := sl.Scope.env()
+			for _, l := range sl.LogRecords {
+				t := l.TimeUnixNano
+				if t == 0 {
+					t = l.ObservedTimeUnixNano
+				}
+				records = append(records, map[string]any{
+					"kind":            OTLPKindLog,
+					"resource":        res,
+					"scope":           scope,
+					"attributes":      otlpAttrs(l.Attributes),
+					"body":            l.Body.Value(),
+					"severity":        l.SeverityText,
+					"severity_number": l.SeverityNumber,
+					"time":            t.String(),
+					"trace_id":        l.TraceID,
+					"span_id":         l.SpanID,
+				})
+				if len(records) > MaxOTLPRecords {
+					return nil, validation.NewGenericError(fmt.Sprintf("too many log records; max %d per request", MaxOTLPRecords))
+				}
+			}
+		}
+	}
+
+	return records, nil
+}
+
+// otlpSpanEvents flattens an OTLP/JSON traces export request into one rule environment per span event.
+//
+// Spans without events are ignored.
+func otlpSpanEvents(data []byte) ([]map[string]any, error) {
+	var r otlpTracesRequest
+	err := json.Unmarshal(data, &r)
+	if err != nil {
+		return nil, validation.WrapError(err)
+	}
+
+	var records []map[string]any
+	for _, rs := range r.ResourceSpans {
+		res := otlpAttrs(rs.Resource.Attributes)
+		for _, ss := range rs.ScopeSpans {
+			scope := ss.Scope.env()
+			for _, s := range ss.Spans {
+				span := map[string]any{
+					"name":           s.Name,
+					"kind":           s.Kind,
+					"attributes":     otlpAttrs(s.Attributes),
+					"status_code":    s.Status.Code,
+					"status_message": s.Status.Message,
+				}
+				for _, e := range s.Events {
+					records = append(records, map[string]any{
+						"kind":       OTLPKindSpanEvent,
+						"resource":   res,
+						"scope":      scope,
+						"span":       span,
+						"attributes": otlpAttrs(e.Attributes),
+						"body":       e.Name,
+						"name":       e.Name,
+						"time":       e.TimeUnixNano.String(),
+						"trace_id":   s.TraceID,
+						"span_id":    s.SpanID,
+					})
+					if len(records) > MaxOTLPRecords {
+						return nil, validation.NewGenericError(fmt.Sprintf("too many span events; max %d per request", MaxOTLPRecords))
+					}
+				}
+			}
+		}
+	}
+
+	return records, nil
+}
+
+// otlpSignal describes how records of an OTLP signal type are parsed and reported.
+type otlpSignal struct {
+	parse func([]byte) ([]map[string]any, error)
+
+	// rejectedField is the partial success field counting rejected items (e.g., `rejectedLogRecords`).
+	rejectedField string
+
+	// rejected returns the number of items, as counted by rejectedField, for unprocessed records.
+	rejected func(records []map[string]any) int64
+}
+
+var (
+	otlpLogs = otlpSignal{
+		parse:         otlpLogRecords,
+		rejectedField: "rejectedLogRecords",
+		rejected:      func(records []map[string]any) int64 { return int64(len(records)) },
+	}
+	otlpTraces = otlpSignal{
+		parse:         otlpSpanEvents,
+		rejectedField: "rejectedSpans",
+		rejected:      otlpRejectedSpans,
+	}
+)
+
+// otlpRejectedSpans returns the number of distinct spans the span event records belong to.
+func otlpRejectedSpans(records []map[string]any) int64 {
+	spans := make(map[[2]any]struct{}, len(records))
+	for _, rec := range records {
+		spans[[2]any{rec["trace_id"], rec["span_id"]}] = struct{}{}
+	}
+	return int64(len(spans))
+}
+
+// otlpPartialSuccess returns an Export*ServiceResponse body reporting rejected items.
+//
+// Per the OTLP spec, clients must not retry a request that was partially accepted.
+func otlpPartialSuccess(rejectedField string, rejected int64, msg string) ([]byte, error) {
+	return json.Marshal(map[string]any{
+		"partialSuccess": map[string]any{
+			// int64 fields are encoded as strings in OTLP/JSON
+			rejectedField:  strconv.FormatInt(rejected, 10),
+			"errorMessage": msg,
+		},
+	})
+}
+
+// ServeOTLPLogs handles OTLP/HTTP JSON log export requests for an OTLP integration key.
+//
+// Each log record is evaluated against the key's rules individually, with the record available as `otlp`.
+func (h *Handler) ServeOTLPLogs(w http.ResponseWriter, req *http.Request) {
+	h.serveOTLP(w, req, otlpLogs)
+}
+
+// ServeOTLPTraces handles OTLP/HTTP JSON trace export requests for an OTLP integration key.
+//
+// Each span event (e.g., an `exception` event) is evaluated against the key's rules individually, with the event available as `otlp`.
+func (h *Handler) ServeOTLPTraces(w http.ResponseWriter, req *http.Request) {
+	h.serveOTLP(w, req, otlpTraces)
+}
+
+// serveOTLP processes each record in order. If a record fails after earlier records have already
+// been processed (e.g., alerts were created), the remaining records are reported as rejected via
+// a partial success response rather than an error, so the client does not retry the whole batch
+// and create duplicates.
+func (h *Handler) serveOTLP(w http.ResponseWriter, req *http.Request, sig otlpSignal) {
+	ctx := req.Context()
+	keyID, err := h.keyID(ctx)
+	if errutil.HTTPError(ctx, w, err) {
+		return
+	}
+
+	mt, _, _ := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	if mt != "application/json" {
+		http.Error(w, "only OTLP/HTTP JSON encoding is supported", http.StatusUnsupportedMediaType)
+		return
+	}
+
+	data, err := io.ReadAll(req.Body)
+	if errutil.HTTPError(ctx, w, err) {
+		return
+	}
+	records, err := sig.parse(data)
+	if errutil.HTTPError(ctx, w, err) {
+		return
+	}
+
+	compiled, err := h.compiledConfig(ctx, keyID)
+	if errutil.HTTPError(ctx, w, err) {
+		return
+	}
+
+	var vm vm.VM
+	// OTLP clients expect a protocol-defined response, so the rule response is not used
+	resp := newResponse()
+	for i, rec := range records {
+		env := reqEnv(req, nil)
+		env["otlp"] = rec
+		_, err = h.runEnv(ctx, compiled, &vm, env, resp)
+		if err == nil {
+			continue
+		}
+		if i == 0 {
+			// nothing was processed, so the request can safely be retried
+			errutil.HTTPError(ctx, w, err)
+			return
+		}
+
+		log.Log(ctx, fmt.Errorf("process otlp record %d of %d: %w", i+1, len(records), err))
+		msg := "internal server error"
+		if validation.IsClientError(err) {
+			msg = err.Error()
+		}
+		body, err := otlpPartialSuccess(sig.rejectedField, sig.rejected(records[i:]), msg)
+		if errutil.HTTPError(ctx, w, err) {
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(body)
+		return
+	}
+
+	// empty Export*ServiceResponse, indicating full success
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = io.WriteString(w, "{}")
+}
@@ -0,0 +1,152 @@
+package uik
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/expr-lang/expr/vm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/gadb"
+)
+
+func TestOTLPLogRecords(t *testing.T) {
+	const data = `{
+		"resourceLogs": [{
+			"resource": {"attributes": [{"key": "service.name", "value": {"stringValue": "checkout"}}]},
+			"scopeLogs": [{
+				"scope": {"name": "app", "version": "1.2.3"},
+				"logRecords": [{
+					"timeUnixNano": "1544712660300000000",
+					"severityNumber": 17,
+					"severityText": "ERROR",
+					"body": {"stringValue": "payment failed"},
+					"attributes": [
+						{"key": "retries", "value": {"intValue": "3"}},
+						{"key": "fatal", "value": {"boolValue": true}},
+						{"key": "tags", "value": {"arrayValue": {"values": [{"stringValue": "a"}, {"doubleValue": 1.5}]}}}
+					],
+					"traceId": "5b8efff798038103d269b633813fc60c",
+					"spanId": "eee19b7ec3c1b174"
+				}, {
+					"observedTimeUnixNano": 1544712660300000000,
+					"severityText": "INFO",
+					"body": {"kvlistValue": {"values": [{"key": "msg", "value": {"stringValue": "ok"}}]}}
+				}]
+			}]
+		}]
+	}`
+
+	recs, err := otlpLogRecords([]byte(data))
+	require.NoError(t, err)
+	require.Len(t, recs, 2)
+
+	r := recs[0]
+	assert.Equal(t, OTLPKindLog, r["kind"])
+	assert.Equal(t, map[string]any{"service.name": "checkout"}, r["resource"])
+	assert.Equal(t, "app", r["scope"].(map[string]any)["name"])
+	assert.Equal(t, "payment failed", r["body"])
+	assert.Equal(t, "ERROR", r["severity"])
+	assert.Equal(t, 17, r["severity_number"])
+	assert.Equal(t, "2018-12-13T14:51:00.3Z", r["time"])
+	assert.Equal(t, "5b8efff798038103d269b633813fc60c", r["trace_id"])
+	assert.Equal(t, map[string]any{
+		"retries": int64(3),
+		"fatal":   true,
+		"tags":    []any{"a", 1.5},
+	}, r["attributes"])
+
+	// falls back to observed time, numeric timestamps are accepted
+	assert.Equal(t, "2018-12-13T14:51:00.3Z", recs[1]["time"])
+	assert.Equal(t, map[string]any{"msg": "ok"}, recs[1]["body"])
+}
+
+func TestOTLPSpanEvents(t *testing.T) {
+	const data = `{
+		"resourceSpans": [{
+			"resource": {"attributes": [{"key": "service.name", "value": {"stringValue": "checkout"}}]},
+			"scopeSpans": [{
+				"spans": [{
+					"traceId": "5b8efff798038103d269b633813fc60c",
+					"spanId": "eee19b7ec3c1b174",
+					"name": "POST /pay",
+					"status": {"code": 2, "message": "boom"},
+					"events": [{
+						"timeUnixNano": "1544712660300000000",
+						"name": "exception",
+						"attributes": [{"key": "exception.message", "value": {"stringValue": "boom"}}]
+					}]
+				}, {
+					"name": "no events"
+				}]
+			}]
+		}]
+	}`
+
+	recs, err := otlpSpanEvents([]byte(data))
+	require.NoError(t, err)
+	require.Len(t, recs, 1)
+
+	r := recs[0]
+	assert.Equal(t, OTLPKindSpanEvent, r["kind"])
+	assert.Equal(t, "exception", r["name"])
+	assert.Equal(t, map[string]any{"exception.message": "boom"}, r["attributes"])
+	assert.Equal(t, "POST /pay", r["span"].(map[string]any)["name"])
+	assert.Equal(t, 2, r["span"].(map[string]any)["status_code"])
+}
+
+func TestOTLPRules(t *testing.T) {
+	const data = `{"resourceLogs": [{"scopeLogs": [{"logRecords": [
+		{"severityNumber": 9, "body": {"stringValue": "fine"}},
+		{"severityNumber": 17, "body": {"stringValue": "payment failed"}}
+	]}]}]}`
+
+	cfg, err := NewCompiledConfig(gadb.UIKConfigV1{
+		Rules: []gadb.UIKRuleV1{{
+			Name:          "errors",
+			ConditionExpr: "otlp.severity_number >= 17",
+			Actions:       []gadb.UIKActionV1{{Params: map[string]string{"summary": "otlp.body"}}},
+		}},
+	})
+	require.NoError(t, err)
+
+	recs, err := otlpLogRecords([]byte(data))
+	require.NoError(t, err)
+
+	var vm vm.VM
+	var summaries []string
+	for _, rec := range recs {
+		actions, err := cfg.Run(&vm, map[string]any{"otlp": rec})
+		require.NoError(t, err)
+		for _, a := range actions {
+			summaries = append(summaries, a.Param("summary"))
+		}
+	}
+	assert.Equal(t, []string{"payment failed"}, summaries)
+}
+
+func TestOTLPLogRecords_Invalid(t *testing.T) {
+	_, err := otlpLogRecords([]byte(`{"resourceLogs": "bad"}`))
+	require.Error(t, err)
+
+	recs := strings.Repeat(`{"body": {"stringValue": "x"}},`, MaxOTLPRecords)
+	_, err = otlpLogRecords([]byte(`{"resourceLogs": [{"scopeLogs": [{"logRecords": [` + recs + `{}]}]}]}`))
+	require.Error(t, err, "too many records")
+}
+
+func TestOTLPPartialSuccess(t *testing.T) {
+	recs, err := otlpSpanEvents([]byte(`{"resourceSpans": [{"scopeSpans": [{"spans": [
+		{"traceId": "t1", "spanId": "s1", "events": [{"name": "a"}, {"name": "b"}]},
+		{"traceId": "t1", "spanId": "s2", "events": [{"name": "c"}]}
+	]}]}]}`))
+	require.NoError(t, err)
+	require.Len(t, recs, 3)
+
+	assert.EqualValues(t, 2, otlpTraces.rejected(recs))
+	assert.EqualValues(t, 1, otlpTraces.rejected(recs[1:2]))
+	assert.EqualValues(t, 3, otlpLogs.rejected(recs))
+
+	body, err := otlpPartialSuccess(otlpTraces.rejectedField, 2, "bad record")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"partialSuccess": {"rejectedSpans": "2", "errorMessage": "bad record"}}`, string(body))
+}
@@ -0,0 +1,50 @@
+package uik
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/target/goalert/integrationkey"
+)
+
+// SampleInterval is the minimum time between recorded samples for a key, so that
+// high-volume keys don't perform an extra write for every request.
+const SampleInterval = time.Minute / integrationkey.MaxSamples
+
+// sampleLimiter tracks when each key last recorded a sample.
+type sampleLimiter struct {
+	mx   sync.Mutex
+	last map[uuid.UUID]time.Time
+
+	now func() time.Time
+}
+
+func newSampleLimiter() *sampleLimiter {
+	return &sampleLimiter{
+		last: make(map[uuid.UUID]time.Time),
+		now:  time.Now,
+	}
+}
+
+// Allow returns true if a sample should be recorded for the key.
+func (l *sampleLimiter) Allow(keyID uuid.UUID) bool {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	now := l.now()
+	if t, ok := l.last[keyID]; ok && now.Sub(t) < SampleInterval {
+		return false
+	}
+
+	if len(l.last) >= 1000 {
+		// drop expired entries so the map doesn't grow with every key ever seen
+		for id, t := range l.last {
+			if now.Sub(t) >= SampleInterval {
+				delete(l.last, id)
+			}
+		}
+	}
+	l.last[keyID] = now
+	return true
+}
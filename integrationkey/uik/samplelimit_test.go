@@ -0,0 +1,24 @@
+package uik
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSampleLimiter(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := newSampleLimiter()
+	l.now = func() time.Time { return now }
+
+	a, b := uuid.New(), uuid.New()
+	require.True(t, l.Allow(a), "first sample")
+	require.False(t, l.Allow(a), "within interval")
+	require.True(t, l.Allow(b), "other keys are independent")
+
+	now = now.Add(SampleInterval)
+	require.True(t, l.Allow(a), "after interval")
+	require.False(t, l.Allow(a), "within interval again")
+}
@@ -94,6 +94,34 @@ func ReEncryptAll(ctx context.Context, db *sql.DB, keys Keys) error {
 		}
 	}
 
+	err = gdb.Keyring_LockWebhookSecrets(ctx)
+	if err != nil {
+		return fmt.Errorf("lock webhook secrets: %w", err)
+	}
+
+	secrets, err := gdb.Keyring_GetWebhookSecrets(ctx)
+	if err != nil {
+		return fmt.Errorf("get webhook secrets: %w", err)
+	}
+
+	for _, sec := range secrets {
+		dec, label, err := keys.Decrypt(sec.Secret)
+		if err != nil {
+			return fmt.Errorf("decrypt webhook secret for '%s': %w", sec.WebhookUrl, err)
+		}
+		enc, err := keys.Encrypt(label, dec)
+		if err != nil {
+			return fmt.Errorf("encrypt webhook secret for '%s': %w", sec.WebhookUrl, err)
+		}
+		err = gdb.Keyring_UpdateWebhookSecret(ctx, gadb.Keyring_UpdateWebhookSecretParams{
+			WebhookUrl: sec.WebhookUrl,
+			Secret:     enc,
+		})
+		if err != nil {
+			return fmt.Errorf("update webhook secret for '%s': %w", sec.WebhookUrl, err)
+		}
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return fmt.Errorf("commit transaction: %w", err)
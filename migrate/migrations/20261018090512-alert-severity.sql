@@ -0,0 +1,17 @@
+-- +migrate Up
+CREATE TYPE enum_alert_severity AS ENUM (
+    'critical',
+    'high',
+    'low',
+    'info'
+);
+
+ALTER TABLE alerts
+    ADD COLUMN severity enum_alert_severity NOT NULL DEFAULT 'high';
+
+-- +migrate Down
+ALTER TABLE alerts
+    DROP COLUMN severity;
+
+DROP TYPE enum_alert_severity;
+
@@ -0,0 +1,16 @@
+-- +migrate Up
+ALTER TABLE user_notification_rules
+    ADD COLUMN filter_min_severity enum_alert_severity,
+    ADD COLUMN filter_service_id uuid REFERENCES services(id) ON DELETE CASCADE,
+    ADD COLUMN filter_label_key text,
+    ADD COLUMN filter_label_value text,
+    ADD CONSTRAINT user_notification_rules_filter_label_check CHECK ((filter_label_key IS NULL) = (filter_label_value IS NULL));
+
+-- +migrate Down
+ALTER TABLE user_notification_rules
+    DROP CONSTRAINT user_notification_rules_filter_label_check,
+    DROP COLUMN filter_min_severity,
+    DROP COLUMN filter_service_id,
+    DROP COLUMN filter_label_key,
+    DROP COLUMN filter_label_value;
+
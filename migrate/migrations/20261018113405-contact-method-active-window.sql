@@ -0,0 +1,7 @@
+-- +migrate Up
+ALTER TABLE user_contact_methods
+    ADD COLUMN active_window jsonb;
+
+-- +migrate Down
+ALTER TABLE user_contact_methods
+    DROP COLUMN active_window;
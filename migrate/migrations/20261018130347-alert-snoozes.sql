@@ -0,0 +1,11 @@
+-- +migrate Up
+CREATE TABLE alert_snoozes(
+    alert_id bigint PRIMARY KEY REFERENCES alerts(id) ON DELETE CASCADE,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    snoozed_until timestamptz NOT NULL
+);
+
+CREATE INDEX idx_alert_snoozes_until ON alert_snoozes(snoozed_until);
+
+-- +migrate Down
+DROP TABLE alert_snoozes;
@@ -0,0 +1,36 @@
+-- +migrate Up
+CREATE TABLE incidents(
+    id bigserial PRIMARY KEY,
+    title text NOT NULL,
+    description text NOT NULL DEFAULT '',
+    status enum_alert_status NOT NULL DEFAULT 'triggered',
+    created_at timestamptz NOT NULL DEFAULT now(),
+    closed_at timestamptz
+);
+
+CREATE INDEX idx_incidents_status ON incidents(status);
+
+CREATE TABLE incident_alerts(
+    alert_id bigint PRIMARY KEY REFERENCES alerts(id) ON DELETE CASCADE,
+    incident_id bigint NOT NULL REFERENCES incidents(id) ON DELETE CASCADE,
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_incident_alerts_incident_id ON incident_alerts(incident_id);
+
+CREATE TABLE incident_updates(
+    id bigserial PRIMARY KEY,
+    incident_id bigint NOT NULL REFERENCES incidents(id) ON DELETE CASCADE,
+    user_id uuid REFERENCES users(id) ON DELETE SET NULL,
+    message text NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_incident_updates_incident_id ON incident_updates(incident_id);
+
+-- +migrate Down
+DROP TABLE incident_updates;
+
+DROP TABLE incident_alerts;
+
+DROP TABLE incidents;
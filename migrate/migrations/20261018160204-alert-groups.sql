@@ -0,0 +1,13 @@
+-- +migrate Up
+CREATE TABLE alert_groups(
+    alert_id bigint PRIMARY KEY REFERENCES alerts(id) ON DELETE CASCADE,
+    integration_key_id uuid NOT NULL REFERENCES integration_keys(id) ON DELETE CASCADE,
+    group_key text NOT NULL,
+    count integer NOT NULL DEFAULT 1,
+    last_seen timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_alert_groups_key ON alert_groups(integration_key_id, group_key);
+
+-- +migrate Down
+DROP TABLE alert_groups;
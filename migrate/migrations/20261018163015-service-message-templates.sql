@@ -0,0 +1,12 @@
+-- +migrate Up
+CREATE TABLE service_message_templates(
+    service_id uuid NOT NULL REFERENCES services(id) ON DELETE CASCADE,
+    dest_type text NOT NULL,
+    summary_template text NOT NULL DEFAULT '',
+    details_template text NOT NULL DEFAULT '',
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (service_id, dest_type)
+);
+
+-- +migrate Down
+DROP TABLE service_message_templates;
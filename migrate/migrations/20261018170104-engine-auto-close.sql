@@ -0,0 +1,12 @@
+-- +migrate Up notransaction
+ALTER TYPE engine_processing_type
+    ADD VALUE IF NOT EXISTS 'auto_close';
+
+INSERT INTO engine_processing_versions(type_id, version)
+    VALUES ('auto_close', 1)
+ON CONFLICT
+    DO NOTHING;
+
+-- +migrate Down
+DELETE FROM engine_processing_versions
+WHERE type_id = 'auto_close';
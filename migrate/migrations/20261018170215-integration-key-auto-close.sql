@@ -0,0 +1,17 @@
+-- +migrate Up
+ALTER TABLE integration_keys
+    ADD COLUMN auto_close_minutes integer CHECK (auto_close_minutes > 0);
+
+CREATE TABLE alert_auto_close(
+    alert_id bigint PRIMARY KEY REFERENCES alerts(id) ON DELETE CASCADE,
+    integration_key_id uuid NOT NULL REFERENCES integration_keys(id) ON DELETE CASCADE,
+    last_seen timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_alert_auto_close_key ON alert_auto_close(integration_key_id);
+
+-- +migrate Down
+DROP TABLE alert_auto_close;
+
+ALTER TABLE integration_keys
+    DROP COLUMN auto_close_minutes;
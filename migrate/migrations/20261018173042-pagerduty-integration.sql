@@ -0,0 +1,8 @@
+-- +migrate Up notransaction
+ALTER TYPE enum_integration_keys_type
+    ADD VALUE IF NOT EXISTS 'pagerduty';
+
+ALTER TYPE enum_alert_source
+    ADD VALUE IF NOT EXISTS 'pagerduty';
+
+-- +migrate Down
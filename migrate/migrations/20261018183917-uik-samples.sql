@@ -0,0 +1,15 @@
+-- +migrate Up
+CREATE TABLE uik_samples(
+    id uuid PRIMARY KEY,
+    key_id uuid NOT NULL REFERENCES integration_keys(id) ON DELETE CASCADE,
+    received_at timestamptz NOT NULL DEFAULT now(),
+    body jsonb NOT NULL,
+    query text NOT NULL DEFAULT '',
+    user_agent text NOT NULL DEFAULT '',
+    remote_addr text NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_uik_samples_key ON uik_samples(key_id, received_at);
+
+-- +migrate Down
+DROP TABLE uik_samples;
@@ -0,0 +1,30 @@
+-- +migrate Up
+ALTER TABLE uik_config
+    ADD COLUMN revision bigint NOT NULL DEFAULT 1;
+
+-- +migrate StatementBegin
+CREATE OR REPLACE FUNCTION fn_notify_uik_config_change() RETURNS TRIGGER AS
+    $$
+    BEGIN
+        IF TG_OP = 'DELETE' THEN
+            PERFORM pg_notify('/goalert/uik-config-change', OLD.id::text || ':' || (OLD.revision + 1)::text);
+            RETURN OLD;
+        END IF;
+
+        PERFORM pg_notify('/goalert/uik-config-change', NEW.id::text || ':' || NEW.revision::text);
+        RETURN NEW;
+    END;
+    $$ LANGUAGE 'plpgsql';
+-- +migrate StatementEnd
+
+CREATE TRIGGER trg_uik_config_change
+    AFTER INSERT OR UPDATE OF config OR DELETE ON uik_config
+    FOR EACH ROW
+    EXECUTE PROCEDURE fn_notify_uik_config_change();
+
+-- +migrate Down
+DROP TRIGGER trg_uik_config_change ON uik_config;
+DROP FUNCTION fn_notify_uik_config_change();
+
+ALTER TABLE uik_config
+    DROP COLUMN revision;
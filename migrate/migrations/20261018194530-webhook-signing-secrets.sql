@@ -0,0 +1,9 @@
+-- +migrate Up
+CREATE TABLE webhook_signing_secrets(
+    webhook_url text PRIMARY KEY,
+    secret bytea NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+
+-- +migrate Down
+DROP TABLE webhook_signing_secrets;
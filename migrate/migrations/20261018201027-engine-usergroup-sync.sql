@@ -0,0 +1,12 @@
+-- +migrate Up notransaction
+ALTER TYPE engine_processing_type
+    ADD VALUE IF NOT EXISTS 'usergroup_sync';
+
+INSERT INTO engine_processing_versions(type_id, version)
+    VALUES ('usergroup_sync', 1)
+ON CONFLICT
+    DO NOTHING;
+
+-- +migrate Down
+DELETE FROM engine_processing_versions
+WHERE type_id = 'usergroup_sync';
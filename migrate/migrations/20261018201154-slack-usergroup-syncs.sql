@@ -0,0 +1,21 @@
+-- +migrate Up
+CREATE TABLE slack_usergroup_syncs(
+    id uuid PRIMARY KEY,
+    usergroup_id text NOT NULL UNIQUE,
+    rotation_id uuid REFERENCES rotations(id) ON DELETE CASCADE,
+    ep_step_id uuid REFERENCES escalation_policy_steps(id) ON DELETE CASCADE,
+    members text[] NOT NULL DEFAULT '{}',
+    last_sync_at timestamptz,
+    last_error text NOT NULL DEFAULT '',
+    drift_detected_at timestamptz,
+    drift_details text NOT NULL DEFAULT '',
+    created_at timestamptz NOT NULL DEFAULT now(),
+    CONSTRAINT slack_usergroup_syncs_one_source CHECK (num_nonnulls(rotation_id, ep_step_id) = 1)
+);
+
+CREATE INDEX idx_slack_usergroup_syncs_rotation ON slack_usergroup_syncs(rotation_id);
+
+CREATE INDEX idx_slack_usergroup_syncs_ep_step ON slack_usergroup_syncs(ep_step_id);
+
+-- +migrate Down
+DROP TABLE slack_usergroup_syncs;
@@ -0,0 +1,7 @@
+-- +migrate Up
+ALTER TABLE user_contact_methods
+    ADD COLUMN paused_until timestamptz;
+
+-- +migrate Down
+ALTER TABLE user_contact_methods
+    DROP COLUMN paused_until;
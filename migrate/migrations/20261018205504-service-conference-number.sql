@@ -0,0 +1,7 @@
+-- +migrate Up
+ALTER TABLE services
+    ADD COLUMN conference_number text;
+
+-- +migrate Down
+ALTER TABLE services
+    DROP COLUMN conference_number;
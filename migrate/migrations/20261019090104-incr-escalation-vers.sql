@@ -0,0 +1,15 @@
+-- +migrate Up
+UPDATE
+    engine_processing_versions
+SET
+    version = 5
+WHERE
+    type_id = 'escalation';
+
+-- +migrate Down
+UPDATE
+    engine_processing_versions
+SET
+    version = 4
+WHERE
+    type_id = 'escalation';
@@ -0,0 +1,15 @@
+-- +migrate Up
+UPDATE
+    engine_processing_versions
+SET
+    version = 13
+WHERE
+    type_id = 'message';
+
+-- +migrate Down
+UPDATE
+    engine_processing_versions
+SET
+    version = 12
+WHERE
+    type_id = 'message';
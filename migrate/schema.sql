@@ -1,7 +1,7 @@
 -- This file is auto-generated by "make db-schema"; DO NOT EDIT
--- DATA=48c69cc7d2bcafd088fd053a892530b1c79ccd856d4d69c173137a6d8dca58b6  -
--- DISK=794c6d630bfe16fca4a32cfa500455bd9d49f6811f59b8dfcee2f603e5fe0da0  -
--- PSQL=794c6d630bfe16fca4a32cfa500455bd9d49f6811f59b8dfcee2f603e5fe0da0  -
+-- DATA=ab94a7723d9da8727bf1827e362ce1739f5412fcb8df9c900c51785f3b15a690  -
+-- DISK=cee70068eb44f1bec269ad0c735f68b52fea565cf85ff8a0dcbe09c48afd9a5f  -
+-- PSQL=cee70068eb44f1bec269ad0c735f68b52fea565cf85ff8a0dcbe09c48afd9a5f  -
 --
 -- pgdump-lite database dump
 --
@@ -13,6 +13,7 @@ CREATE EXTENSION IF NOT EXISTS pgcrypto;
 -- Enums
 
 CREATE TYPE engine_processing_type AS ENUM (
+	'auto_close',
 	'cleanup',
 	'compat',
 	'escalation',
@@ -24,6 +25,7 @@ CREATE TYPE engine_processing_type AS ENUM (
 	'schedule',
 	'signals',
 	'status_update',
+	'usergroup_sync',
 	'verify'
 );
 
@@ -36,10 +38,13 @@ CREATE TYPE enum_alert_log_event AS ENUM (
 	'escalated',
 	'escalation_request',
 	'no_notification_sent',
+	'note',
 	'notification_sent',
 	'policy_updated',
 	'reopened',
 	'response_received',
+	'snooze_expired',
+	'snoozed',
 	'status_changed'
 );
 
@@ -50,11 +55,19 @@ CREATE TYPE enum_alert_log_subject_type AS ENUM (
 	'user'
 );
 
+CREATE TYPE enum_alert_severity AS ENUM (
+	'critical',
+	'high',
+	'info',
+	'low'
+);
+
 CREATE TYPE enum_alert_source AS ENUM (
 	'email',
 	'generic',
 	'grafana',
 	'manual',
+	'pagerduty',
 	'prometheusAlertmanager',
 	'site24x7',
 	'universal'
@@ -76,6 +89,8 @@ CREATE TYPE enum_integration_keys_type AS ENUM (
 	'email',
 	'generic',
 	'grafana',
+	'otlp',
+	'pagerduty',
 	'prometheusAlertmanager',
 	'site24x7',
 	'universal'
@@ -1247,6 +1262,22 @@ AS $function$
     $function$
 ;
 
+CREATE OR REPLACE FUNCTION public.fn_notify_uik_config_change()
+ RETURNS trigger
+ LANGUAGE plpgsql
+AS $function$
+    BEGIN
+        IF TG_OP = 'DELETE' THEN
+            PERFORM pg_notify('/goalert/uik-config-change', OLD.id::text || ':' || (OLD.revision + 1)::text);
+            RETURN OLD;
+        END IF;
+
+        PERFORM pg_notify('/goalert/uik-config-change', NEW.id::text || ':' || NEW.revision::text);
+        RETURN NEW;
+    END;
+    $function$
+;
+
 CREATE OR REPLACE FUNCTION public.fn_prevent_reopen()
  RETURNS trigger
  LANGUAGE plpgsql
@@ -1565,6 +1596,19 @@ AS $function$
 
 -- Tables
 
+CREATE TABLE alert_auto_close (
+	alert_id bigint NOT NULL,
+	integration_key_id uuid NOT NULL,
+	last_seen timestamp with time zone DEFAULT now() NOT NULL,
+	CONSTRAINT alert_auto_close_alert_id_fkey FOREIGN KEY (alert_id) REFERENCES alerts(id) ON DELETE CASCADE,
+	CONSTRAINT alert_auto_close_integration_key_id_fkey FOREIGN KEY (integration_key_id) REFERENCES integration_keys(id) ON DELETE CASCADE,
+	CONSTRAINT alert_auto_close_pkey PRIMARY KEY (alert_id)
+);
+
+CREATE UNIQUE INDEX alert_auto_close_pkey ON public.alert_auto_close USING btree (alert_id);
+CREATE INDEX idx_alert_auto_close_key ON public.alert_auto_close USING btree (integration_key_id);
+
+
 CREATE TABLE alert_data (
 	alert_id bigint NOT NULL,
 	id bigint DEFAULT nextval('alert_data_id_seq'::regclass) NOT NULL,
@@ -1591,6 +1635,21 @@ CREATE UNIQUE INDEX alert_feedback_id_key ON public.alert_feedback USING btree (
 CREATE UNIQUE INDEX alert_feedback_pkey ON public.alert_feedback USING btree (alert_id);
 
 
+CREATE TABLE alert_groups (
+	alert_id bigint NOT NULL,
+	count integer DEFAULT 1 NOT NULL,
+	group_key text NOT NULL,
+	integration_key_id uuid NOT NULL,
+	last_seen timestamp with time zone DEFAULT now() NOT NULL,
+	CONSTRAINT alert_groups_alert_id_fkey FOREIGN KEY (alert_id) REFERENCES alerts(id) ON DELETE CASCADE,
+	CONSTRAINT alert_groups_integration_key_id_fkey FOREIGN KEY (integration_key_id) REFERENCES integration_keys(id) ON DELETE CASCADE,
+	CONSTRAINT alert_groups_pkey PRIMARY KEY (alert_id)
+);
+
+CREATE UNIQUE INDEX alert_groups_pkey ON public.alert_groups USING btree (alert_id);
+CREATE INDEX idx_alert_groups_key ON public.alert_groups USING btree (integration_key_id, group_key);
+
+
 CREATE TABLE alert_logs (
 	alert_id bigint,
 	event enum_alert_log_event NOT NULL,
@@ -1635,6 +1694,18 @@ CREATE UNIQUE INDEX alert_metrics_id_key ON public.alert_metrics USING btree (id
 CREATE UNIQUE INDEX alert_metrics_pkey ON public.alert_metrics USING btree (alert_id);
 
 
+CREATE TABLE alert_snoozes (
+	alert_id bigint NOT NULL,
+	created_at timestamp with time zone DEFAULT now() NOT NULL,
+	snoozed_until timestamp with time zone NOT NULL,
+	CONSTRAINT alert_snoozes_alert_id_fkey FOREIGN KEY (alert_id) REFERENCES alerts(id) ON DELETE CASCADE,
+	CONSTRAINT alert_snoozes_pkey PRIMARY KEY (alert_id)
+);
+
+CREATE UNIQUE INDEX alert_snoozes_pkey ON public.alert_snoozes USING btree (alert_id);
+CREATE INDEX idx_alert_snoozes_until ON public.alert_snoozes USING btree (snoozed_until);
+
+
 CREATE TABLE alert_status_subscriptions (
 	alert_id bigint NOT NULL,
 	channel_id uuid,
@@ -1664,6 +1735,7 @@ CREATE TABLE alerts (
 	last_escalation timestamp with time zone DEFAULT now(),
 	last_processed timestamp with time zone,
 	service_id uuid,
+	severity enum_alert_severity DEFAULT 'high'::enum_alert_severity NOT NULL,
 	source enum_alert_source DEFAULT 'manual'::enum_alert_source NOT NULL,
 	status enum_alert_status DEFAULT 'triggered'::enum_alert_status NOT NULL,
 	summary text NOT NULL,
@@ -1999,12 +2071,56 @@ CREATE INDEX idx_heartbeat_monitor_service ON public.heartbeat_monitors USING bt
 CREATE CONSTRAINT TRIGGER trg_enforce_heartbeat_monitor_limit AFTER INSERT ON public.heartbeat_monitors NOT DEFERRABLE INITIALLY IMMEDIATE FOR EACH ROW EXECUTE FUNCTION fn_enforce_heartbeat_limit();
 
 
+CREATE TABLE incident_alerts (
+	alert_id bigint NOT NULL,
+	created_at timestamp with time zone DEFAULT now() NOT NULL,
+	incident_id bigint NOT NULL,
+	CONSTRAINT incident_alerts_alert_id_fkey FOREIGN KEY (alert_id) REFERENCES alerts(id) ON DELETE CASCADE,
+	CONSTRAINT incident_alerts_incident_id_fkey FOREIGN KEY (incident_id) REFERENCES incidents(id) ON DELETE CASCADE,
+	CONSTRAINT incident_alerts_pkey PRIMARY KEY (alert_id)
+);
+
+CREATE INDEX idx_incident_alerts_incident_id ON public.incident_alerts USING btree (incident_id);
+CREATE UNIQUE INDEX incident_alerts_pkey ON public.incident_alerts USING btree (alert_id);
+
+
+CREATE TABLE incident_updates (
+	created_at timestamp with time zone DEFAULT now() NOT NULL,
+	id bigint DEFAULT nextval('incident_updates_id_seq'::regclass) NOT NULL,
+	incident_id bigint NOT NULL,
+	message text NOT NULL,
+	user_id uuid,
+	CONSTRAINT incident_updates_incident_id_fkey FOREIGN KEY (incident_id) REFERENCES incidents(id) ON DELETE CASCADE,
+	CONSTRAINT incident_updates_pkey PRIMARY KEY (id),
+	CONSTRAINT incident_updates_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_incident_updates_incident_id ON public.incident_updates USING btree (incident_id);
+CREATE UNIQUE INDEX incident_updates_pkey ON public.incident_updates USING btree (id);
+
+
+CREATE TABLE incidents (
+	closed_at timestamp with time zone,
+	created_at timestamp with time zone DEFAULT now() NOT NULL,
+	description text DEFAULT ''::text NOT NULL,
+	id bigint DEFAULT nextval('incidents_id_seq'::regclass) NOT NULL,
+	status enum_alert_status DEFAULT 'triggered'::enum_alert_status NOT NULL,
+	title text NOT NULL,
+	CONSTRAINT incidents_pkey PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_incidents_status ON public.incidents USING btree (status);
+CREATE UNIQUE INDEX incidents_pkey ON public.incidents USING btree (id);
+
+
 CREATE TABLE integration_keys (
+	auto_close_minutes integer,
 	external_system_name text,
 	id uuid DEFAULT gen_random_uuid() NOT NULL,
 	name text NOT NULL,
 	service_id uuid NOT NULL,
 	type enum_integration_keys_type NOT NULL,
+	CONSTRAINT integration_keys_auto_close_minutes_check CHECK ((auto_close_minutes > 0)),
 	CONSTRAINT integration_keys_pkey PRIMARY KEY (id),
 	CONSTRAINT integration_keys_services_id_fkey FOREIGN KEY (service_id) REFERENCES services(id) ON DELETE CASCADE
 );
@@ -2467,7 +2583,21 @@ CREATE UNIQUE INDEX schedules_name_key ON public.schedules USING btree (name);
 CREATE UNIQUE INDEX schedules_pkey ON public.schedules USING btree (id);
 
 
+CREATE TABLE service_message_templates (
+	dest_type text NOT NULL,
+	details_template text DEFAULT ''::text NOT NULL,
+	service_id uuid NOT NULL,
+	summary_template text DEFAULT ''::text NOT NULL,
+	updated_at timestamp with time zone DEFAULT now() NOT NULL,
+	CONSTRAINT service_message_templates_pkey PRIMARY KEY (service_id, dest_type),
+	CONSTRAINT service_message_templates_service_id_fkey FOREIGN KEY (service_id) REFERENCES services(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX service_message_templates_pkey ON public.service_message_templates USING btree (service_id, dest_type);
+
+
 CREATE TABLE services (
+	conference_number text,
 	description text DEFAULT ''::text NOT NULL,
 	escalation_policy_id uuid NOT NULL,
 	id uuid DEFAULT gen_random_uuid() NOT NULL,
@@ -2489,6 +2619,30 @@ CREATE UNIQUE INDEX svc_ep_uniq ON public.services USING btree (id, escalation_p
 CREATE TRIGGER trg_10_clear_ep_state_on_svc_ep_change AFTER UPDATE ON public.services FOR EACH ROW WHEN ((old.escalation_policy_id <> new.escalation_policy_id)) EXECUTE FUNCTION fn_clear_ep_state_on_svc_ep_change();
 
 
+CREATE TABLE slack_usergroup_syncs (
+	created_at timestamp with time zone DEFAULT now() NOT NULL,
+	drift_detected_at timestamp with time zone,
+	drift_details text DEFAULT ''::text NOT NULL,
+	ep_step_id uuid,
+	id uuid NOT NULL,
+	last_error text DEFAULT ''::text NOT NULL,
+	last_sync_at timestamp with time zone,
+	members text[] DEFAULT '{}'::text[] NOT NULL,
+	rotation_id uuid,
+	usergroup_id text NOT NULL,
+	CONSTRAINT slack_usergroup_syncs_ep_step_id_fkey FOREIGN KEY (ep_step_id) REFERENCES escalation_policy_steps(id) ON DELETE CASCADE,
+	CONSTRAINT slack_usergroup_syncs_one_source CHECK (num_nonnulls(rotation_id, ep_step_id) = 1),
+	CONSTRAINT slack_usergroup_syncs_pkey PRIMARY KEY (id),
+	CONSTRAINT slack_usergroup_syncs_rotation_id_fkey FOREIGN KEY (rotation_id) REFERENCES rotations(id) ON DELETE CASCADE,
+	CONSTRAINT slack_usergroup_syncs_usergroup_id_key UNIQUE (usergroup_id)
+);
+
+CREATE INDEX idx_slack_usergroup_syncs_ep_step ON public.slack_usergroup_syncs USING btree (ep_step_id);
+CREATE INDEX idx_slack_usergroup_syncs_rotation ON public.slack_usergroup_syncs USING btree (rotation_id);
+CREATE UNIQUE INDEX slack_usergroup_syncs_pkey ON public.slack_usergroup_syncs USING btree (id);
+CREATE UNIQUE INDEX slack_usergroup_syncs_usergroup_id_key ON public.slack_usergroup_syncs USING btree (usergroup_id);
+
+
 CREATE TABLE switchover_log (
 	data jsonb NOT NULL,
 	id bigint NOT NULL,
@@ -2560,6 +2714,7 @@ CREATE TABLE uik_config (
 	id uuid NOT NULL,
 	primary_token uuid,
 	primary_token_hint text,
+	revision bigint DEFAULT 1 NOT NULL,
 	secondary_token uuid,
 	secondary_token_hint text,
 	CONSTRAINT uik_config_id_fkey FOREIGN KEY (id) REFERENCES integration_keys(id) ON DELETE CASCADE,
@@ -2572,6 +2727,24 @@ CREATE UNIQUE INDEX uik_config_pkey ON public.uik_config USING btree (id);
 CREATE UNIQUE INDEX uik_config_primary_token_key ON public.uik_config USING btree (primary_token);
 CREATE UNIQUE INDEX uik_config_secondary_token_key ON public.uik_config USING btree (secondary_token);
 
+CREATE TRIGGER trg_uik_config_change AFTER INSERT OR DELETE OR UPDATE OF config ON public.uik_config FOR EACH ROW EXECUTE FUNCTION fn_notify_uik_config_change();
+
+
+CREATE TABLE uik_samples (
+	body jsonb NOT NULL,
+	id uuid NOT NULL,
+	key_id uuid NOT NULL,
+	query text DEFAULT ''::text NOT NULL,
+	received_at timestamp with time zone DEFAULT now() NOT NULL,
+	remote_addr text DEFAULT ''::text NOT NULL,
+	user_agent text DEFAULT ''::text NOT NULL,
+	CONSTRAINT uik_samples_key_id_fkey FOREIGN KEY (key_id) REFERENCES integration_keys(id) ON DELETE CASCADE,
+	CONSTRAINT uik_samples_pkey PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_uik_samples_key ON public.uik_samples USING btree (key_id, received_at);
+CREATE UNIQUE INDEX uik_samples_pkey ON public.uik_samples USING btree (id);
+
 
 CREATE TABLE user_calendar_subscriptions (
 	config jsonb NOT NULL,
@@ -2596,6 +2769,7 @@ CREATE CONSTRAINT TRIGGER trg_enforce_calendar_subscriptions_per_user_limit AFTE
 
 
 CREATE TABLE user_contact_methods (
+	active_window jsonb,
 	dest jsonb NOT NULL,
 	disabled boolean DEFAULT false NOT NULL,
 	enable_status_updates boolean DEFAULT false NOT NULL,
@@ -2603,6 +2777,7 @@ CREATE TABLE user_contact_methods (
 	last_test_verify_at timestamp with time zone,
 	metadata jsonb,
 	name text NOT NULL,
+	paused_until timestamp with time zone,
 	pending boolean DEFAULT true NOT NULL,
 	type enum_user_contact_method_type NOT NULL,
 	user_id uuid NOT NULL,
@@ -2661,10 +2836,16 @@ CREATE TABLE user_notification_rules (
 	contact_method_id uuid NOT NULL,
 	created_at timestamp with time zone DEFAULT now(),
 	delay_minutes integer DEFAULT 0 NOT NULL,
+	filter_label_key text,
+	filter_label_value text,
+	filter_min_severity enum_alert_severity,
+	filter_service_id uuid,
 	id uuid DEFAULT gen_random_uuid() NOT NULL,
 	user_id uuid NOT NULL,
 	CONSTRAINT user_notification_rules_contact_method_id_delay_minutes_key UNIQUE (contact_method_id, delay_minutes),
 	CONSTRAINT user_notification_rules_contact_method_id_fkey FOREIGN KEY (contact_method_id) REFERENCES user_contact_methods(id) ON DELETE CASCADE,
+	CONSTRAINT user_notification_rules_filter_label_check CHECK ((filter_label_key IS NULL) = (filter_label_value IS NULL)),
+	CONSTRAINT user_notification_rules_filter_service_id_fkey FOREIGN KEY (filter_service_id) REFERENCES services(id) ON DELETE CASCADE,
 	CONSTRAINT user_notification_rules_pkey PRIMARY KEY (id),
 	CONSTRAINT user_notification_rules_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
 );
@@ -2745,6 +2926,16 @@ CREATE INDEX idx_user_status_updates ON public.users USING btree (alert_status_l
 CREATE TRIGGER trg_enforce_status_update_same_user BEFORE INSERT OR UPDATE ON public.users FOR EACH ROW EXECUTE FUNCTION fn_enforce_status_update_same_user();
 
 
+CREATE TABLE webhook_signing_secrets (
+	created_at timestamp with time zone DEFAULT now() NOT NULL,
+	secret bytea NOT NULL,
+	webhook_url text NOT NULL,
+	CONSTRAINT webhook_signing_secrets_pkey PRIMARY KEY (webhook_url)
+);
+
+CREATE UNIQUE INDEX webhook_signing_secrets_pkey ON public.webhook_signing_secrets USING btree (webhook_url);
+
+
 -- Sequences
 
 CREATE SEQUENCE incident_number_seq
@@ -14,3 +14,46 @@ FROM
 WHERE
     service_id = $1::uuid
     AND status = 'triggered';
+
+-- name: NoticeUGSyncsByRotation :many
+-- Returns Slack user group syncs for a rotation that have an error or recently detected drift.
+SELECT
+    usergroup_id,
+    last_error,
+    (
+        CASE WHEN drift_detected_at > now() - '1 day'::interval THEN
+            drift_details
+        ELSE
+            ''
+        END)::text AS drift_details
+FROM
+    slack_usergroup_syncs
+WHERE
+    rotation_id = @rotation_id
+    AND (last_error != ''
+        OR drift_detected_at > now() - '1 day'::interval)
+ORDER BY
+    usergroup_id;
+
+-- name: NoticeUGSyncsByPolicy :many
+-- Returns Slack user group syncs for the steps of an escalation policy that have an error or recently detected drift.
+SELECT
+    stp.step_number,
+    s.usergroup_id,
+    s.last_error,
+    (
+        CASE WHEN s.drift_detected_at > now() - '1 day'::interval THEN
+            s.drift_details
+        ELSE
+            ''
+        END)::text AS drift_details
+FROM
+    slack_usergroup_syncs s
+    JOIN escalation_policy_steps stp ON stp.id = s.ep_step_id
+WHERE
+    stp.escalation_policy_id = @escalation_policy_id
+    AND (s.last_error != ''
+        OR s.drift_detected_at > now() - '1 day'::interval)
+ORDER BY
+    stp.step_number,
+    s.usergroup_id;
@@ -80,9 +80,63 @@ func (s *Store) FindAllPolicyNotices(ctx context.Context, policyID string) ([]No
 		})
 	}
 
+	ugRows, err := gadb.New(s.db).NoticeUGSyncsByPolicy(ctx, uuid.MustParse(policyID))
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range ugRows {
+		notices = append(notices, userGroupSyncNotices(fmt.Sprintf("Step #%d: ", r.StepNumber+1), r.UsergroupID, r.LastError, r.DriftDetails)...)
+	}
+
+	return notices, nil
+}
+
+// FindAllRotationNotices returns any relevant notices for the given rotation. Currently returns
+// notices pertaining to Slack user group syncs.
+func (s *Store) FindAllRotationNotices(ctx context.Context, rotationID string) ([]Notice, error) {
+	err := permission.LimitCheckAny(ctx, permission.User)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := validate.ParseUUID("RotationID", rotationID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := gadb.New(s.db).NoticeUGSyncsByRotation(ctx, uuid.NullUUID{UUID: id, Valid: true})
+	if err != nil {
+		return nil, err
+	}
+
+	var notices []Notice
+	for _, r := range rows {
+		notices = append(notices, userGroupSyncNotices("", r.UsergroupID, r.LastError, r.DriftDetails)...)
+	}
+
 	return notices, nil
 }
 
+// userGroupSyncNotices returns notices for a Slack user group sync that has an error or recently detected drift.
+func userGroupSyncNotices(prefix, userGroupID, lastError, driftDetails string) []Notice {
+	var notices []Notice
+	if lastError != "" {
+		notices = append(notices, Notice{
+			Type:    TypeError,
+			Message: prefix + "Unable to sync Slack user group",
+			Details: fmt.Sprintf("User group %s: %s", userGroupID, lastError),
+		})
+	}
+	if driftDetails != "" {
+		notices = append(notices, Notice{
+			Message: prefix + "Slack user group was modified outside of GoAlert",
+			Details: fmt.Sprintf("User group %s: %s Membership is managed by GoAlert and will be reset automatically.", userGroupID, driftDetails),
+		})
+	}
+
+	return notices
+}
+
 // FindAllServiceNotices returns any relevant notices for the given service. Currently returns
 // notices pertaining to the system limit for unacknowledged alerts.
 func (s *Store) FindAllServiceNotices(ctx context.Context, serviceID string) ([]Notice, error) {
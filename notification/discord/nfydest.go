@@ -0,0 +1,91 @@
+package discord
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/target/goalert/config"
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/notification/nfydest"
+	"github.com/target/goalert/validation"
+	"github.com/target/goalert/validation/validate"
+)
+
+const (
+	DestTypeDiscordChannel = "builtin-discord-channel"
+	FieldWebhookURL        = "discord_webhook_url"
+	ParamMessage           = "message"
+	FallbackIconURL        = "builtin://discord"
+)
+
+func NewChannelDest(webhookURL string) gadb.DestV1 {
+	return gadb.NewDestV1(DestTypeDiscordChannel, FieldWebhookURL, webhookURL)
+}
+
+var _ nfydest.Provider = (*Sender)(nil)
+
+func (s *Sender) ID() string { return DestTypeDiscordChannel }
+func (s *Sender) TypeInfo(ctx context.Context) (*nfydest.TypeInfo, error) {
+	cfg := config.FromContext(ctx)
+	return &nfydest.TypeInfo{
+		Type:                       DestTypeDiscordChannel,
+		Name:                       "Discord Channel",
+		Enabled:                    cfg.Discord.Enable,
+		SupportsAlertNotifications: true,
+		SupportsStatusUpdates:      true,
+		StatusUpdatesRequired:      true,
+		SupportsOnCallNotify:       true,
+		SupportsSignals:            true,
+		RequiredFields: []nfydest.FieldConfig{{
+			FieldID:         FieldWebhookURL,
+			Label:           "Webhook URL",
+			PlaceholderText: "https://discord.com/api/webhooks/...",
+			InputType:       "url",
+			Hint:            "Create a webhook from the channel's Integrations settings in Discord.",
+		}},
+		DynamicParams: []nfydest.DynamicParamConfig{{
+			ParamID: ParamMessage,
+			Label:   "Message",
+			Hint:    "The text of the message to send.",
+		}},
+	}, nil
+}
+
+func (s *Sender) ValidateField(ctx context.Context, fieldID, value string) error {
+	switch fieldID {
+	case FieldWebhookURL:
+		err := validate.AbsoluteURL(FieldWebhookURL, value)
+		if err != nil {
+			return err
+		}
+		u, _ := url.Parse(value) // already validated
+		if u.Scheme != "https" || !strings.Contains(u.Path, "/api/webhooks/") {
+			return validation.NewGenericError("must be a Discord webhook URL")
+		}
+		if !config.FromContext(ctx).ValidWebhookURL(value) {
+			return validation.NewGenericError("url is not allowed by administrator")
+		}
+
+		return nil
+	}
+
+	return validation.NewGenericError("unknown field ID")
+}
+
+func (s *Sender) DisplayInfo(ctx context.Context, args map[string]string) (*nfydest.DisplayInfo, error) {
+	if args == nil {
+		args = make(map[string]string)
+	}
+
+	u, err := url.Parse(args[FieldWebhookURL])
+	if err != nil {
+		return nil, validation.WrapError(err)
+	}
+
+	return &nfydest.DisplayInfo{
+		IconURL:     FallbackIconURL,
+		IconAltText: "Discord",
+		Text:        u.Hostname(),
+	}, nil
+}
@@ -0,0 +1,177 @@
+// Package discord sends notifications to Discord channels via webhooks.
+//
+// Alert notifications are sent as embeds, and status updates edit the original message
+// in place, similar to Slack.
+package discord
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/target/goalert/config"
+	"github.com/target/goalert/notification"
+	"github.com/target/goalert/notification/nfychat"
+	"github.com/target/goalert/notification/nfydest"
+	"github.com/target/goalert/util/log"
+)
+
+// maxDescriptionLength is the maximum length of alert details included in an embed; Discord allows up to 4096 characters.
+const maxDescriptionLength = 2000
+
+const (
+	colorClosed  = 0x218626
+	colorUnacked = 0x862421
+	colorAcked   = 0x867321
+)
+
+// Sender sends notifications to Discord channels.
+type Sender struct {
+	client *http.Client
+}
+
+var _ nfydest.MessageSender = (*Sender)(nil)
+
+// NewSender creates a new Sender.
+func NewSender(ctx context.Context, client *http.Client) *Sender {
+	return &Sender{client: client}
+}
+
+type embedField struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Inline bool   `json:"inline"`
+}
+
+type embed struct {
+	Title       string       `json:"title,omitempty"`
+	URL         string       `json:"url,omitempty"`
+	Description string       `json:"description,omitempty"`
+	Color       int          `json:"color,omitempty"`
+	Fields      []embedField `json:"fields,omitempty"`
+	Footer      *embedFooter `json:"footer,omitempty"`
+}
+
+type embedFooter struct {
+	Text string `json:"text"`
+}
+
+type payload struct {
+	Content string  `json:"content,omitempty"`
+	Embeds  []embed `json:"embeds,omitempty"`
+
+	// AllowedMentions is always set to disable pings from alert text (e.g., `@everyone`).
+	AllowedMentions struct {
+		Parse []string `json:"parse"`
+	} `json:"allowed_mentions"`
+}
+
+// escape prevents text from being interpreted as markdown.
+var escape = nfychat.Escaper("\\*_~`|[]()<>#")
+
+func link(text, url string) string {
+	// angle brackets prevent link previews
+	return fmt.Sprintf("[%s](<%s>)", escape(text), url)
+}
+
+func alertEmbed(ctx context.Context, id int, summary, details, serviceName, logEntry string, state notification.AlertState) embed {
+	cfg := config.FromContext(ctx)
+
+	e := embed{
+		// titles are not rendered as markdown
+		Title:       nfychat.Truncate(fmt.Sprintf("Alert #%d: %s", id, summary), 250),
+		URL:         cfg.CallbackURL(fmt.Sprintf("/alerts/%d", id)),
+		Description: nfychat.Truncate(details, maxDescriptionLength),
+	}
+	switch state {
+	case notification.AlertStateUnacknowledged:
+		e.Color = colorUnacked
+	case notification.AlertStateAcknowledged:
+		e.Color = colorAcked
+	case notification.AlertStateClosed:
+		e.Color = colorClosed
+	}
+	if serviceName != "" {
+		e.Fields = append(e.Fields, embedField{Name: "Service", Value: nfychat.Truncate(serviceName, 1000), Inline: true})
+	}
+	if logEntry != "" {
+		e.Footer = &embedFooter{Text: nfychat.Truncate(logEntry, 2000)}
+	}
+
+	return e
+}
+
+// SendMessage will post the message to the destination channel, or edit the original message for status updates.
+func (s *Sender) SendMessage(ctx context.Context, msg notification.Message) (*notification.SentMessage, error) {
+	cfg := config.FromContext(ctx)
+	webURL := msg.DestArg(FieldWebhookURL)
+	u, err := url.Parse(webURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var p payload
+	p.AllowedMentions.Parse = []string{}
+	method := "POST"
+	switch t := msg.(type) {
+	case notification.Alert:
+		p.Embeds = []embed{alertEmbed(ctx, t.AlertID, t.Summary, t.Details, t.ServiceName, "Unacknowledged", notification.AlertStateUnacknowledged)}
+	case notification.AlertStatus:
+		p.Embeds = []embed{alertEmbed(ctx, t.AlertID, t.Summary, t.Details, t.ServiceName, t.LogEntry, t.NewAlertState)}
+		if msgID := t.OriginalStatus.ProviderMessageID.ExternalID; msgID != "" {
+			method = "PATCH"
+			u = u.JoinPath("messages", msgID)
+		}
+	case notification.AlertBundle:
+		p.Content = fmt.Sprintf("Service '%s' has %d unacknowledged alerts.\n\n<%s>", escape(t.ServiceName), t.Count, cfg.CallbackURL("/services/"+t.ServiceID+"/alerts"))
+	case notification.ScheduleOnCallUsers:
+		p.Content = nfychat.OnCallText(t, link)
+	case notification.SignalMessage:
+		p.Content = t.Param(ParamMessage)
+	default:
+		return nil, fmt.Errorf("unsupported message type: %T", t)
+	}
+
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+
+	if method == "POST" {
+		// wait for the message to be created, so we get its ID for future updates
+		q := u.Query()
+		q.Set("wait", "true")
+		u.RawQuery = q.Encode()
+	}
+
+	sent, body, err := nfychat.Send(ctx, s.client, nfychat.Request{
+		Method:  method,
+		URL:     u.String(),
+		Body:    data,
+		Webhook: true,
+	})
+	if err != nil || sent.State != notification.StateSent {
+		return sent, err
+	}
+
+	var res struct {
+		ID string `json:"id"`
+	}
+	err = json.Unmarshal(body, &res)
+	if err != nil {
+		// the message was sent, it just can't be updated later
+		log.Log(ctx, fmt.Errorf("decode discord response: %w", err))
+	}
+
+	if method == "PATCH" {
+		// keep the original message ID for future updates
+		res.ID = ""
+	}
+
+	return &notification.SentMessage{
+		ExternalID: res.ID,
+		State:      notification.StateDelivered,
+	}, nil
+}
@@ -0,0 +1,78 @@
+package discord
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/config"
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/notification"
+	"github.com/target/goalert/notification/nfymsg"
+)
+
+func TestSender_SendMessage(t *testing.T) {
+	var gotMethod, gotPath, gotQuery string
+	var gotBody payload
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		gotMethod, gotPath, gotQuery = req.Method, req.URL.Path, req.URL.RawQuery
+		data, _ := io.ReadAll(req.Body)
+		gotBody = payload{}
+		_ = json.Unmarshal(data, &gotBody)
+		_, _ = io.WriteString(w, `{"id": "1234"}`)
+	}))
+	defer srv.Close()
+
+	var cfg config.Config
+	cfg.General.PublicURL = "https://goalert.example.com"
+	ctx := cfg.Context(context.Background())
+
+	s := NewSender(ctx, srv.Client())
+	dest := NewChannelDest(srv.URL + "/api/webhooks/1/token")
+
+	res, err := s.SendMessage(ctx, notification.Alert{
+		Base:    nfymsg.Base{Dest: dest},
+		AlertID: 123,
+		Summary: "disk full @everyone",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1234", res.ExternalID, "message ID should be kept for updates")
+	assert.Equal(t, "POST", gotMethod)
+	assert.Equal(t, "/api/webhooks/1/token", gotPath)
+	assert.Equal(t, "wait=true", gotQuery)
+	require.Len(t, gotBody.Embeds, 1)
+	assert.Equal(t, "Alert #123: disk full @everyone", gotBody.Embeds[0].Title)
+	assert.Equal(t, "https://goalert.example.com/alerts/123", gotBody.Embeds[0].URL)
+	assert.Equal(t, colorUnacked, gotBody.Embeds[0].Color)
+	assert.NotNil(t, gotBody.AllowedMentions.Parse, "mentions should be disabled")
+
+	res, err = s.SendMessage(ctx, notification.AlertStatus{
+		Base:           nfymsg.Base{Dest: dest},
+		AlertID:        123,
+		Summary:        "disk full",
+		LogEntry:       "Acknowledged by Bob",
+		NewAlertState:  notification.AlertStateAcknowledged,
+		OriginalStatus: notification.SendResult{ProviderMessageID: gadb.ProviderMessageID{ExternalID: "1234"}},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, res.ExternalID)
+	assert.Equal(t, "PATCH", gotMethod, "status updates should edit the original message")
+	assert.Equal(t, "/api/webhooks/1/token/messages/1234", gotPath)
+	assert.Equal(t, colorAcked, gotBody.Embeds[0].Color)
+	assert.Equal(t, "Acknowledged by Bob", gotBody.Embeds[0].Footer.Text)
+}
+
+func TestSender_ValidateField(t *testing.T) {
+	var s Sender
+	var cfg config.Config
+	cfg.Webhook.AllowedURLs = []string{"https://discord.com/api/webhooks/"}
+	ctx := cfg.Context(context.Background())
+
+	assert.NoError(t, s.ValidateField(ctx, FieldWebhookURL, "https://discord.com/api/webhooks/1/token"))
+	assert.Error(t, s.ValidateField(ctx, FieldWebhookURL, "https://internal.example.com/api/webhooks/1/token"), "must match the allowed URLs")
+}
@@ -0,0 +1,102 @@
+package mattermost
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+
+	"github.com/target/goalert/config"
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/notification/nfydest"
+	"github.com/target/goalert/validation"
+	"github.com/target/goalert/validation/validate"
+)
+
+const (
+	DestTypeMattermostChannel = "builtin-mattermost-channel"
+	FieldWebhookURL           = "mattermost_webhook_url"
+	FieldChannelID            = "mattermost_channel_id"
+	ParamMessage              = "message"
+	FallbackIconURL           = "builtin://mattermost"
+)
+
+// Mattermost IDs are 26 lowercase alphanumeric characters.
+var channelIDRx = regexp.MustCompile(`^[a-z0-9]{26}$`)
+
+func NewChannelDest(webhookURL string) gadb.DestV1 {
+	return gadb.NewDestV1(DestTypeMattermostChannel, FieldWebhookURL, webhookURL)
+}
+
+var _ nfydest.Provider = (*Sender)(nil)
+
+func (s *Sender) ID() string { return DestTypeMattermostChannel }
+func (s *Sender) TypeInfo(ctx context.Context) (*nfydest.TypeInfo, error) {
+	cfg := config.FromContext(ctx)
+	return &nfydest.TypeInfo{
+		Type:                       DestTypeMattermostChannel,
+		Name:                       "Mattermost Channel",
+		Enabled:                    cfg.Mattermost.Enable,
+		SupportsAlertNotifications: true,
+		SupportsStatusUpdates:      true,
+		StatusUpdatesRequired:      true,
+		SupportsOnCallNotify:       true,
+		SupportsSignals:            true,
+		RequiredFields: []nfydest.FieldConfig{{
+			FieldID:         FieldWebhookURL,
+			Label:           "Webhook URL",
+			PlaceholderText: "https://mattermost.example.com/hooks/...",
+			InputType:       "url",
+			Hint:            "Create an incoming webhook for the channel from Integrations in Mattermost.",
+		}, {
+			FieldID:   FieldChannelID,
+			Label:     "Channel ID (optional)",
+			InputType: "text",
+			Hint:      "If set, and a bot token is configured, messages are posted by the bot and alert updates are threaded. The bot must be a member of the channel.",
+		}},
+		DynamicParams: []nfydest.DynamicParamConfig{{
+			ParamID: ParamMessage,
+			Label:   "Message",
+			Hint:    "The text of the message to send.",
+		}},
+	}, nil
+}
+
+func (s *Sender) ValidateField(ctx context.Context, fieldID, value string) error {
+	switch fieldID {
+	case FieldWebhookURL:
+		err := validate.AbsoluteURL(FieldWebhookURL, value)
+		if err != nil {
+			return err
+		}
+		if !config.FromContext(ctx).ValidWebhookURL(value) {
+			return validation.NewGenericError("url is not allowed by administrator")
+		}
+
+		return nil
+	case FieldChannelID:
+		if value == "" || channelIDRx.MatchString(value) {
+			return nil
+		}
+
+		return validation.NewGenericError("must be a 26-character Mattermost channel ID")
+	}
+
+	return validation.NewGenericError("unknown field ID")
+}
+
+func (s *Sender) DisplayInfo(ctx context.Context, args map[string]string) (*nfydest.DisplayInfo, error) {
+	if args == nil {
+		args = make(map[string]string)
+	}
+
+	u, err := url.Parse(args[FieldWebhookURL])
+	if err != nil {
+		return nil, validation.WrapError(err)
+	}
+
+	return &nfydest.DisplayInfo{
+		IconURL:     FallbackIconURL,
+		IconAltText: "Mattermost",
+		Text:        u.Hostname(),
+	}, nil
+}
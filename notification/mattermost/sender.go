@@ -0,0 +1,219 @@
+// Package mattermost sends notifications to Mattermost channels.
+//
+// Messages are posted to a channel's incoming webhook. If a bot token is configured and the
+// destination includes a channel ID, messages are posted by the bot instead, so alert status
+// updates can be replied in a thread under the original message, similar to Slack.
+package mattermost
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/target/goalert/config"
+	"github.com/target/goalert/notification"
+	"github.com/target/goalert/notification/nfychat"
+	"github.com/target/goalert/notification/nfydest"
+	"github.com/target/goalert/util/log"
+)
+
+// maxDetailsLength is the maximum length of alert details included in a message.
+const maxDetailsLength = 2000
+
+const (
+	colorClosed  = "#218626"
+	colorUnacked = "#862421"
+	colorAcked   = "#867321"
+)
+
+// Sender sends notifications to Mattermost channels.
+type Sender struct {
+	client *http.Client
+}
+
+var _ nfydest.MessageSender = (*Sender)(nil)
+
+// NewSender creates a new Sender.
+func NewSender(ctx context.Context, client *http.Client) *Sender {
+	return &Sender{client: client}
+}
+
+type attachmentField struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+	Short bool   `json:"short"`
+}
+
+// attachment is a Slack-compatible message attachment.
+//
+// https://developers.mattermost.com/integrate/reference/message-attachments/
+type attachment struct {
+	Fallback  string            `json:"fallback"`
+	Color     string            `json:"color,omitempty"`
+	Title     string            `json:"title,omitempty"`
+	TitleLink string            `json:"title_link,omitempty"`
+	Text      string            `json:"text,omitempty"`
+	Fields    []attachmentField `json:"fields,omitempty"`
+	Footer    string            `json:"footer,omitempty"`
+}
+
+type post struct {
+	Message     string
+	Attachments []attachment
+
+	// RootID is the ID of the post to reply to; only supported when posting as the bot.
+	RootID string
+}
+
+var escapeMarkdown = nfychat.Escaper("\\*_~`|[]()<>#")
+
+// noMentions prevents text from triggering mentions (e.g., `@channel` or `@all`) by
+// inserting a zero-width space after each `@`.
+func noMentions(s string) string { return strings.ReplaceAll(s, "@", "@\u200b") }
+
+// escape prevents text from being interpreted as markdown or triggering mentions.
+func escape(s string) string { return noMentions(escapeMarkdown(s)) }
+
+func link(text, url string) string { return fmt.Sprintf("[%s](%s)", escape(text), url) }
+
+func alertLink(ctx context.Context, id int, summary string) string {
+	cfg := config.FromContext(ctx)
+	return link(fmt.Sprintf("Alert #%d: %s", id, summary), cfg.CallbackURL(fmt.Sprintf("/alerts/%d", id)))
+}
+
+func alertAttachment(ctx context.Context, id int, summary, details, serviceName, logEntry string, state notification.AlertState) attachment {
+	cfg := config.FromContext(ctx)
+
+	// details are markdown, but all text is checked for mentions
+	title := noMentions(fmt.Sprintf("Alert #%d: %s", id, summary))
+	a := attachment{
+		Fallback:  title,
+		Title:     title,
+		TitleLink: cfg.CallbackURL(fmt.Sprintf("/alerts/%d", id)),
+		Text:      noMentions(nfychat.Truncate(details, maxDetailsLength)),
+		Footer:    noMentions(logEntry),
+	}
+	switch state {
+	case notification.AlertStateUnacknowledged:
+		a.Color = colorUnacked
+	case notification.AlertStateAcknowledged:
+		a.Color = colorAcked
+	case notification.AlertStateClosed:
+		a.Color = colorClosed
+	}
+	if serviceName != "" {
+		a.Fields = []attachmentField{{Title: "Service", Value: escape(serviceName), Short: true}}
+	}
+
+	return a
+}
+
+// SendMessage will post the message to the destination channel.
+func (s *Sender) SendMessage(ctx context.Context, msg notification.Message) (*notification.SentMessage, error) {
+	cfg := config.FromContext(ctx)
+	channelID := msg.DestArg(FieldChannelID)
+	useBot := channelID != "" && cfg.Mattermost.BotToken != "" && cfg.Mattermost.URL != ""
+
+	var p post
+	var isUpdate bool
+	switch t := msg.(type) {
+	case notification.Alert:
+		if useBot && t.OriginalStatus != nil && t.OriginalStatus.ProviderMessageID.ExternalID != "" {
+			// Reply in thread if we already sent a message for this alert.
+			p.RootID = t.OriginalStatus.ProviderMessageID.ExternalID
+			p.Message = alertLink(ctx, t.AlertID, t.Summary)
+			break
+		}
+		p.Attachments = []attachment{alertAttachment(ctx, t.AlertID, t.Summary, t.Details, t.ServiceName, "Unacknowledged", notification.AlertStateUnacknowledged)}
+	case notification.AlertStatus:
+		isUpdate = true
+		if useBot && t.OriginalStatus.ProviderMessageID.ExternalID != "" {
+			p.RootID = t.OriginalStatus.ProviderMessageID.ExternalID
+			p.Attachments = []attachment{alertAttachment(ctx, t.AlertID, t.Summary, "", "", t.LogEntry, t.NewAlertState)}
+			break
+		}
+		p.Attachments = []attachment{alertAttachment(ctx, t.AlertID, t.Summary, t.Details, t.ServiceName, t.LogEntry, t.NewAlertState)}
+	case notification.AlertBundle:
+		p.Message = fmt.Sprintf("Service '%s' has %d unacknowledged alerts.\n\n%s", escape(t.ServiceName), t.Count, cfg.CallbackURL("/services/"+t.ServiceID+"/alerts"))
+	case notification.ScheduleOnCallUsers:
+		p.Message = nfychat.OnCallText(t, link)
+	case notification.SignalMessage:
+		p.Message = t.Param(ParamMessage)
+	default:
+		return nil, fmt.Errorf("unsupported message type: %T", t)
+	}
+
+	var res *notification.SentMessage
+	var err error
+	if useBot {
+		res, err = s.postAPI(ctx, channelID, p)
+	} else {
+		res, err = s.postWebhook(ctx, msg.DestArg(FieldWebhookURL), p)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if isUpdate {
+		res.ExternalID = ""
+	}
+
+	return res, nil
+}
+
+func (s *Sender) postWebhook(ctx context.Context, webURL string, p post) (*notification.SentMessage, error) {
+	data, err := json.Marshal(struct {
+		Text        string       `json:"text,omitempty"`
+		Attachments []attachment `json:"attachments,omitempty"`
+	}{p.Message, p.Attachments})
+	if err != nil {
+		return nil, err
+	}
+
+	res, _, err := nfychat.Send(ctx, s.client, nfychat.Request{URL: webURL, Body: data, Webhook: true})
+	return res, err
+}
+
+// postAPI creates the post as the bot user, so the post ID can be used for thread replies.
+func (s *Sender) postAPI(ctx context.Context, channelID string, p post) (*notification.SentMessage, error) {
+	cfg := config.FromContext(ctx)
+
+	type props struct {
+		Attachments []attachment `json:"attachments,omitempty"`
+	}
+	data, err := json.Marshal(struct {
+		ChannelID string `json:"channel_id"`
+		Message   string `json:"message"`
+		RootID    string `json:"root_id,omitempty"`
+		Props     props  `json:"props"`
+	}{channelID, p.Message, p.RootID, props{p.Attachments}})
+	if err != nil {
+		return nil, err
+	}
+
+	apiURL, err := url.JoinPath(cfg.Mattermost.URL, "api/v4/posts")
+	if err != nil {
+		return nil, err
+	}
+
+	res, body, err := nfychat.Send(ctx, s.client, nfychat.Request{URL: apiURL, Body: data, Token: cfg.Mattermost.BotToken})
+	if err != nil || res.State != notification.StateSent {
+		return res, err
+	}
+
+	var created struct {
+		ID string `json:"id"`
+	}
+	err = json.Unmarshal(body, &created)
+	if err != nil {
+		// the message was sent, it just can't be replied to later
+		log.Log(ctx, fmt.Errorf("decode mattermost response: %w", err))
+	}
+
+	return &notification.SentMessage{
+		ExternalID: created.ID,
+		State:      notification.StateDelivered,
+	}, nil
+}
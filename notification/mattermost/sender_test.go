@@ -0,0 +1,97 @@
+package mattermost
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/config"
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/notification"
+	"github.com/target/goalert/notification/nfymsg"
+)
+
+func TestSender_SendMessage(t *testing.T) {
+	var gotPath, gotAuth string
+	var gotBody map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		gotPath, gotAuth = req.URL.Path, req.Header.Get("Authorization")
+		data, _ := io.ReadAll(req.Body)
+		gotBody = nil
+		_ = json.Unmarshal(data, &gotBody)
+		if req.URL.Path == "/api/v4/posts" {
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.WriteString(w, `{"id": "post1"}`)
+		}
+	}))
+	defer srv.Close()
+
+	var cfg config.Config
+	cfg.General.PublicURL = "https://goalert.example.com"
+	ctx := cfg.Context(context.Background())
+
+	s := NewSender(ctx, srv.Client())
+	dest := NewChannelDest(srv.URL + "/hooks/abc")
+	dest.SetArg(FieldChannelID, "abcdefghijklmnopqrstuvwxyz")
+	alert := notification.Alert{
+		Base:    nfymsg.Base{Dest: dest},
+		AlertID: 123,
+		Summary: "disk full @channel",
+		Details: "ping @all",
+	}
+
+	// without a bot token, the webhook is used
+	res, err := s.SendMessage(ctx, alert)
+	require.NoError(t, err)
+	assert.Equal(t, notification.StateSent, res.State)
+	assert.Empty(t, res.ExternalID)
+	assert.Equal(t, "/hooks/abc", gotPath)
+	assert.Empty(t, gotAuth)
+	require.Contains(t, gotBody, "attachments")
+	att := gotBody["attachments"].([]any)[0].(map[string]any)
+	assert.Equal(t, "Alert #123: disk full @\u200bchannel", att["title"], "mentions should be escaped")
+	assert.Equal(t, "ping @\u200ball", att["text"], "mentions should be escaped")
+
+	cfg.Mattermost.URL = srv.URL
+	cfg.Mattermost.BotToken = "token"
+	ctx = cfg.Context(context.Background())
+
+	res, err = s.SendMessage(ctx, alert)
+	require.NoError(t, err)
+	assert.Equal(t, "post1", res.ExternalID, "post ID should be kept for replies")
+	assert.Equal(t, "/api/v4/posts", gotPath)
+	assert.Equal(t, "Bearer token", gotAuth)
+	assert.Equal(t, "abcdefghijklmnopqrstuvwxyz", gotBody["channel_id"])
+	assert.NotContains(t, gotBody, "root_id")
+
+	res, err = s.SendMessage(ctx, notification.AlertStatus{
+		Base:           nfymsg.Base{Dest: dest},
+		AlertID:        123,
+		Summary:        "disk full",
+		LogEntry:       "Closed by Bob",
+		NewAlertState:  notification.AlertStateClosed,
+		OriginalStatus: notification.SendResult{ProviderMessageID: gadb.ProviderMessageID{ExternalID: "post1"}},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, res.ExternalID)
+	assert.Equal(t, "post1", gotBody["root_id"], "status updates should reply in thread")
+}
+
+func TestSender_ValidateField(t *testing.T) {
+	var s Sender
+	ctx := context.Background()
+	assert.NoError(t, s.ValidateField(ctx, FieldChannelID, ""))
+	assert.NoError(t, s.ValidateField(ctx, FieldChannelID, "abcdefghijklmnopqrstuvwxyz"))
+	assert.Error(t, s.ValidateField(ctx, FieldChannelID, "town-square"))
+
+	var cfg config.Config
+	cfg.Webhook.AllowedURLs = []string{"https://mattermost.example.com/hooks/"}
+	ctx = cfg.Context(ctx)
+	assert.NoError(t, s.ValidateField(ctx, FieldWebhookURL, "https://mattermost.example.com/hooks/abc"))
+	assert.Error(t, s.ValidateField(ctx, FieldWebhookURL, "http://169.254.169.254/hooks/abc"), "must match the allowed URLs")
+}
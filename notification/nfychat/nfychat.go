@@ -0,0 +1,147 @@
+// Package nfychat contains helpers shared by the chat webhook providers (Discord, Mattermost, and Teams).
+package nfychat
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/target/goalert/config"
+	"github.com/target/goalert/notification"
+)
+
+// SendTimeout is the maximum time to wait for a chat provider request to complete.
+const SendTimeout = 10 * time.Second
+
+// maxResponseSize is the largest response body that will be read.
+const maxResponseSize = 1 << 20
+
+// Escaper returns a function that escapes each of the given characters with a backslash,
+// preventing text from being interpreted as markdown.
+func Escaper(chars string) func(string) string {
+	return func(s string) string {
+		var b strings.Builder
+		for _, r := range s {
+			if strings.ContainsRune(chars, r) {
+				b.WriteRune('\\')
+			}
+			b.WriteRune(r)
+		}
+		return b.String()
+	}
+}
+
+// Truncate will limit s to n characters, adding an ellipsis if anything was removed.
+func Truncate(s string, n int) string {
+	if r := []rune(s); len(r) > n {
+		return string(r[:n]) + "…"
+	}
+	return s
+}
+
+// OnCallText returns a sentence listing the on-call users for the schedule, sorted by name.
+//
+// The link function should return a markdown link, escaping the text as needed.
+func OnCallText(msg notification.ScheduleOnCallUsers, link func(text, url string) string) string {
+	users := append([]notification.User(nil), msg.Users...)
+	sort.Slice(users, func(i, j int) bool {
+		if users[i].Name == users[j].Name {
+			return users[i].ID < users[j].ID
+		}
+		return users[i].Name < users[j].Name
+	})
+
+	sched := link(msg.ScheduleName, msg.ScheduleURL)
+	if len(users) == 0 {
+		return "No users are on-call for " + sched
+	}
+
+	links := make([]string, len(users))
+	for i, u := range users {
+		links[i] = link(u.Name, u.URL)
+	}
+
+	verb := "is"
+	if len(users) > 1 {
+		verb = "are"
+	}
+
+	return fmt.Sprintf("%s %s on-call for %s", strings.Join(links, ", "), verb, sched)
+}
+
+// Request is a JSON request to a chat provider.
+type Request struct {
+	// Method defaults to POST.
+	Method string
+	URL    string
+	Body   []byte
+
+	// Token, if set, is sent as a bearer token.
+	Token string
+
+	// Webhook indicates the URL was provided by a user, and must be allowed by the
+	// Webhook.AllowedURLs config.
+	Webhook bool
+}
+
+// Send will perform the request, mapping the response status to a message state.
+//
+// 2xx responses result in StateSent, 429 and 5xx in StateFailedTemp, and anything else
+// in StateFailedPerm. The response body is returned for the caller to decode.
+func Send(ctx context.Context, client *http.Client, r Request) (*notification.SentMessage, []byte, error) {
+	if r.Webhook && !config.FromContext(ctx).ValidWebhookURL(r.URL) {
+		// fail permanently if the URL is not currently valid/allowed
+		return &notification.SentMessage{
+			State:        notification.StateFailedPerm,
+			StateDetails: "invalid or not allowed URL",
+		}, nil, nil
+	}
+
+	method := r.Method
+	if method == "" {
+		method = "POST"
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, SendTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, method, r.URL, bytes.NewReader(r.Body))
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if r.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+r.Token)
+	}
+
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+	// the request was already handled, so a failure to read the response body isn't retried
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return &notification.SentMessage{State: notification.StateSent}, body, nil
+	case resp.StatusCode == http.StatusTooManyRequests, resp.StatusCode >= 500:
+		return &notification.SentMessage{
+			State:        notification.StateFailedTemp,
+			StateDetails: resp.Status,
+		}, body, nil
+	}
+
+	return &notification.SentMessage{
+		State:        notification.StateFailedPerm,
+		StateDetails: resp.Status,
+	}, body, nil
+}
@@ -0,0 +1,102 @@
+package nfychat
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/config"
+	"github.com/target/goalert/notification"
+)
+
+func TestSend(t *testing.T) {
+	var status int
+	var gotMethod, gotAuth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		gotMethod, gotAuth = req.Method, req.Header.Get("Authorization")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"id": "1"}`))
+	}))
+	defer srv.Close()
+
+	var cfg config.Config
+	ctx := cfg.Context(context.Background())
+
+	check := func(code int, exp notification.State) {
+		t.Helper()
+		status = code
+		res, body, err := Send(ctx, srv.Client(), Request{URL: srv.URL, Webhook: true})
+		require.NoError(t, err)
+		assert.Equal(t, exp, res.State, "status %d", code)
+		assert.Equal(t, `{"id": "1"}`, string(body))
+	}
+	check(http.StatusOK, notification.StateSent)
+	check(http.StatusAccepted, notification.StateSent)
+	check(http.StatusTooManyRequests, notification.StateFailedTemp)
+	check(http.StatusBadGateway, notification.StateFailedTemp)
+	check(http.StatusNotFound, notification.StateFailedPerm)
+	assert.Equal(t, "POST", gotMethod)
+	assert.Empty(t, gotAuth)
+
+	status = http.StatusOK
+	_, _, err := Send(ctx, srv.Client(), Request{Method: "PATCH", URL: srv.URL, Token: "tok"})
+	require.NoError(t, err)
+	assert.Equal(t, "PATCH", gotMethod)
+	assert.Equal(t, "Bearer tok", gotAuth)
+}
+
+func TestSend_AllowedURLs(t *testing.T) {
+	var called bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		called = true
+	}))
+	defer srv.Close()
+
+	var cfg config.Config
+	cfg.Webhook.AllowedURLs = []string{"https://chat.example.com/"}
+	ctx := cfg.Context(context.Background())
+
+	res, _, err := Send(ctx, srv.Client(), Request{URL: srv.URL, Webhook: true})
+	require.NoError(t, err)
+	assert.Equal(t, notification.StateFailedPerm, res.State)
+	assert.False(t, called, "disallowed URLs should not be sent to")
+
+	// only user-provided URLs are checked
+	res, _, err = Send(ctx, srv.Client(), Request{URL: srv.URL})
+	require.NoError(t, err)
+	assert.Equal(t, notification.StateSent, res.State)
+	assert.True(t, called)
+}
+
+func TestOnCallText(t *testing.T) {
+	link := func(text, url string) string { return "[" + text + "](" + url + ")" }
+
+	s := OnCallText(notification.ScheduleOnCallUsers{
+		ScheduleName: "Primary",
+		ScheduleURL:  "https://goalert.example.com/schedules/1",
+		Users: []notification.User{
+			{ID: "2", Name: "Bob", URL: "https://goalert.example.com/users/2"},
+			{ID: "1", Name: "Alice", URL: "https://goalert.example.com/users/1"},
+		},
+	}, link)
+	assert.Equal(t, "[Alice](https://goalert.example.com/users/1), [Bob](https://goalert.example.com/users/2) are on-call for [Primary](https://goalert.example.com/schedules/1)", s)
+
+	s = OnCallText(notification.ScheduleOnCallUsers{
+		ScheduleName: "Primary",
+		ScheduleURL:  "https://goalert.example.com/schedules/1",
+		Users:        []notification.User{{ID: "1", Name: "Alice", URL: "https://goalert.example.com/users/1"}},
+	}, link)
+	assert.Equal(t, "[Alice](https://goalert.example.com/users/1) is on-call for [Primary](https://goalert.example.com/schedules/1)", s)
+
+	s = OnCallText(notification.ScheduleOnCallUsers{ScheduleName: "Primary", ScheduleURL: "https://goalert.example.com/schedules/1"}, link)
+	assert.Equal(t, "No users are on-call for [Primary](https://goalert.example.com/schedules/1)", s)
+}
+
+func TestEscaper(t *testing.T) {
+	assert.Equal(t, `a\*b\_c`, Escaper("*_")("a*b_c"))
+	assert.Equal(t, "abc", Truncate("abc", 3))
+	assert.Equal(t, "ab…", Truncate("abcd", 2))
+}
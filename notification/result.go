@@ -10,4 +10,5 @@ const (
 	ResultAcknowledge Result = iota
 	ResultResolve
 	ResultEscalate
+	ResultSnooze
 )
@@ -368,6 +368,7 @@ const (
 	alertResponseBlockID = "block_alert_response"
 	alertCloseActionID   = "action_alert_close"
 	alertAckActionID     = "action_alert_ack"
+	alertSnoozeActionID  = "action_alert_snooze"
 	linkActActionID      = "action_link_account"
 )
 
@@ -386,6 +387,7 @@ func alertMsgOption(ctx context.Context, callbackID string, id int, summary, log
 		actions = []slack.Block{
 			slack.NewDividerBlock(),
 			slack.NewActionBlock(alertResponseBlockID,
+				slack.NewButtonBlockElement(alertSnoozeActionID, callbackID, slack.NewTextBlockObject("plain_text", "Snooze", false, false)),
 				slack.NewButtonBlockElement(alertCloseActionID, callbackID, slack.NewTextBlockObject("plain_text", "Close", false, false)),
 			),
 		}
@@ -395,6 +397,7 @@ func alertMsgOption(ctx context.Context, callbackID string, id int, summary, log
 			slack.NewDividerBlock(),
 			slack.NewActionBlock(alertResponseBlockID,
 				slack.NewButtonBlockElement(alertAckActionID, callbackID, slack.NewTextBlockObject("plain_text", "Acknowledge", false, false)),
+				slack.NewButtonBlockElement(alertSnoozeActionID, callbackID, slack.NewTextBlockObject("plain_text", "Snooze", false, false)),
 				slack.NewButtonBlockElement(alertCloseActionID, callbackID, slack.NewTextBlockObject("plain_text", "Close", false, false)),
 			),
 		}
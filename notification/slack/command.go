@@ -0,0 +1,426 @@
+package slack
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/slack-go/slack"
+	"github.com/target/goalert/alert"
+	"github.com/target/goalert/auth/authlink"
+	"github.com/target/goalert/config"
+	"github.com/target/goalert/notification"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/schedule"
+	"github.com/target/goalert/service"
+	"github.com/target/goalert/user"
+	"github.com/target/goalert/util/errutil"
+	"github.com/target/goalert/util/log"
+	"github.com/target/goalert/validation"
+	"github.com/target/goalert/validation/validate"
+)
+
+// userContext will return a context authorized as the GoAlert user linked to the given Slack user.
+//
+// If the Slack user has not been linked, a nil context is returned.
+func (s *ChannelSender) userContext(ctx context.Context, teamID, userID string) (context.Context, error) {
+	var usr *user.User
+	var err error
+	permission.SudoContext(ctx, func(ctx context.Context) {
+		usr, err = s.cfg.UserStore.FindOneBySubject(ctx, "slack:"+teamID, userID)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("find user: %w", err)
+	}
+	if usr == nil {
+		return nil, nil
+	}
+
+	return permission.UserSourceContext(ctx, usr.ID, usr.Role, &permission.SourceInfo{
+		Type: permission.SourceTypeAuthSubject,
+		ID:   "slack:" + teamID,
+	}), nil
+}
+
+func writeJSON(ctx context.Context, w http.ResponseWriter, v any) {
+	data, err := json.Marshal(v)
+	if errutil.HTTPError(ctx, w, err) {
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data)
+}
+
+// commandResponse is the message returned in response to a slash command.
+type commandResponse struct {
+	ResponseType string        `json:"response_type"`
+	Text         string        `json:"text,omitempty"`
+	Blocks       []slack.Block `json:"blocks,omitempty"`
+}
+
+func ephemeralText(text string) *commandResponse {
+	return &commandResponse{ResponseType: slack.ResponseTypeEphemeral, Text: text}
+}
+
+// commandErrorText returns a message for the user describing err.
+//
+// Unexpected errors are logged and a generic message is returned.
+func commandErrorText(ctx context.Context, err error) string {
+	switch {
+	case permission.IsPermissionError(err):
+		return "You do not have permission to do that."
+	case validation.IsClientError(err):
+		return err.Error()
+	}
+
+	log.Log(ctx, err)
+	return "Something went wrong, please try again later."
+}
+
+// splitArgs splits s on whitespace, keeping quoted strings together.
+//
+// Slack may replace straight quotes with smart quotes, so both are supported.
+func splitArgs(s string) []string {
+	var args []string
+	var cur strings.Builder
+	var inQuote, hasArg bool
+	for _, r := range s {
+		switch {
+		case r == '"' || r == '“' || r == '”':
+			inQuote = !inQuote
+			hasArg = true
+		case !inQuote && (r == ' ' || r == '\t' || r == '\n'):
+			if hasArg {
+				args = append(args, cur.String())
+				cur.Reset()
+				hasArg = false
+			}
+		default:
+			cur.WriteRune(r)
+			hasArg = true
+		}
+	}
+	if hasArg {
+		args = append(args, cur.String())
+	}
+
+	return args
+}
+
+// parseAlertID parses an alert ID, with or without a leading `#`.
+func parseAlertID(s string) (int, error) {
+	id, err := strconv.Atoi(strings.TrimPrefix(s, "#"))
+	if err != nil || id < 1 {
+		return 0, validation.NewGenericError(fmt.Sprintf("'%s' is not a valid alert ID.", s))
+	}
+
+	return id, nil
+}
+
+// matchName returns the item whose name matches query (case-insensitive), or the only item if there is exactly one.
+func matchName[T any](kind, query string, items []T, nameFn func(T) string) (T, error) {
+	var empty T
+	for _, item := range items {
+		if strings.EqualFold(nameFn(item), query) {
+			return item, nil
+		}
+	}
+
+	switch len(items) {
+	case 0:
+		return empty, validation.NewGenericError(fmt.Sprintf("No %s found matching '%s'.", kind, query))
+	case 1:
+		return items[0], nil
+	}
+
+	names := make([]string, len(items))
+	for i, item := range items {
+		names[i] = "'" + nameFn(item) + "'"
+	}
+	return empty, validation.NewGenericError(fmt.Sprintf("Multiple %ss match '%s': %s.", kind, query, strings.Join(names, ", ")))
+}
+
+func (s *ChannelSender) findSchedule(ctx context.Context, query string) (*schedule.Schedule, error) {
+	if validate.UUID("ScheduleID", query) == nil {
+		res, err := s.cfg.ScheduleStore.FindOne(ctx, query)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, validation.NewGenericError(fmt.Sprintf("No schedule found with ID '%s'.", query))
+		}
+		return res, err
+	}
+
+	scheds, err := s.cfg.ScheduleStore.Search(ctx, &schedule.SearchOptions{
+		Search:          query,
+		FavoritesUserID: permission.UserID(ctx),
+		FavoritesFirst:  true,
+		Limit:           10,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sched, err := matchName("schedule", query, scheds, func(s schedule.Schedule) string { return s.Name })
+	if err != nil {
+		return nil, err
+	}
+
+	return &sched, nil
+}
+
+func (s *ChannelSender) findService(ctx context.Context, query string) (*service.Service, error) {
+	if validate.UUID("ServiceID", query) == nil {
+		res, err := s.cfg.ServiceStore.FindOne(ctx, query)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, validation.NewGenericError(fmt.Sprintf("No service found with ID '%s'.", query))
+		}
+		return res, err
+	}
+
+	svcs, err := s.cfg.ServiceStore.Search(ctx, &service.SearchOptions{
+		Search:          query,
+		FavoritesUserID: permission.UserID(ctx),
+		FavoritesFirst:  true,
+		Limit:           10,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	svc, err := matchName("service", query, svcs, func(s service.Service) string { return s.Name })
+	if err != nil {
+		return nil, err
+	}
+
+	return &svc, nil
+}
+
+func commandUsage(cmd string) string {
+	if cmd == "" {
+		cmd = "/goalert"
+	}
+
+	return strings.Join([]string{
+		"Usage:",
+		fmt.Sprintf("`%s oncall <schedule>` – show who is currently on-call for a schedule", cmd),
+		fmt.Sprintf("`%s ack <alert ID>` – acknowledge an alert", cmd),
+		fmt.Sprintf("`%s escalate <alert ID>` – escalate an alert", cmd),
+		fmt.Sprintf("`%s create alert <service> <summary>` – create an alert; use quotes for names with spaces, or leave out the summary to open a form", cmd),
+	}, "\n")
+}
+
+// ServeSlashCommand handles requests for the GoAlert slash command (e.g., `/goalert`).
+func (s *ChannelSender) ServeSlashCommand(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	cfg := config.FromContext(ctx)
+
+	if !cfg.Slack.InteractiveMessages {
+		http.Error(w, "not enabled", http.StatusNotFound)
+		return
+	}
+
+	err := validateRequestSignature(time.Now(), req)
+	if errutil.HTTPError(ctx, w, err) {
+		return
+	}
+
+	cmd, err := slack.SlashCommandParse(req)
+	if errutil.HTTPError(ctx, w, err) {
+		return
+	}
+
+	args := splitArgs(cmd.Text)
+	if len(args) == 0 || strings.EqualFold(args[0], "help") {
+		writeJSON(ctx, w, ephemeralText(commandUsage(cmd.Command)))
+		return
+	}
+
+	userCtx, err := s.userContext(ctx, cmd.TeamID, cmd.UserID)
+	if errutil.HTTPError(ctx, w, err) {
+		return
+	}
+	if userCtx == nil {
+		var meta authlink.Metadata
+		if len(args) == 2 && strings.EqualFold(args[0], "ack") {
+			// acknowledge the alert once the account is linked
+			meta.AlertID, _ = parseAlertID(args[1])
+			if meta.AlertID != 0 {
+				meta.AlertAction = notification.ResultAcknowledge.String()
+			}
+		}
+		linkURL := s.authLinkURL(ctx, cmd.TeamID, cmd.UserID, cmd.UserName, cmd.TeamDomain, meta)
+		writeJSON(ctx, w, &commandResponse{
+			ResponseType: slack.ResponseTypeEphemeral,
+			Blocks:       linkAccountBlocks(linkURL),
+		})
+		return
+	}
+
+	resp := s.runCommand(userCtx, cmd, args)
+	if resp == nil {
+		// nothing to reply with, e.g., a modal was opened
+		return
+	}
+
+	writeJSON(ctx, w, resp)
+}
+
+// runCommand executes the slash command as the linked GoAlert user and returns the response message.
+//
+// A nil response indicates no message should be sent.
+func (s *ChannelSender) runCommand(ctx context.Context, cmd slack.SlashCommand, args []string) *commandResponse {
+	usage := ephemeralText(commandUsage(cmd.Command))
+
+	switch strings.ToLower(args[0]) {
+	case "oncall", "on-call":
+		if len(args) < 2 {
+			return usage
+		}
+		msg, err := s.onCallCommand(ctx, strings.Join(args[1:], " "))
+		if err != nil {
+			return ephemeralText(commandErrorText(ctx, err))
+		}
+		return msg
+	case "ack", "acknowledge":
+		if len(args) != 2 {
+			return usage
+		}
+		return s.alertCommand(ctx, args[1], func(ctx context.Context, a *alert.Alert) (string, error) {
+			err := s.cfg.AlertStore.UpdateStatus(ctx, a.ID, alert.StatusActive)
+			switch {
+			case alert.IsAlreadyAcknowledged(err):
+				return "%s is already acknowledged.", nil
+			case alert.IsAlreadyClosed(err):
+				return "%s is already closed.", nil
+			case err != nil:
+				return "", err
+			}
+			return "Acknowledged %s.", nil
+		})
+	case "escalate":
+		if len(args) != 2 {
+			return usage
+		}
+		return s.alertCommand(ctx, args[1], func(ctx context.Context, a *alert.Alert) (string, error) {
+			_, err := s.cfg.AlertStore.EscalateMany(ctx, []int{a.ID})
+			switch {
+			case alert.IsAlreadyClosed(err):
+				return "%s is already closed.", nil
+			case err != nil:
+				return "", err
+			}
+			return "Escalated %s.", nil
+		})
+	case "create":
+		if len(args) < 2 || !strings.EqualFold(args[1], "alert") {
+			return usage
+		}
+		if len(args) < 4 {
+			// not enough info, open the modal instead
+			var svcName string
+			if len(args) == 3 {
+				svcName = args[2]
+			}
+			err := s.openCreateAlertModal(ctx, cmd.TriggerID, cmd.ChannelID, svcName)
+			if err != nil {
+				return ephemeralText(commandErrorText(ctx, err))
+			}
+
+			return nil
+		}
+
+		svc, err := s.findService(ctx, args[2])
+		if err != nil {
+			return ephemeralText(commandErrorText(ctx, err))
+		}
+		a, err := s.createAlert(ctx, svc.ID, strings.Join(args[3:], " "), "")
+		if err != nil {
+			return ephemeralText(commandErrorText(ctx, err))
+		}
+		return ephemeralText(fmt.Sprintf("Created %s.", alertLink(ctx, a.ID, a.Summary)))
+	}
+
+	return usage
+}
+
+// alertCommand looks up the alert by ID and calls fn. The format string returned by fn is rendered with a link to the alert.
+func (s *ChannelSender) alertCommand(ctx context.Context, idStr string, fn func(context.Context, *alert.Alert) (string, error)) *commandResponse {
+	id, err := parseAlertID(idStr)
+	if err != nil {
+		return ephemeralText(commandErrorText(ctx, err))
+	}
+
+	a, err := s.cfg.AlertStore.FindOne(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ephemeralText(fmt.Sprintf("Alert #%d not found.", id))
+	}
+	if err != nil {
+		return ephemeralText(commandErrorText(ctx, err))
+	}
+
+	format, err := fn(ctx, a)
+	if err != nil {
+		return ephemeralText(commandErrorText(ctx, err))
+	}
+
+	return ephemeralText(fmt.Sprintf(format, alertLink(ctx, a.ID, a.Summary)))
+}
+
+func (s *ChannelSender) onCallCommand(ctx context.Context, query string) (*commandResponse, error) {
+	cfg := config.FromContext(ctx)
+	sched, err := s.findSchedule(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	onCall, err := s.cfg.OnCallStore.OnCallUsersBySchedule(ctx, sched.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := notification.ScheduleOnCallUsers{
+		ScheduleID:   sched.ID,
+		ScheduleName: sched.Name,
+		ScheduleURL:  cfg.CallbackURL("/schedules/" + sched.ID),
+	}
+	for _, u := range onCall {
+		msg.Users = append(msg.Users, notification.User{
+			ID:   u.ID,
+			Name: u.Name,
+			URL:  cfg.CallbackURL("/users/" + u.ID),
+		})
+	}
+
+	var text string
+	// looking up Slack IDs for other users requires elevated permissions
+	permission.SudoContext(ctx, func(ctx context.Context) {
+		text = s.onCallNotificationText(ctx, msg)
+	})
+
+	return &commandResponse{
+		ResponseType: slack.ResponseTypeInChannel,
+		Text:         text,
+	}, nil
+}
+
+// createAlert creates a new alert as the current user.
+func (s *ChannelSender) createAlert(ctx context.Context, serviceID, summary, details string) (*alert.Alert, error) {
+	a, _, err := s.cfg.AlertStore.CreateOrUpdate(ctx, &alert.Alert{
+		ServiceID: serviceID,
+		Summary:   validate.SanitizeText(summary, alert.MaxSummaryLength),
+		Details:   validate.SanitizeText(details, alert.MaxDetailsLength),
+		Status:    alert.StatusTriggered,
+		Source:    alert.SourceManual,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
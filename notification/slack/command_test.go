@@ -0,0 +1,57 @@
+package slack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitArgs(t *testing.T) {
+	check := func(input string, expected ...string) {
+		t.Helper()
+		assert.Equal(t, expected, splitArgs(input), input)
+	}
+
+	check("")
+	check("   ")
+	check("ack 123", "ack", "123")
+	check("  oncall   Primary  ", "oncall", "Primary")
+	check(`create alert "My Service" disk is full`, "create", "alert", "My Service", "disk", "is", "full")
+	check("create alert “My Service” disk", "create", "alert", "My Service", "disk")
+	check(`create alert "" foo`, "create", "alert", "", "foo")
+}
+
+func TestParseAlertID(t *testing.T) {
+	id, err := parseAlertID("123")
+	require.NoError(t, err)
+	assert.Equal(t, 123, id)
+
+	id, err = parseAlertID("#45")
+	require.NoError(t, err)
+	assert.Equal(t, 45, id)
+
+	_, err = parseAlertID("abc")
+	assert.Error(t, err)
+
+	_, err = parseAlertID("0")
+	assert.Error(t, err)
+}
+
+func TestMatchName(t *testing.T) {
+	ident := func(s string) string { return s }
+
+	name, err := matchName("schedule", "primary", []string{"Primary Secondary", "Primary"}, ident)
+	require.NoError(t, err)
+	assert.Equal(t, "Primary", name, "exact match should be preferred")
+
+	name, err = matchName("schedule", "prim", []string{"Primary"}, ident)
+	require.NoError(t, err)
+	assert.Equal(t, "Primary", name, "single result should match")
+
+	_, err = matchName("schedule", "prim", []string{"Primary", "Primary Backup"}, ident)
+	assert.EqualError(t, err, "Multiple schedules match 'prim': 'Primary', 'Primary Backup'.")
+
+	_, err = matchName("schedule", "foo", nil, ident)
+	assert.EqualError(t, err, "No schedule found matching 'foo'.")
+}
@@ -3,6 +3,10 @@ package slack
 import (
 	"net/http"
 
+	"github.com/target/goalert/alert"
+	"github.com/target/goalert/oncall"
+	"github.com/target/goalert/schedule"
+	"github.com/target/goalert/service"
 	"github.com/target/goalert/user"
 )
 
@@ -11,4 +15,10 @@ type Config struct {
 	BaseURL   string
 	UserStore *user.Store
 	Client    *http.Client
+
+	// The following stores are used to handle slash commands and modals.
+	AlertStore    *alert.Store
+	ServiceStore  *service.Store
+	ScheduleStore *schedule.Store
+	OnCallStore   *oncall.Store
 }
@@ -0,0 +1,215 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/slack-go/slack"
+	"github.com/target/goalert/config"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/service"
+	"github.com/target/goalert/util/errutil"
+	"github.com/target/goalert/util/log"
+	"github.com/target/goalert/validation"
+)
+
+const (
+	createAlertCallbackID = "create_alert"
+
+	createAlertServiceBlockID  = "block_create_alert_service"
+	createAlertServiceActionID = "action_create_alert_service"
+	createAlertSummaryBlockID  = "block_create_alert_summary"
+	createAlertSummaryActionID = "action_create_alert_summary"
+	createAlertDetailsBlockID  = "block_create_alert_details"
+	createAlertDetailsActionID = "action_create_alert_details"
+)
+
+// optionText returns text for a select option; Slack limits option text to 75 characters.
+func optionText(s string) *slack.TextBlockObject {
+	if r := []rune(s); len(r) > 75 {
+		s = string(r[:74]) + "…"
+	}
+
+	return slack.NewTextBlockObject("plain_text", s, false, false)
+}
+
+func serviceOption(svc service.Service) *slack.OptionBlockObject {
+	return slack.NewOptionBlockObject(svc.ID, optionText(svc.Name), nil)
+}
+
+// openCreateAlertModal opens a modal for the user to create an alert.
+//
+// If serviceQuery is provided, the matching service will be pre-selected.
+func (s *ChannelSender) openCreateAlertModal(ctx context.Context, triggerID, channelID, serviceQuery string) error {
+	if triggerID == "" {
+		return validation.NewGenericError("Unable to open the create alert form, please try again.")
+	}
+
+	svcSelect := slack.NewOptionsSelectBlockElement(slack.OptTypeExternal,
+		slack.NewTextBlockObject("plain_text", "Search services", false, false),
+		createAlertServiceActionID)
+	minQueryLength := 0
+	svcSelect.MinQueryLength = &minQueryLength
+	if serviceQuery != "" {
+		svc, err := s.findService(ctx, serviceQuery)
+		if err != nil {
+			return err
+		}
+		svcSelect.InitialOption = serviceOption(*svc)
+	}
+
+	summary := slack.NewPlainTextInputBlockElement(nil, createAlertSummaryActionID)
+	details := slack.NewPlainTextInputBlockElement(nil, createAlertDetailsActionID)
+	details.Multiline = true
+	detailsBlock := slack.NewInputBlock(createAlertDetailsBlockID,
+		slack.NewTextBlockObject("plain_text", "Details", false, false), nil, details)
+	detailsBlock.Optional = true
+
+	view := slack.ModalViewRequest{
+		Type:       slack.VTModal,
+		CallbackID: createAlertCallbackID,
+		Title:      slack.NewTextBlockObject("plain_text", "Create Alert", false, false),
+		Submit:     slack.NewTextBlockObject("plain_text", "Create", false, false),
+		Close:      slack.NewTextBlockObject("plain_text", "Cancel", false, false),
+
+		// used to confirm creation in the originating channel
+		PrivateMetadata: channelID,
+
+		Blocks: slack.Blocks{BlockSet: []slack.Block{
+			slack.NewInputBlock(createAlertServiceBlockID,
+				slack.NewTextBlockObject("plain_text", "Service", false, false), nil, svcSelect),
+			slack.NewInputBlock(createAlertSummaryBlockID,
+				slack.NewTextBlockObject("plain_text", "Summary", false, false), nil, summary),
+			detailsBlock,
+		}},
+	}
+
+	return s.withClient(ctx, func(c *slack.Client) error {
+		_, err := c.OpenViewContext(ctx, triggerID, view)
+		if err != nil {
+			return fmt.Errorf("open create alert modal: %w", err)
+		}
+		return nil
+	})
+}
+
+// serveViewSubmission handles the submission of a modal, after the request has been validated by ServeMessageAction.
+func (s *ChannelSender) serveViewSubmission(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var payload slack.InteractionCallback
+	err := json.Unmarshal([]byte(req.FormValue("payload")), &payload)
+	if errutil.HTTPError(ctx, w, err) {
+		return
+	}
+
+	if payload.View.CallbackID != createAlertCallbackID {
+		errutil.HTTPError(ctx, w, validation.NewFieldErrorf("callback_id", "unknown callback ID '%s'", payload.View.CallbackID))
+		return
+	}
+
+	userCtx, err := s.userContext(ctx, payload.Team.ID, payload.User.ID)
+	if errutil.HTTPError(ctx, w, err) {
+		return
+	}
+	if userCtx == nil {
+		writeJSON(ctx, w, slack.NewErrorsViewSubmissionResponse(map[string]string{
+			createAlertServiceBlockID: "Your Slack account isn't linked to GoAlert.",
+		}))
+		return
+	}
+
+	var values map[string]map[string]slack.BlockAction
+	if payload.View.State != nil {
+		values = payload.View.State.Values
+	}
+	serviceID := values[createAlertServiceBlockID][createAlertServiceActionID].SelectedOption.Value
+	summary := values[createAlertSummaryBlockID][createAlertSummaryActionID].Value
+	details := values[createAlertDetailsBlockID][createAlertDetailsActionID].Value
+
+	a, err := s.createAlert(userCtx, serviceID, summary, details)
+	if err != nil {
+		writeJSON(ctx, w, slack.NewErrorsViewSubmissionResponse(map[string]string{
+			createAlertSummaryBlockID: commandErrorText(ctx, err),
+		}))
+		return
+	}
+
+	// close the modal
+	w.WriteHeader(http.StatusOK)
+
+	channelID := payload.View.PrivateMetadata
+	if channelID == "" {
+		return
+	}
+
+	err = s.withClient(ctx, func(c *slack.Client) error {
+		_, err := c.PostEphemeralContext(ctx, channelID, payload.User.ID,
+			slack.MsgOptionText(fmt.Sprintf("Created %s.", alertLink(ctx, a.ID, a.Summary)), false))
+		return err
+	})
+	if err != nil {
+		// the alert was already created, nothing else to do
+		log.Log(ctx, fmt.Errorf("post alert created message: %w", err))
+	}
+}
+
+// ServeMenuOptions handles requests for select menu options (e.g., the service picker in the create alert modal).
+func (s *ChannelSender) ServeMenuOptions(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	cfg := config.FromContext(ctx)
+
+	if !cfg.Slack.InteractiveMessages {
+		http.Error(w, "not enabled", http.StatusNotFound)
+		return
+	}
+
+	err := validateRequestSignature(time.Now(), req)
+	if errutil.HTTPError(ctx, w, err) {
+		return
+	}
+
+	var payload slack.InteractionCallback
+	err = json.Unmarshal([]byte(req.FormValue("payload")), &payload)
+	if errutil.HTTPError(ctx, w, err) {
+		return
+	}
+
+	if payload.ActionID != createAlertServiceActionID {
+		errutil.HTTPError(ctx, w, validation.NewFieldErrorf("action_id", "unknown action ID '%s'", payload.ActionID))
+		return
+	}
+
+	var res slack.OptionsResponse
+	userCtx, err := s.userContext(ctx, payload.Team.ID, payload.User.ID)
+	if errutil.HTTPError(ctx, w, err) {
+		return
+	}
+	if userCtx == nil {
+		// no options for unlinked users
+		writeJSON(ctx, w, res)
+		return
+	}
+
+	svcs, err := s.cfg.ServiceStore.Search(userCtx, &service.SearchOptions{
+		Search:          payload.Value,
+		FavoritesUserID: permission.UserID(userCtx),
+		FavoritesFirst:  true,
+
+		// Slack allows up to 100 options
+		Limit: 100,
+	})
+	if errutil.HTTPError(ctx, w, err) {
+		return
+	}
+
+	res.Options = make([]*slack.OptionBlockObject, 0, len(svcs))
+	for _, svc := range svcs {
+		res.Options = append(res.Options, serviceOption(svc))
+	}
+
+	writeJSON(ctx, w, res)
+}
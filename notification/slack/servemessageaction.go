@@ -2,6 +2,7 @@ package slack
 
 import (
 	"bytes"
+	"context"
 	"crypto/hmac"
 	"encoding/json"
 	"errors"
@@ -60,6 +61,51 @@ func validateRequestSignature(now time.Time, req *http.Request) error {
 	return nil
 }
 
+// authLinkURL will return a URL for the Slack user to link their account with GoAlert.
+//
+// An empty string is returned if a link can't be generated; errors are logged.
+func (s *ChannelSender) authLinkURL(ctx context.Context, teamID, userID, userName, teamDomain string, meta authlink.Metadata) string {
+	if userID == "" || teamID == "" {
+		// missing data, don't allow linking
+		log.Log(ctx, errors.New("slack payload missing required data"))
+		return ""
+	}
+
+	meta.UserDetails = fmt.Sprintf("Slack user %s from %s.slack.com", userName, teamDomain)
+	linkURL, err := s.recv.AuthLinkURL(ctx, "slack:"+teamID, userID, meta)
+	if err != nil {
+		log.Log(ctx, err)
+		return ""
+	}
+
+	return linkURL
+}
+
+// linkAccountBlocks returns message blocks asking the user to link their Slack account with GoAlert.
+func linkAccountBlocks(linkURL string) []slack.Block {
+	var msg string
+	if linkURL == "" {
+		msg = "Your Slack account isn't currently linked to GoAlert, please try again later."
+	} else {
+		msg = "Please link your Slack account with GoAlert."
+	}
+	blocks := []slack.Block{
+		slack.NewSectionBlock(
+			slack.NewTextBlockObject("plain_text", msg, false, false),
+			nil, nil,
+		),
+	}
+
+	if linkURL != "" {
+		btn := slack.NewButtonBlockElement(linkActActionID, linkURL,
+			slack.NewTextBlockObject("plain_text", "Link Account", false, false))
+		btn.URL = linkURL
+		blocks = append(blocks, slack.NewActionBlock(alertResponseBlockID, btn))
+	}
+
+	return blocks
+}
+
 func (s *ChannelSender) ServeMessageAction(w http.ResponseWriter, req *http.Request) {
 	ctx := req.Context()
 	cfg := config.FromContext(ctx)
@@ -102,6 +148,11 @@ func (s *ChannelSender) ServeMessageAction(w http.ResponseWriter, req *http.Requ
 		return
 	}
 
+	if payload.Type == string(slack.InteractionTypeViewSubmission) {
+		s.serveViewSubmission(w, req)
+		return
+	}
+
 	if len(payload.Actions) != 1 {
 		errutil.HTTPError(ctx, w, validation.NewFieldError("payload", "invalid payload"))
 		return
@@ -119,6 +170,8 @@ func (s *ChannelSender) ServeMessageAction(w http.ResponseWriter, req *http.Requ
 		res = notification.ResultAcknowledge
 	case alertCloseActionID:
 		res = notification.ResultResolve
+	case alertSnoozeActionID:
+		res = notification.ResultSnooze
 	case linkActActionID:
 		err = s.withClient(ctx, func(c *slack.Client) error {
 			// remove ephemeral 'Link Account' button
@@ -144,54 +197,23 @@ func (s *ChannelSender) ServeMessageAction(w http.ResponseWriter, req *http.Requ
 	err = s.recv.ReceiveSubject(ctx, "slack:"+payload.Team.ID, payload.User.ID, act.Value, res)
 
 	if errors.As(err, &e) {
-		var linkURL string
 		teamID := payload.User.TeamID
 		if teamID == "" {
 			teamID = payload.Team.ID
 		}
-		switch {
-		case payload.User.ID == "", teamID == "":
-			// missing data, don't allow linking
-			log.Log(ctx, errors.New("slack payload missing required data"))
-		default:
-			name := payload.User.Username
-			if name == "" {
-				name = payload.User.Name
-			}
-			linkURL, err = s.recv.AuthLinkURL(ctx, "slack:"+teamID, payload.User.ID, authlink.Metadata{
-				UserDetails: fmt.Sprintf("Slack user %s from %s.slack.com", name, payload.Team.Domain),
-				AlertID:     e.AlertID,
-				AlertAction: res.String(),
-			})
-			if err != nil {
-				log.Log(ctx, err)
-			}
+		name := payload.User.Username
+		if name == "" {
+			name = payload.User.Name
 		}
+		linkURL := s.authLinkURL(ctx, teamID, payload.User.ID, name, payload.Team.Domain, authlink.Metadata{
+			AlertID:     e.AlertID,
+			AlertAction: res.String(),
+		})
 
 		err = s.withClient(ctx, func(c *slack.Client) error {
-			var msg string
-			if linkURL == "" {
-				msg = "Your Slack account isn't currently linked to GoAlert, please try again later."
-			} else {
-				msg = "Please link your Slack account with GoAlert."
-			}
-			blocks := []slack.Block{
-				slack.NewSectionBlock(
-					slack.NewTextBlockObject("plain_text", msg, false, false),
-					nil, nil,
-				),
-			}
-
-			if linkURL != "" {
-				btn := slack.NewButtonBlockElement(linkActActionID, linkURL,
-					slack.NewTextBlockObject("plain_text", "Link Account", false, false))
-				btn.URL = linkURL
-				blocks = append(blocks, slack.NewActionBlock(alertResponseBlockID, btn))
-			}
-
 			_, err = c.PostEphemeralContext(ctx, payload.Channel.ID, payload.User.ID,
 				slack.MsgOptionResponseURL(payload.ResponseURL, "ephemeral"),
-				slack.MsgOptionBlocks(blocks...),
+				slack.MsgOptionBlocks(linkAccountBlocks(linkURL)...),
 			)
 			if err != nil {
 				return err
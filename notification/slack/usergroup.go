@@ -3,6 +3,7 @@ package slack
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/slack-go/slack"
 	"github.com/target/goalert/notification/nfydest"
@@ -115,3 +116,44 @@ func (s *ChannelSender) ListUserGroups(ctx context.Context) ([]UserGroup, error)
 
 	return res, nil
 }
+
+// UserGroupMembers returns the Slack user IDs of the current members of a user group.
+func (s *ChannelSender) UserGroupMembers(ctx context.Context, id string) ([]string, error) {
+	err := permission.LimitCheckAny(ctx, permission.System)
+	if err != nil {
+		return nil, err
+	}
+
+	var members []string
+	err = s.withClient(ctx, func(c *slack.Client) error {
+		members, err = c.GetUserGroupMembersContext(ctx, id)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get user group members '%s': %w", id, err)
+	}
+
+	return members, nil
+}
+
+// SetUserGroupMembers replaces the members of a user group with the provided Slack user IDs.
+//
+// Slack does not allow a user group to have no members, so at least one user ID must be provided.
+func (s *ChannelSender) SetUserGroupMembers(ctx context.Context, id string, slackUserIDs []string) error {
+	err := permission.LimitCheckAny(ctx, permission.System)
+	if err != nil {
+		return err
+	}
+	if len(slackUserIDs) == 0 {
+		return validation.NewFieldError("UserIDs", "at least one member is required")
+	}
+
+	return s.withClient(ctx, func(c *slack.Client) error {
+		_, err := c.UpdateUserGroupMembersContext(ctx, id, strings.Join(slackUserIDs, ","))
+		if err != nil {
+			return fmt.Errorf("update user group '%s': %w", id, err)
+		}
+
+		return nil
+	})
+}
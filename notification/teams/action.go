@@ -0,0 +1,36 @@
+package teams
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/target/goalert/config"
+)
+
+type actionType string
+
+// Action types are passed to the alert details page as the `action` URL parameter.
+const (
+	actionAck   actionType = "ack"
+	actionClose actionType = "close"
+)
+
+func (a actionType) Title() string {
+	switch a {
+	case actionAck:
+		return "Acknowledge"
+	case actionClose:
+		return "Close"
+	}
+	return string(a)
+}
+
+// actionURL returns a link to the alert details page that will prompt the user to confirm the action.
+//
+// Webhook-posted cards can't call back to GoAlert, and session cookies are not sent on
+// cross-site navigation, so the action itself is performed by the UI after confirmation.
+func actionURL(ctx context.Context, alertID int, act actionType) string {
+	cfg := config.FromContext(ctx)
+	return cfg.CallbackURL(fmt.Sprintf("/alerts/%d", alertID), url.Values{"action": {string(act)}})
+}
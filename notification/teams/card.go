@@ -0,0 +1,151 @@
+package teams
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/target/goalert/config"
+	"github.com/target/goalert/notification"
+	"github.com/target/goalert/notification/nfychat"
+)
+
+// maxDetailsLength is the maximum length of alert details included in a card; Teams limits the total message size to 28KB.
+const maxDetailsLength = 2000
+
+// card is an Adaptive Card, limited to the elements GoAlert uses.
+//
+// https://adaptivecards.io/explorer/
+type card struct {
+	Body    []any    `json:"body"`
+	Actions []action `json:"actions,omitempty"`
+}
+
+type textBlock struct {
+	Type     string `json:"type"`
+	Text     string `json:"text"`
+	Size     string `json:"size,omitempty"`
+	Weight   string `json:"weight,omitempty"`
+	Color    string `json:"color,omitempty"`
+	IsSubtle bool   `json:"isSubtle,omitempty"`
+	Wrap     bool   `json:"wrap"`
+}
+
+type fact struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+}
+
+type factSet struct {
+	Type  string `json:"type"`
+	Facts []fact `json:"facts"`
+}
+
+type action struct {
+	Type  string `json:"type"`
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
+func text(s string) textBlock { return textBlock{Type: "TextBlock", Text: s, Wrap: true} }
+
+func openURL(title, url string) action {
+	return action{Type: "Action.OpenUrl", Title: title, URL: url}
+}
+
+// message returns the webhook payload for the card.
+func (c *card) message() any {
+	type content struct {
+		Schema  string `json:"$schema"`
+		Type    string `json:"type"`
+		Version string `json:"version"`
+		card
+		MSTeams struct {
+			Width string `json:"width"`
+		} `json:"msteams"`
+	}
+	type attachment struct {
+		ContentType string  `json:"contentType"`
+		ContentURL  *string `json:"contentUrl"`
+		Content     content `json:"content"`
+	}
+
+	cont := content{
+		Schema:  "http://adaptivecards.io/schemas/adaptive-card.json",
+		Type:    "AdaptiveCard",
+		Version: "1.4",
+		card:    *c,
+	}
+	cont.MSTeams.Width = "Full"
+
+	return struct {
+		Type        string       `json:"type"`
+		Attachments []attachment `json:"attachments"`
+	}{
+		Type: "message",
+		Attachments: []attachment{{
+			ContentType: "application/vnd.microsoft.card.adaptive",
+			Content:     cont,
+		}},
+	}
+}
+
+// escape prevents text from being interpreted as markdown.
+var escape = nfychat.Escaper("\\*_[]`")
+
+func link(text, url string) string { return fmt.Sprintf("[%s](%s)", escape(text), url) }
+
+func stateColor(state notification.AlertState) string {
+	switch state {
+	case notification.AlertStateUnacknowledged:
+		return "Attention"
+	case notification.AlertStateAcknowledged:
+		return "Warning"
+	case notification.AlertStateClosed:
+		return "Good"
+	}
+	return "Default"
+}
+
+// alertCard renders an alert notification or status update.
+func alertCard(ctx context.Context, id int, summary, details, serviceName, logEntry string, state notification.AlertState) *card {
+	cfg := config.FromContext(ctx)
+	alertURL := cfg.CallbackURL(fmt.Sprintf("/alerts/%d", id))
+
+	title := text(link(fmt.Sprintf("Alert #%d: %s", id, summary), alertURL))
+	title.Size = "Medium"
+	title.Weight = "Bolder"
+	title.Color = stateColor(state)
+
+	c := &card{Body: []any{title}}
+	if details != "" {
+		c.Body = append(c.Body, text(nfychat.Truncate(details, maxDetailsLength)))
+	}
+	if serviceName != "" {
+		c.Body = append(c.Body, factSet{Type: "FactSet", Facts: []fact{{Title: "Service", Value: serviceName}}})
+	}
+
+	status := text(logEntry)
+	status.IsSubtle = true
+	c.Body = append(c.Body, status)
+	c.Actions = append(c.Actions, openURL("Open in GoAlert", alertURL))
+
+	return c
+}
+
+func bundleCard(ctx context.Context, msg notification.AlertBundle) *card {
+	cfg := config.FromContext(ctx)
+	u := cfg.CallbackURL("/services/" + msg.ServiceID + "/alerts")
+
+	return &card{
+		Body:    []any{text(fmt.Sprintf("Service '%s' has %d unacknowledged alerts.", escape(msg.ServiceName), msg.Count))},
+		Actions: []action{openURL("View Alerts", u)},
+	}
+}
+
+func onCallCard(msg notification.ScheduleOnCallUsers) *card {
+	return textCard(nfychat.OnCallText(msg, link))
+}
+
+func textCard(s string) *card {
+	return &card{Body: []any{text(s)}}
+}
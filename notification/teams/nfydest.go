@@ -0,0 +1,89 @@
+package teams
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/target/goalert/config"
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/notification/nfydest"
+	"github.com/target/goalert/validation"
+	"github.com/target/goalert/validation/validate"
+)
+
+const (
+	DestTypeTeamsChannel = "builtin-teams-channel"
+	FieldWebhookURL      = "teams_webhook_url"
+	ParamMessage         = "message"
+	FallbackIconURL      = "builtin://teams"
+)
+
+func NewChannelDest(webhookURL string) gadb.DestV1 {
+	return gadb.NewDestV1(DestTypeTeamsChannel, FieldWebhookURL, webhookURL)
+}
+
+var _ nfydest.Provider = (*Sender)(nil)
+
+func (s *Sender) ID() string { return DestTypeTeamsChannel }
+func (s *Sender) TypeInfo(ctx context.Context) (*nfydest.TypeInfo, error) {
+	cfg := config.FromContext(ctx)
+	return &nfydest.TypeInfo{
+		Type:                       DestTypeTeamsChannel,
+		Name:                       "Microsoft Teams Channel",
+		Enabled:                    cfg.Teams.Enable,
+		SupportsAlertNotifications: true,
+		SupportsStatusUpdates:      true,
+		SupportsOnCallNotify:       true,
+		SupportsSignals:            true,
+		RequiredFields: []nfydest.FieldConfig{{
+			FieldID:         FieldWebhookURL,
+			Label:           "Webhook URL",
+			PlaceholderText: "https://example.webhook.office.com/...",
+			InputType:       "url",
+			Hint:            "Create one with the 'Post to a channel when a webhook request is received' Workflows template in Teams.",
+		}},
+		DynamicParams: []nfydest.DynamicParamConfig{{
+			ParamID: ParamMessage,
+			Label:   "Message",
+			Hint:    "The text of the message to send.",
+		}},
+	}, nil
+}
+
+func (s *Sender) ValidateField(ctx context.Context, fieldID, value string) error {
+	switch fieldID {
+	case FieldWebhookURL:
+		err := validate.AbsoluteURL(FieldWebhookURL, value)
+		if err != nil {
+			return err
+		}
+		u, _ := url.Parse(value) // already validated
+		if u.Scheme != "https" {
+			return validation.NewGenericError("must be an https URL")
+		}
+		if !config.FromContext(ctx).ValidWebhookURL(value) {
+			return validation.NewGenericError("url is not allowed by administrator")
+		}
+
+		return nil
+	}
+
+	return validation.NewGenericError("unknown field ID")
+}
+
+func (s *Sender) DisplayInfo(ctx context.Context, args map[string]string) (*nfydest.DisplayInfo, error) {
+	if args == nil {
+		args = make(map[string]string)
+	}
+
+	u, err := url.Parse(args[FieldWebhookURL])
+	if err != nil {
+		return nil, validation.WrapError(err)
+	}
+
+	return &nfydest.DisplayInfo{
+		IconURL:     FallbackIconURL,
+		IconAltText: "Microsoft Teams",
+		Text:        u.Hostname(),
+	}, nil
+}
@@ -0,0 +1,79 @@
+// Package teams sends notifications to Microsoft Teams channels.
+//
+// Messages are posted as Adaptive Cards to a channel's incoming webhook, created with the
+// "Post to a channel when a webhook request is received" Workflows template.
+package teams
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/target/goalert/config"
+	"github.com/target/goalert/notification"
+	"github.com/target/goalert/notification/nfychat"
+	"github.com/target/goalert/notification/nfydest"
+)
+
+// Sender sends notifications to Teams channels.
+type Sender struct {
+	client *http.Client
+}
+
+var _ nfydest.MessageSender = (*Sender)(nil)
+
+// NewSender creates a new Sender.
+func NewSender(ctx context.Context, client *http.Client) *Sender {
+	return &Sender{client: client}
+}
+
+// SendMessage will post the message to the destination channel.
+func (s *Sender) SendMessage(ctx context.Context, msg notification.Message) (*notification.SentMessage, error) {
+	var c *card
+	switch t := msg.(type) {
+	case notification.Alert:
+		c = alertCard(ctx, t.AlertID, t.Summary, t.Details, t.ServiceName, "Unacknowledged", notification.AlertStateUnacknowledged)
+		s.addAlertActions(ctx, c, t.AlertID, notification.AlertStateUnacknowledged)
+	case notification.AlertStatus:
+		c = alertCard(ctx, t.AlertID, t.Summary, "", t.ServiceName, t.LogEntry, t.NewAlertState)
+		s.addAlertActions(ctx, c, t.AlertID, t.NewAlertState)
+	case notification.AlertBundle:
+		c = bundleCard(ctx, t)
+	case notification.ScheduleOnCallUsers:
+		c = onCallCard(t)
+	case notification.SignalMessage:
+		c = textCard(t.Param(ParamMessage))
+	default:
+		return nil, fmt.Errorf("unsupported message type: %T", t)
+	}
+
+	data, err := json.Marshal(c.message())
+	if err != nil {
+		return nil, err
+	}
+
+	// Workflows webhooks return 202 Accepted, so we can't know if the message was posted.
+	res, _, err := nfychat.Send(ctx, s.client, nfychat.Request{URL: msg.DestArg(FieldWebhookURL), Body: data, Webhook: true})
+	return res, err
+}
+
+// addAlertActions adds the interactive buttons for the alert state, if enabled.
+func (s *Sender) addAlertActions(ctx context.Context, c *card, alertID int, state notification.AlertState) {
+	cfg := config.FromContext(ctx)
+	if !cfg.Teams.InteractiveMessages {
+		return
+	}
+
+	var acts []actionType
+	switch state {
+	case notification.AlertStateUnacknowledged:
+		acts = []actionType{actionAck, actionClose}
+	case notification.AlertStateAcknowledged:
+		acts = []actionType{actionClose}
+	}
+
+	for _, act := range acts {
+		c.Actions = append(c.Actions, openURL(act.Title(), actionURL(ctx, alertID, act)))
+	}
+}
@@ -0,0 +1,94 @@
+package teams
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/config"
+	"github.com/target/goalert/notification"
+	"github.com/target/goalert/notification/nfymsg"
+)
+
+func TestSender_SendMessage(t *testing.T) {
+	var status int
+	var gotBody []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		gotBody, _ = io.ReadAll(req.Body)
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+
+	var cfg config.Config
+	cfg.General.PublicURL = "https://goalert.example.com"
+	ctx := cfg.Context(context.Background())
+
+	s := NewSender(ctx, srv.Client())
+	msg := notification.Alert{
+		Base:        nfymsg.Base{Dest: NewChannelDest(srv.URL)},
+		AlertID:     123,
+		Summary:     "disk *full*",
+		Details:     "details",
+		ServiceName: "svc",
+	}
+
+	status = http.StatusAccepted
+	res, err := s.SendMessage(ctx, msg)
+	require.NoError(t, err)
+	assert.Equal(t, notification.StateSent, res.State)
+
+	var body struct {
+		Type        string
+		Attachments []struct {
+			ContentType string
+			Content     struct {
+				Type    string
+				Body    []map[string]any
+				Actions []action
+			}
+		}
+	}
+	require.NoError(t, json.Unmarshal(gotBody, &body))
+	assert.Equal(t, "message", body.Type)
+	require.Len(t, body.Attachments, 1)
+	att := body.Attachments[0]
+	assert.Equal(t, "application/vnd.microsoft.card.adaptive", att.ContentType)
+	assert.Equal(t, "AdaptiveCard", att.Content.Type)
+	assert.Equal(t, `[Alert #123: disk \*full\*](https://goalert.example.com/alerts/123)`, att.Content.Body[0]["text"])
+	assert.Equal(t, []action{openURL("Open in GoAlert", "https://goalert.example.com/alerts/123")}, att.Content.Actions, "no buttons unless interactive")
+}
+
+func TestSender_ValidateField(t *testing.T) {
+	var s Sender
+	var cfg config.Config
+	cfg.Webhook.AllowedURLs = []string{"https://example.webhook.office.com/"}
+	ctx := cfg.Context(context.Background())
+
+	assert.NoError(t, s.ValidateField(ctx, FieldWebhookURL, "https://example.webhook.office.com/workflows/abc"))
+	assert.Error(t, s.ValidateField(ctx, FieldWebhookURL, "http://example.webhook.office.com/workflows/abc"), "must be https")
+	assert.Error(t, s.ValidateField(ctx, FieldWebhookURL, "https://internal.example.com/workflows/abc"), "must match the allowed URLs")
+}
+
+func TestSender_AlertActions(t *testing.T) {
+	var cfg config.Config
+	cfg.General.PublicURL = "https://goalert.example.com"
+	cfg.Teams.InteractiveMessages = true
+	ctx := cfg.Context(context.Background())
+
+	var s Sender
+	c := &card{}
+	s.addAlertActions(ctx, c, 123, notification.AlertStateUnacknowledged)
+	assert.Equal(t, []action{
+		openURL("Acknowledge", "https://goalert.example.com/alerts/123?action=ack"),
+		openURL("Close", "https://goalert.example.com/alerts/123?action=close"),
+	}, c.Actions, "buttons should open the alert page to confirm the action")
+
+	c = &card{}
+	s.addAlertActions(ctx, c, 123, notification.AlertStateClosed)
+	assert.Empty(t, c.Actions)
+}
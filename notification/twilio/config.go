@@ -4,6 +4,10 @@ import (
 	"database/sql"
 	"net/http"
 
+	"github.com/target/goalert/alert"
+	"github.com/target/goalert/oncall"
+	"github.com/target/goalert/service"
+	"github.com/target/goalert/user"
 	"github.com/target/goalert/user/contactmethod"
 )
 
@@ -28,4 +32,10 @@ type Config struct {
 
 	// DB is used for storing DB connection data (needed for carrier metadata dbtx).
 	DB *sql.DB
+
+	// UserStore, AlertStore, ServiceStore, and OnCallStore are used to handle SMS commands (e.g., `oncall`).
+	UserStore    *user.Store
+	AlertStore   *alert.Store
+	ServiceStore *service.Store
+	OnCallStore  *oncall.Store
 }
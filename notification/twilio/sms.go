@@ -26,13 +26,27 @@ import (
 )
 
 var (
-	lastReplyRx  = regexp.MustCompile(`^'?\s*(c|close|a|e|ack[a-z]*)\s*'?$`)
-	shortReplyRx = regexp.MustCompile(`^'?\s*([0-9]+)\s*(c|a|e)\s*'?$`)
-	alertReplyRx = regexp.MustCompile(`^'?\s*(c|close|e|a|ack[a-z]*)\s*#?\s*([0-9]+)\s*'?$`)
+	lastReplyRx  = regexp.MustCompile(`^'?\s*(c|close|a|e|esc|escalate|s|snooze|ack[a-z]*)\s*'?$`)
+	shortReplyRx = regexp.MustCompile(`^'?\s*([0-9]+)\s*(c|a|e|s)\s*'?$`)
+	alertReplyRx = regexp.MustCompile(`^'?\s*(c|close|e|esc|escalate|s|snooze|a|ack[a-z]*)\s*#?\s*([0-9]+)\s*'?$`)
 
 	svcReplyRx = regexp.MustCompile(`^'?\s*([0-9]+)\s*(cc|aa)\s*'?$`)
 )
 
+// replyResult returns the notification.Result for a matched reply action (e.g., "a", "ack", "c").
+func replyResult(action string) notification.Result {
+	switch {
+	case strings.HasPrefix(action, "a"):
+		return notification.ResultAcknowledge
+	case strings.HasPrefix(action, "e"):
+		return notification.ResultEscalate
+	case strings.HasPrefix(action, "s"):
+		return notification.ResultSnooze
+	}
+
+	return notification.ResultResolve
+}
+
 func NewSMSDest(number string) gadb.DestV1 {
 	return gadb.NewDestV1(DestTypeTwilioSMS, FieldPhoneNumber, number)
 }
@@ -299,26 +313,25 @@ func (s *SMS) ServeMessage(w http.ResponseWriter, req *http.Request) {
 
 	body = strings.TrimSpace(body)
 	body = strings.ToLower(body)
+
+	cmd, err := parseSMSCommand(body)
+	if err != nil {
+		respond(true, smsErrorText(ctx, err))
+		return
+	}
+	if cmd != nil {
+		respond(s.runCommand(ctx, cfg.ApplicationName(), from, cmd))
+		return
+	}
+
 	var lookupFn func() (*codeInfo, error)
 	var result notification.Result
 	var isSvc bool
 	if m := lastReplyRx.FindStringSubmatch(body); len(m) == 2 {
-		if strings.HasPrefix(m[1], "a") {
-			result = notification.ResultAcknowledge
-		} else if strings.HasPrefix(m[1], "e") {
-			result = notification.ResultEscalate
-		} else {
-			result = notification.ResultResolve
-		}
+		result = replyResult(m[1])
 		lookupFn = func() (*codeInfo, error) { return s.b.LookupByCode(ctx, from, 0) }
 	} else if m := shortReplyRx.FindStringSubmatch(body); len(m) == 3 {
-		if strings.HasPrefix(m[2], "a") {
-			result = notification.ResultAcknowledge
-		} else if strings.HasPrefix(m[2], "e") {
-			result = notification.ResultEscalate
-		} else {
-			result = notification.ResultResolve
-		}
+		result = replyResult(m[2])
 		code, err := strconv.Atoi(m[1])
 		if err != nil {
 			log.Debug(ctx, errors.Wrap(err, "parse code"))
@@ -327,13 +340,7 @@ func (s *SMS) ServeMessage(w http.ResponseWriter, req *http.Request) {
 			lookupFn = func() (*codeInfo, error) { return s.b.LookupByCode(ctx, from, code) }
 		}
 	} else if m := alertReplyRx.FindStringSubmatch(body); len(m) == 3 {
-		if strings.HasPrefix(m[1], "a") {
-			result = notification.ResultAcknowledge
-		} else if strings.HasPrefix(m[1], "e") {
-			result = notification.ResultEscalate
-		} else {
-			result = notification.ResultResolve
-		}
+		result = replyResult(m[1])
 		alertID, err := strconv.Atoi(m[2])
 		if err != nil {
 			log.Debug(ctx, errors.Wrap(err, "parse alertID"))
@@ -343,13 +350,7 @@ func (s *SMS) ServeMessage(w http.ResponseWriter, req *http.Request) {
 		}
 	} else if m := svcReplyRx.FindStringSubmatch(body); len(m) == 3 {
 		isSvc = true
-		if strings.HasPrefix(m[2], "a") {
-			result = notification.ResultAcknowledge
-		} else if strings.HasPrefix(m[2], "e") {
-			result = notification.ResultEscalate
-		} else {
-			result = notification.ResultResolve
-		}
+		result = replyResult(m[2])
 		code, err := strconv.Atoi(m[1])
 		if err != nil {
 			log.Debug(ctx, errors.Wrap(err, "parse code"))
@@ -360,7 +361,7 @@ func (s *SMS) ServeMessage(w http.ResponseWriter, req *http.Request) {
 	}
 
 	if lookupFn == nil {
-		respond(true, "Sorry, but that isn't a request GoAlert understood. Visit the Web UI for more information. To unsubscribe, reply with STOP.")
+		respond(true, "Sorry, but that isn't a request GoAlert understood. Reply HELP for a list of commands. To unsubscribe, reply with STOP.")
 		ctx = log.WithField(ctx, "SMSBody", body)
 		log.Debug(ctx, errors.Wrap(err, "parse alert action"))
 		return
@@ -372,6 +373,8 @@ func (s *SMS) ServeMessage(w http.ResponseWriter, req *http.Request) {
 		prefix = "Acknowledged"
 	case notification.ResultEscalate:
 		prefix = "Escalation requested"
+	case notification.ResultSnooze:
+		prefix = "Snoozed"
 	default:
 		prefix = "Closed"
 	}
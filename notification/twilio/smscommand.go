@@ -0,0 +1,272 @@
+package twilio
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/target/goalert/alert"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/service"
+	"github.com/target/goalert/user/contactmethod"
+	"github.com/target/goalert/util/log"
+	"github.com/target/goalert/validation"
+)
+
+// maxPauseDuration is the longest a contact method can be paused via SMS.
+const maxPauseDuration = 7 * 24 * time.Hour
+
+// maxOnCallServices is the maximum number of services listed in a reply to the `oncall` command.
+const maxOnCallServices = 5
+
+var (
+	helpRx      = regexp.MustCompile(`^'?\s*(help|commands|\?)\s*'?$`)
+	onCallRx    = regexp.MustCompile(`^'?\s*on-?call\s*'?$`)
+	snoozeForRx = regexp.MustCompile(`^'?\s*(?:s|snooze)\s*#?\s*([0-9]+)\s+(?:for\s+)?([0-9a-z]+)\s*'?$`)
+	pauseRx     = regexp.MustCompile(`^'?\s*(?:stop|pause)\s+(?:for\s+)?([0-9a-z]+)\s*'?$`)
+)
+
+type smsCommandType int
+
+const (
+	smsCommandHelp smsCommandType = iota + 1
+	smsCommandOnCall
+	smsCommandSnooze
+	smsCommandPause
+)
+
+// smsCommand is a parsed SMS command that is not a reply to a specific notification (e.g., `oncall`).
+type smsCommand struct {
+	Type     smsCommandType
+	AlertID  int
+	Duration time.Duration
+}
+
+// parseSMSDuration parses a duration like `30m`, `2h`, `1d`, or `1h30m`; a plain number is treated as minutes.
+func parseSMSDuration(s string) (time.Duration, error) {
+	var dur time.Duration
+	if n, err := strconv.Atoi(s); err == nil {
+		dur = time.Duration(n) * time.Minute
+	} else if n, err := strconv.Atoi(strings.TrimSuffix(s, "d")); err == nil && strings.HasSuffix(s, "d") {
+		dur = time.Duration(n) * 24 * time.Hour
+	} else if dur, err = time.ParseDuration(s); err != nil {
+		return 0, validation.NewFieldError("Duration", fmt.Sprintf("invalid duration '%s', try 30m, 2h, or 1d", s))
+	}
+
+	if dur < time.Minute {
+		return 0, validation.NewFieldError("Duration", "must be at least 1 minute")
+	}
+
+	return dur, nil
+}
+
+// parseSMSCommand parses a lower-case, trimmed SMS body. If the body is not a command, nil is returned.
+func parseSMSCommand(body string) (*smsCommand, error) {
+	if helpRx.MatchString(body) {
+		return &smsCommand{Type: smsCommandHelp}, nil
+	}
+	if onCallRx.MatchString(body) {
+		return &smsCommand{Type: smsCommandOnCall}, nil
+	}
+	if m := snoozeForRx.FindStringSubmatch(body); len(m) == 3 {
+		alertID, err := strconv.Atoi(m[1])
+		if err != nil {
+			return nil, validation.NewFieldError("AlertID", "invalid alert ID")
+		}
+		dur, err := parseSMSDuration(m[2])
+		if err != nil {
+			return nil, err
+		}
+		return &smsCommand{Type: smsCommandSnooze, AlertID: alertID, Duration: dur}, nil
+	}
+	if m := pauseRx.FindStringSubmatch(body); len(m) == 2 {
+		dur, err := parseSMSDuration(m[1])
+		if err != nil {
+			return nil, err
+		}
+		if dur > maxPauseDuration {
+			return nil, validation.NewFieldError("Duration", "cannot pause notifications for more than 7 days")
+		}
+		return &smsCommand{Type: smsCommandPause, Duration: dur}, nil
+	}
+
+	return nil, nil
+}
+
+// durationText returns a short, human-readable representation of a duration (e.g., "2 hours").
+func durationText(dur time.Duration) string {
+	plural := func(n int, unit string) string {
+		if n == 1 {
+			return "1 " + unit
+		}
+		return fmt.Sprintf("%d %ss", n, unit)
+	}
+
+	switch {
+	case dur%(24*time.Hour) == 0:
+		return plural(int(dur/(24*time.Hour)), "day")
+	case dur%time.Hour == 0:
+		return plural(int(dur/time.Hour), "hour")
+	}
+
+	return plural(int(dur/time.Minute), "minute")
+}
+
+func smsHelpText(appName string) string {
+	return appName + " commands: '<id>a' ack, '<id>c' close, 'esc <id>' escalate, " +
+		"'snooze <id> 30m', 'oncall' for your favorite services, 'stop 2h' to pause alerts, 'start' to resume."
+}
+
+// smsErrorText returns the reply text for an error while processing a command.
+func smsErrorText(ctx context.Context, err error) string {
+	switch {
+	case permission.IsPermissionError(err):
+		return "Error: you do not have permission to do that."
+	case validation.IsClientError(err):
+		return "Error: " + err.Error()
+	}
+
+	log.Log(ctx, err)
+	return "System error. Visit the dashboard to manage alerts."
+}
+
+// userContext returns a context authorized as the owner of the contact method for the given number.
+func (s *SMS) userContext(ctx context.Context, number string) (context.Context, *contactmethod.ContactMethod, error) {
+	var cm *contactmethod.ContactMethod
+	var role permission.Role
+	var err error
+	permission.SudoContext(ctx, func(ctx context.Context) {
+		cm, err = s.c.CMStore.FindOneByDest(ctx, s.c.DB, NewSMSDest(number))
+		if err != nil {
+			return
+		}
+
+		usr, uErr := s.c.UserStore.FindOne(ctx, cm.UserID)
+		if uErr != nil {
+			err = fmt.Errorf("lookup user: %w", uErr)
+			return
+		}
+		role = usr.Role
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return permission.UserSourceContext(ctx, cm.UserID, role, &permission.SourceInfo{
+		Type: permission.SourceTypeContactMethod,
+		ID:   cm.ID.String(),
+	}), cm, nil
+}
+
+// runCommand processes an SMS command, returning the reply text and if it was a passive (non-action) request.
+//
+// Passive replies count against the reply limit, so read-only commands must not reset it.
+func (s *SMS) runCommand(ctx context.Context, appName, from string, cmd *smsCommand) (isPassive bool, msg string) {
+	if cmd.Type == smsCommandHelp {
+		return true, smsHelpText(appName)
+	}
+
+	userCtx, cm, err := s.userContext(ctx, from)
+	if errors.Is(err, sql.ErrNoRows) {
+		return true, "Unknown number. Visit the dashboard to manage alerts."
+	}
+	if err != nil {
+		return true, smsErrorText(ctx, err)
+	}
+
+	switch cmd.Type {
+	case smsCommandOnCall:
+		// read-only, so it counts against the passive reply limit
+		isPassive = true
+		msg, err = s.onCallText(userCtx)
+	case smsCommandSnooze:
+		isPassive, msg, err = s.snooze(userCtx, from, cmd.AlertID, cmd.Duration)
+	case smsCommandPause:
+		err = s.c.CMStore.Pause(userCtx, s.c.DB, cm.ID, cmd.Duration)
+		msg = fmt.Sprintf("Alert notifications to this number are paused for %s. Reply START to resume.", durationText(cmd.Duration))
+	default:
+		err = fmt.Errorf("unknown SMS command type %d", cmd.Type)
+	}
+	if err != nil {
+		return true, smsErrorText(ctx, err)
+	}
+
+	return isPassive, msg
+}
+
+// snooze will snooze an alert, provided the number was previously sent a notification for it.
+//
+// isPassive is true if the alert was not snoozed.
+func (s *SMS) snooze(ctx context.Context, from string, alertID int, dur time.Duration) (isPassive bool, msg string, err error) {
+	_, err = s.b.LookupByAlertID(ctx, from, alertID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return true, "Unknown reply code for this action. Visit the dashboard to manage alerts.", nil
+	}
+	if err != nil {
+		return true, "", fmt.Errorf("lookup alert: %w", err)
+	}
+
+	err = s.c.AlertStore.Snooze(ctx, alertID, int(dur/time.Minute))
+	if alert.IsAlreadyClosed(err) {
+		return true, fmt.Sprintf("Alert #%d already closed", alertID), nil
+	}
+	if err != nil {
+		return true, "", err
+	}
+
+	return false, fmt.Sprintf("Snoozed alert #%d for %s", alertID, durationText(dur)), nil
+}
+
+// onCallText returns a summary of who is on call for the user's favorite services.
+func (s *SMS) onCallText(ctx context.Context) (string, error) {
+	svcs, err := s.c.ServiceStore.Search(ctx, &service.SearchOptions{
+		FavoritesUserID: permission.UserID(ctx),
+		FavoritesOnly:   true,
+		Limit:           maxOnCallServices + 1,
+	})
+	if err != nil {
+		return "", fmt.Errorf("search services: %w", err)
+	}
+	if len(svcs) == 0 {
+		return "You have no favorite services. Mark services as favorites in the dashboard to see who is on call.", nil
+	}
+
+	var more bool
+	if len(svcs) > maxOnCallServices {
+		svcs = svcs[:maxOnCallServices]
+		more = true
+	}
+
+	var lines []string
+	for _, svc := range svcs {
+		users, err := s.c.OnCallStore.OnCallUsersByService(ctx, svc.ID)
+		if err != nil {
+			return "", fmt.Errorf("lookup on-call users for service %s: %w", svc.ID, err)
+		}
+
+		var names []string
+		seen := make(map[string]bool)
+		for _, u := range users {
+			if seen[u.UserID] {
+				continue
+			}
+			seen[u.UserID] = true
+			names = append(names, u.UserName)
+		}
+		if len(names) == 0 {
+			names = append(names, "no one")
+		}
+
+		lines = append(lines, fmt.Sprintf("%s: %s", svc.Name, strings.Join(names, ", ")))
+	}
+	if more {
+		lines = append(lines, "(more favorites in the dashboard)")
+	}
+
+	return "On call:\n" + strings.Join(lines, "\n"), nil
+}
@@ -0,0 +1,56 @@
+package twilio
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSMSCommand(t *testing.T) {
+	check := func(body string, exp *smsCommand) {
+		t.Helper()
+		cmd, err := parseSMSCommand(body)
+		require.NoError(t, err, body)
+		assert.Equal(t, exp, cmd, body)
+	}
+	checkErr := func(body string) {
+		t.Helper()
+		_, err := parseSMSCommand(body)
+		assert.Error(t, err, body)
+	}
+
+	check("help", &smsCommand{Type: smsCommandHelp})
+	check("?", &smsCommand{Type: smsCommandHelp})
+	check("oncall", &smsCommand{Type: smsCommandOnCall})
+	check("'on-call'", &smsCommand{Type: smsCommandOnCall})
+	check("snooze 12 30m", &smsCommand{Type: smsCommandSnooze, AlertID: 12, Duration: 30 * time.Minute})
+	check("s #12 for 2h", &smsCommand{Type: smsCommandSnooze, AlertID: 12, Duration: 2 * time.Hour})
+	check("snooze 12 45", &smsCommand{Type: smsCommandSnooze, AlertID: 12, Duration: 45 * time.Minute})
+	check("stop 2h", &smsCommand{Type: smsCommandPause, Duration: 2 * time.Hour})
+	check("pause for 1d", &smsCommand{Type: smsCommandPause, Duration: 24 * time.Hour})
+
+	// handled elsewhere
+	check("stop", nil)
+	check("snooze 12", nil)
+	check("esc 12", nil)
+	check("12a", nil)
+
+	checkErr("snooze 12 soon")
+	checkErr("stop 30s")
+	checkErr("stop 8d")
+}
+
+func TestDurationText(t *testing.T) {
+	assert.Equal(t, "1 minute", durationText(time.Minute))
+	assert.Equal(t, "90 minutes", durationText(90*time.Minute))
+	assert.Equal(t, "2 hours", durationText(2*time.Hour))
+	assert.Equal(t, "1 day", durationText(24*time.Hour))
+}
+
+func TestReplyRx(t *testing.T) {
+	assert.Equal(t, []string{"esc 12", "esc", "12"}, alertReplyRx.FindStringSubmatch("esc 12"))
+	assert.Equal(t, []string{"escalate #12", "escalate", "12"}, alertReplyRx.FindStringSubmatch("escalate #12"))
+	assert.Equal(t, []string{"esc", "esc"}, lastReplyRx.FindStringSubmatch("esc"))
+}
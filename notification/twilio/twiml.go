@@ -20,6 +20,7 @@ type twiMLResponse struct {
 	gatherURL        string
 	redirectURL      string
 	redirectPauseSec int
+	dialNumber       string
 	hangup           bool
 
 	hasOptions     bool
@@ -63,6 +64,8 @@ const (
 	optionCloseAll
 	optionStop
 	optionRepeat
+	optionDetails
+	optionConference
 )
 
 func (t *twiMLResponse) AddOptions(options ...menuOption) {
@@ -79,6 +82,10 @@ func (t *twiMLResponse) AddOptions(options ...menuOption) {
 			t.Sayf("To disable voice notifications to this number, press %s.", digitStop)
 		case optionRepeat:
 			t.Sayf("To repeat this message, press %s.", sayRepeat)
+		case optionDetails:
+			t.Sayf("To hear the full alert details, press %s.", digitDetails)
+		case optionConference:
+			t.Sayf("To be connected to the conference line for this service, press %s.", digitConference)
 		case optionAck:
 			t.expectResponse = true
 			t.Sayf("To acknowledge, press %s.", digitAck)
@@ -109,6 +116,12 @@ func (t *twiMLResponse) Gather(url string) {
 	t.sendResponse()
 }
 
+// Dial will connect the call to the provided phone number.
+func (t *twiMLResponse) Dial(number string) {
+	t.dialNumber = number
+	t.sendResponse()
+}
+
 func (t *twiMLResponse) SayUnknownDigit() *twiMLResponse {
 	t.Say("Sorry, I didn't understand that.")
 	return t
@@ -155,6 +168,10 @@ type verbRedirect struct {
 	XMLName xml.Name `xml:"Redirect"`
 	URL     string   `xml:",chardata"`
 }
+type verbDial struct {
+	XMLName xml.Name `xml:"Dial"`
+	Number  string   `xml:",chardata"`
+}
 type verbHangup struct {
 	XMLName xml.Name `xml:"Hangup"`
 }
@@ -176,6 +193,9 @@ func (t *twiMLResponse) sendResponse() {
 		// if we give the user options, we need to allow them to respond
 		panic("Options without gather")
 	}
+	if t.dialNumber != "" && (t.gatherURL != "" || t.redirectURL != "") {
+		panic("Dial with gather or redirect")
+	}
 
 	var doc twimlResponse
 	for _, text := range t.say {
@@ -200,6 +220,10 @@ func (t *twiMLResponse) sendResponse() {
 		doc.Verbs = append(doc.Verbs, verbRedirect{URL: t.redirectURL})
 	}
 
+	if t.dialNumber != "" {
+		doc.Verbs = append(doc.Verbs, verbDial{Number: t.dialNumber})
+	}
+
 	if t.gatherURL != "" {
 		doc.Verbs = []any{verbGather{
 			Action:     t.gatherURL,
@@ -174,12 +174,102 @@ func TestTwiMLResponse(t *testing.T) {
 			<prosody rate="slow">Hello</prosody>
 		</Say>
 		<Say>
-			<prosody rate="slow">To escalate, press 5.</prosody>
+			<prosody rate="slow">To escalate, press 3.</prosody>
 		</Say>
 		<Say>
 			<prosody rate="slow">To repeat this message, press star.</prosody>
 		</Say>
 	</Gather>
+</Response>`, string(data))
+	})
+
+	t.Run("details and conference", func(t *testing.T) {
+		var mockConfig config.Config
+		ctx := mockConfig.Context(context.Background())
+		rec := httptest.NewRecorder()
+
+		r := newTwiMLResponse(ctx, rec)
+		r.Say("Hello")
+		r.AddOptions(optionDetails, optionConference)
+		r.Gather("http://example.com")
+
+		resp := rec.Result()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Contains(t, resp.Header.Get("Content-Type"), "application/xml")
+		data, err := io.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<Response>
+	<Gather numDigits="1" timeout="10" action="http://example.com">
+		<Say>
+			<prosody rate="slow">Hello</prosody>
+		</Say>
+		<Say>
+			<prosody rate="slow">To hear the full alert details, press 2.</prosody>
+		</Say>
+		<Say>
+			<prosody rate="slow">To be connected to the conference line for this service, press 0.</prosody>
+		</Say>
+		<Say>
+			<prosody rate="slow">If you are done, you may simply hang up.</prosody>
+		</Say>
+		<Say>
+			<prosody rate="slow">To repeat this message, press star.</prosody>
+		</Say>
+	</Gather>
+</Response>`, string(data))
+	})
+
+	t.Run("go back", func(t *testing.T) {
+		var mockConfig config.Config
+		ctx := mockConfig.Context(context.Background())
+		rec := httptest.NewRecorder()
+
+		r := newTwiMLResponse(ctx, rec)
+		r.Say("Details")
+		r.AddOptions(optionCancel)
+		r.Gather("http://example.com")
+
+		resp := rec.Result()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		data, err := io.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<Response>
+	<Gather numDigits="1" timeout="10" action="http://example.com">
+		<Say>
+			<prosody rate="slow">Details</prosody>
+		</Say>
+		<Say>
+			<prosody rate="slow">To go back to the previous menu, press 1.</prosody>
+		</Say>
+		<Say>
+			<prosody rate="slow">To repeat this message, press star.</prosody>
+		</Say>
+	</Gather>
+</Response>`, string(data))
+	})
+
+	t.Run("dial", func(t *testing.T) {
+		var mockConfig config.Config
+		ctx := mockConfig.Context(context.Background())
+		rec := httptest.NewRecorder()
+
+		r := newTwiMLResponse(ctx, rec)
+		r.Say("Connecting you to the conference line.")
+		r.Dial("+17633757777")
+
+		resp := rec.Result()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Contains(t, resp.Header.Get("Content-Type"), "application/xml")
+		data, err := io.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<Response>
+	<Say>
+		<prosody rate="slow">Connecting you to the conference line.</prosody>
+	</Say>
+	<Dial>+17633757777</Dial>
 </Response>`, string(data))
 	})
 }
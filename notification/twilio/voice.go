@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/url"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -21,6 +22,7 @@ import (
 	"github.com/target/goalert/notification/nfydest"
 	"github.com/target/goalert/permission"
 	"github.com/target/goalert/retry"
+	"github.com/target/goalert/service"
 	"github.com/target/goalert/util/log"
 	"github.com/target/goalert/validation"
 )
@@ -41,6 +43,7 @@ const (
 	// Supported call types.
 	CallTypeAlert       = CallType("alert")
 	CallTypeAlertStatus = CallType("alert-status")
+	CallTypeAlertDetail = CallType("alert-detail")
 	CallTypeTest        = CallType("test")
 	CallTypeVerify      = CallType("verify")
 	CallTypeStop        = CallType("stop")
@@ -54,8 +57,15 @@ const (
 	digitConfirm  = "3"
 	digitOldAck   = "8"
 	digitOldClose = "9"
-	digitEscalate = "5"
-	sayRepeat     = "star"
+	digitEscalate = "3"
+	digitDetails  = "2"
+
+	// digitOldEscalate is still accepted to escalate, as it was used before the menu was extended.
+	digitOldEscalate = "5"
+
+	// digitConference uses 0 since it is commonly associated with reaching an operator.
+	digitConference = "0"
+	sayRepeat       = "star"
 )
 
 var (
@@ -131,6 +141,8 @@ func (v *Voice) ServeCall(w http.ResponseWriter, req *http.Request) {
 		v.ServeAlert(w, req)
 	case CallTypeAlertStatus:
 		v.ServeAlertStatus(w, req)
+	case CallTypeAlertDetail:
+		v.ServeAlertDetail(w, req)
 	case CallTypeTest:
 		v.ServeTest(w, req)
 	case CallTypeStop:
@@ -563,7 +575,10 @@ func (v *Voice) ServeAlert(w http.ResponseWriter, req *http.Request) {
 		if call.Q.Get(msgParamBundle) == "1" {
 			resp.AddOptions(optionAckAll, optionCloseAll)
 		} else {
-			resp.AddOptions(optionAck, optionEscalate, optionClose)
+			resp.AddOptions(optionAck, optionEscalate, optionClose, optionDetails)
+			if v.hasConferenceNumber(ctx, call.msgSubjectID) {
+				resp.AddOptions(optionConference)
+			}
 		}
 		resp.AddOptions(optionStop)
 		resp.Gather(v.callbackURL(ctx, call.Q, CallTypeAlert))
@@ -574,14 +589,41 @@ func (v *Voice) ServeAlert(w http.ResponseWriter, req *http.Request) {
 		resp.Redirect(v.callbackURL(ctx, call.Q, CallTypeStop))
 		return
 
-	case digitAck, digitClose, digitEscalate: // Acknowledge , Escalate and Close cases
+	case digitDetails:
+		if call.Q.Get(msgParamBundle) == "1" {
+			resp.SayUnknownDigit().Redirect(v.callbackURL(ctx, call.Q, CallTypeAlert))
+			return
+		}
+		call.Q.Set("previous", string(CallTypeAlert))
+		resp.Redirect(v.callbackURL(ctx, call.Q, CallTypeAlertDetail))
+		return
+
+	case digitConference:
+		var number string
+		err := doDeadline(ctx, func() (err error) {
+			number, err = v.conferenceNumber(ctx, call.msgSubjectID)
+			return err
+		})
+		if errResp(false, errors.Wrap(err, "lookup conference number"), "") {
+			return
+		}
+		if number == "" {
+			resp.Say("There is no conference line configured for this service.").
+				Redirect(v.callbackURL(ctx, call.Q, CallTypeAlert))
+			return
+		}
+
+		resp.Say("Connecting you to the conference line.").Dial(number)
+		return
+
+	case digitAck, digitClose, digitEscalate, digitOldEscalate: // Acknowledge , Escalate and Close cases
 		var result notification.Result
 		var msg string
 		switch call.Digits {
 		case digitClose:
 			result = notification.ResultResolve
 			msg = "Closed"
-		case digitEscalate:
+		case digitEscalate, digitOldEscalate:
 			result = notification.ResultEscalate
 			msg = "Escalation requested"
 		default:
@@ -606,6 +648,172 @@ func (v *Voice) ServeAlert(w http.ResponseWriter, req *http.Request) {
 	}
 }
 
+// ServeAlertDetail serves the full details of an alert from the alert call menu.
+func (v *Voice) ServeAlertDetail(w http.ResponseWriter, req *http.Request) {
+	if disabled(w, req) {
+		return
+	}
+	ctx, call, errResp := v.getCall(w, req)
+	if call == nil {
+		return
+	}
+
+	resp := newTwiMLResponse(ctx, w)
+	switch call.Digits {
+	default:
+		resp.SayUnknownDigit()
+		fallthrough
+	case "", digitRepeat:
+		var text string
+		err := doDeadline(ctx, func() (err error) {
+			text, err = v.alertDetailText(ctx, call.msgSubjectID)
+			return err
+		})
+		if errResp(false, errors.Wrap(err, "lookup alert details"), "") {
+			return
+		}
+
+		resp.Say(text)
+		resp.AddOptions(optionCancel)
+		resp.Gather(v.callbackURL(ctx, call.Q, CallTypeAlertDetail))
+		return
+	case digitGoBack:
+		resp.Redirect(v.callbackURL(ctx, call.Q, CallType(call.Q.Get("previous"))))
+		return
+	}
+}
+
+// alertService returns the alert and its service for the provided alert ID.
+func (v *Voice) alertService(ctx context.Context, alertID int) (a *alert.Alert, svc *service.Service, err error) {
+	if alertID <= 0 {
+		return nil, nil, errors.New("alert ID missing")
+	}
+
+	// callbacks are validated by signature, and the alert ID is part of the signed URL
+	permission.SudoContext(ctx, func(ctx context.Context) {
+		a, err = v.c.AlertStore.FindOne(ctx, alertID)
+		if err != nil {
+			err = errors.Wrap(err, "find alert")
+			return
+		}
+		svc, err = v.c.ServiceStore.FindOne(ctx, a.ServiceID)
+		if err != nil {
+			err = errors.Wrap(err, "find service")
+		}
+	})
+
+	return a, svc, err
+}
+
+// conferenceNumber returns the conference number for the service of the provided alert, if any.
+func (v *Voice) conferenceNumber(ctx context.Context, alertID int) (string, error) {
+	if alertID <= 0 {
+		return "", nil
+	}
+
+	_, svc, err := v.alertService(ctx, alertID)
+	if err != nil {
+		return "", err
+	}
+
+	return svc.ConferenceNumber, nil
+}
+
+// hasConferenceNumber returns true if the service of the provided alert has a conference number.
+//
+// Errors are logged, and the option is omitted from the menu.
+func (v *Voice) hasConferenceNumber(ctx context.Context, alertID int) bool {
+	var number string
+	err := doDeadline(ctx, func() (err error) {
+		number, err = v.conferenceNumber(ctx, alertID)
+		return err
+	})
+	if err != nil {
+		log.Log(ctx, errors.Wrap(err, "lookup conference number"))
+		return false
+	}
+
+	return number != ""
+}
+
+// alertDetailText returns the spoken details and metadata for the provided alert.
+func (v *Voice) alertDetailText(ctx context.Context, alertID int) (string, error) {
+	a, svc, err := v.alertService(ctx, alertID)
+	if err != nil {
+		return "", err
+	}
+
+	var meta map[string]string
+	permission.SudoContext(ctx, func(ctx context.Context) {
+		meta, err = v.c.AlertStore.Metadata(ctx, v.c.DB, alertID)
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "find alert metadata")
+	}
+
+	return buildAlertDetail(*a, svc.Name, meta), nil
+}
+
+// maxAlertDetailLength is the maximum number of characters spoken for alert details, staying
+// well under the 4,096 character limit Twilio has for <Say> text.
+const maxAlertDetailLength = 3000
+
+// buildAlertDetail returns a spoken description of an alert, including its details and metadata.
+//
+// The details and metadata are truncated so the result is at most maxAlertDetailLength characters.
+func buildAlertDetail(a alert.Alert, serviceName string, meta map[string]string) string {
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "Alert %d for service '%s' is %s, with %s severity. ", a.ID, serviceName, alertStatusText(a.Status), a.Severity)
+
+	summary := strings.TrimSpace(a.Summary)
+	if summary == "" {
+		summary = "No summary provided"
+	}
+	fmt.Fprintf(&buf, "Summary: %s. ", strings.TrimSuffix(summary, "."))
+	header := buf.String()
+	buf.Reset()
+
+	details := strings.TrimSpace(a.Details)
+	if details == "" {
+		buf.WriteString("No details provided.")
+	} else {
+		fmt.Fprintf(&buf, "Details: %s.", strings.TrimSuffix(details, "."))
+	}
+
+	if len(meta) > 0 {
+		keys := make([]string, 0, len(meta))
+		for k := range meta {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		buf.WriteString(" Metadata:")
+		for _, k := range keys {
+			fmt.Fprintf(&buf, " %s: %s.", k, meta[k])
+		}
+	}
+
+	const truncated = "... details truncated."
+	body := []rune(buf.String())
+	budget := maxAlertDetailLength - len([]rune(header))
+	if len(body) > budget {
+		body = append(body[:budget-len(truncated)], []rune(truncated)...)
+	}
+
+	return header + string(body)
+}
+
+func alertStatusText(s alert.Status) string {
+	switch s {
+	case alert.StatusActive:
+		return "acknowledged"
+	case alert.StatusClosed:
+		return "closed"
+	}
+
+	return "unacknowledged"
+}
+
 // buildMessage is a function that will build the VoiceOptions object with the proper message contents
 func buildMessage(prefix string, msg notification.Message) (message string, err error) {
 	if prefix == "" {
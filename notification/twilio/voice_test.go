@@ -2,9 +2,11 @@ package twilio
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/target/goalert/alert"
 	"github.com/target/goalert/notification"
 	"github.com/target/goalert/notification/nfymsg"
 )
@@ -106,6 +108,37 @@ func TestBuildMessage(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestBuildAlertDetail(t *testing.T) {
+	a := alert.Alert{
+		ID:       3,
+		Status:   alert.StatusActive,
+		Summary:  "Widget is Broken.",
+		Details:  "Oh No!",
+		Severity: alert.SeverityHigh,
+	}
+
+	assert.Equal(t,
+		"Alert 3 for service 'Widget' is acknowledged, with high severity. Summary: Widget is Broken. Details: Oh No!. Metadata: host: web1. region: us-east.",
+		buildAlertDetail(a, "Widget", map[string]string{"region": "us-east", "host": "web1"}),
+	)
+
+	a.Status = alert.StatusTriggered
+	a.Summary = ""
+	a.Details = ""
+	assert.Equal(t,
+		"Alert 3 for service 'Widget' is unacknowledged, with high severity. Summary: No summary provided. No details provided.",
+		buildAlertDetail(a, "Widget", map[string]string{}),
+	)
+
+	// Twilio rejects <Say> text over 4,096 characters
+	a.Summary = strings.Repeat("s", alert.MaxSummaryLength)
+	a.Details = strings.Repeat("d", alert.MaxDetailsLength)
+	text := buildAlertDetail(a, "Widget", map[string]string{"host": strings.Repeat("h", 1024)})
+	assert.Len(t, []rune(text), maxAlertDetailLength)
+	assert.True(t, strings.HasPrefix(text, "Alert 3 for service 'Widget' is unacknowledged, with high severity. Summary: "+a.Summary+". Details: ddd"), "summary should be kept")
+	assert.True(t, strings.HasSuffix(text, "ddd... details truncated."))
+}
+
 func BenchmarkBuildMessage(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		_, _ = buildMessage(
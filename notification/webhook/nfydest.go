@@ -12,11 +12,13 @@ import (
 )
 
 const (
-	DestTypeWebhook  = "builtin-webhook"
-	FieldWebhookURL  = "webhook_url"
-	ParamBody        = "body"
-	ParamContentType = "content_type"
-	FallbackIconURL  = "builtin://webhook"
+	DestTypeWebhook   = "builtin-webhook"
+	FieldWebhookURL   = "webhook_url"
+	FieldBodyTemplate = "body_template"
+	FieldHeaders      = "headers"
+	ParamBody         = "body"
+	ParamContentType  = "content_type"
+	FallbackIconURL   = "builtin://webhook"
 )
 
 func NewWebhookDest(url string) gadb.DestV1 {
@@ -47,6 +49,19 @@ func (Sender) TypeInfo(ctx context.Context) (*nfydest.TypeInfo, error) {
 			Hint:               "Webhook Documentation",
 			HintURL:            "/docs#webhooks",
 			SupportsValidation: true,
+		}, {
+			FieldID:         FieldBodyTemplate,
+			Label:           "Body Template (optional)",
+			PlaceholderText: `{"text": {{json .Summary}}}`,
+			InputType:       "textarea",
+			Hint:            "Go template for the request body, replacing the default payload. Not used for signals.",
+			HintURL:         "/docs#webhooks",
+		}, {
+			FieldID:         FieldHeaders,
+			Label:           "Headers (optional)",
+			PlaceholderText: "Authorization: Bearer ...",
+			InputType:       "textarea",
+			Hint:            "Additional request headers, one 'Name: value' per line. Visible to anyone who can view this destination.",
 		}},
 		DynamicParams: []nfydest.DynamicParamConfig{
 			{
@@ -77,6 +92,11 @@ func (s *Sender) ValidateField(ctx context.Context, fieldID, value string) error
 		}
 
 		return nil
+	case FieldBodyTemplate:
+		return validateBodyTemplate(value)
+	case FieldHeaders:
+		_, err := parseHeaders(value)
+		return err
 	}
 
 	return validation.NewGenericError("unknown field ID")
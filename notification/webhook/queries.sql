@@ -0,0 +1,18 @@
+-- name: WebhookSetSigningSecret :exec
+INSERT INTO webhook_signing_secrets(webhook_url, secret)
+    VALUES (@webhook_url, @secret)
+ON CONFLICT (webhook_url)
+    DO UPDATE SET
+        secret = @secret, created_at = now();
+
+-- name: WebhookDeleteSigningSecret :exec
+DELETE FROM webhook_signing_secrets
+WHERE webhook_url = @webhook_url;
+
+-- name: WebhookSigningSecret :one
+SELECT
+    secret
+FROM
+    webhook_signing_secrets
+WHERE
+    webhook_url = @webhook_url;
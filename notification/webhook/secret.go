@@ -0,0 +1,96 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/keyring"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/validation/validate"
+)
+
+// SecretPrefix is prepended to all generated signing secrets to make them easy to identify.
+const SecretPrefix = "whsec_"
+
+const secretLabel = "WEBHOOK SIGNING SECRET"
+
+// SecretStore manages signing secrets for webhook URLs.
+//
+// Secrets are encrypted at rest with the data encryption key.
+type SecretStore struct {
+	db   *sql.DB
+	keys keyring.Keys
+}
+
+// NewSecretStore creates a new SecretStore.
+func NewSecretStore(ctx context.Context, db *sql.DB, keys keyring.Keys) (*SecretStore, error) {
+	return &SecretStore{db: db, keys: keys}, nil
+}
+
+// Generate creates a new signing secret for the webhook URL, replacing any existing one.
+//
+// The secret is only returned once and cannot be retrieved later.
+func (s *SecretStore) Generate(ctx context.Context, webhookURL string) (string, error) {
+	err := permission.LimitCheckAny(ctx, permission.Admin)
+	if err != nil {
+		return "", err
+	}
+	err = validate.AbsoluteURL("URL", webhookURL)
+	if err != nil {
+		return "", err
+	}
+
+	buf := make([]byte, 32)
+	_, err = rand.Read(buf)
+	if err != nil {
+		return "", err
+	}
+	secret := SecretPrefix + base64.RawURLEncoding.EncodeToString(buf)
+
+	enc, err := s.keys.Encrypt(secretLabel, []byte(secret))
+	if err != nil {
+		return "", err
+	}
+
+	err = gadb.New(s.db).WebhookSetSigningSecret(ctx, gadb.WebhookSetSigningSecretParams{
+		WebhookUrl: webhookURL,
+		Secret:     enc,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return secret, nil
+}
+
+// Delete removes the signing secret for the webhook URL, if any. Requests to it will no longer be signed.
+func (s *SecretStore) Delete(ctx context.Context, webhookURL string) error {
+	err := permission.LimitCheckAny(ctx, permission.Admin)
+	if err != nil {
+		return err
+	}
+
+	return gadb.New(s.db).WebhookDeleteSigningSecret(ctx, webhookURL)
+}
+
+// signingSecret returns the decrypted signing secret for the webhook URL, or an empty string if there is none.
+func (s *SecretStore) signingSecret(ctx context.Context, webhookURL string) (string, error) {
+	enc, err := gadb.New(s.db).WebhookSigningSecret(ctx, webhookURL)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	data, _, err := s.keys.Decrypt(enc)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
@@ -5,16 +5,24 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"time"
 
 	"github.com/target/goalert/config"
 	"github.com/target/goalert/notification"
 	"github.com/target/goalert/notification/nfydest"
+	"github.com/target/goalert/notification/webhook/signature"
 )
 
+// SendTimeout is the maximum time to wait for a webhook request to complete.
+const SendTimeout = 3 * time.Second
+
 type Sender struct {
 	Client *http.Client
+
+	// Secrets, if set, is used to sign requests to URLs with a signing secret.
+	Secrets *SecretStore
 }
 
 // POSTDataAlert represents fields in outgoing alert notification.
@@ -94,9 +102,10 @@ type POSTDataTest struct {
 	Type    string
 }
 
-func NewSender(ctx context.Context, client *http.Client) *Sender {
+func NewSender(ctx context.Context, client *http.Client, secrets *SecretStore) *Sender {
 	return &Sender{
-		Client: client,
+		Client:  client,
+		Secrets: secrets,
 	}
 }
 
@@ -167,19 +176,52 @@ func (s *Sender) SendMessage(ctx context.Context, msg notification.Message) (*no
 			ScheduleName: m.ScheduleName,
 			ScheduleURL:  m.ScheduleURL,
 		}
+	case notification.SignalMessage:
+		// signals (e.g., from universal key rules) send their body as-is
+		return s.post(ctx, msg, m.Param(ParamContentType), []byte(m.Param(ParamBody)))
 	default:
 		return nil, fmt.Errorf("message type '%T' not supported", m)
 	}
 
-	data, err := json.Marshal(payload)
+	var data []byte
+	var err error
+	if tmpl := msg.DestArg(FieldBodyTemplate); tmpl != "" {
+		data, err = renderBody(tmpl, payload)
+		if err != nil {
+			return &notification.SentMessage{
+				State:        notification.StateFailedPerm,
+				StateDetails: "render body template: " + err.Error(),
+			}, nil
+		}
+	} else {
+		data, err = json.Marshal(payload)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return s.post(ctx, msg, "application/json", data)
+}
+
+// post will deliver a webhook request, mapping the response to a message state.
+//
+// Custom headers from the destination are added to the request. If a signing secret exists for the URL, the request is signed; see the signature package.
+//
+// Connection errors, 429s, and 5xx responses are temporary failures and will be retried.
+func (s *Sender) post(ctx context.Context, msg notification.Message, contentType string, data []byte) (*notification.SentMessage, error) {
+	cfg := config.FromContext(ctx)
+	webURL := msg.DestArg(FieldWebhookURL)
+	hdr, err := parseHeaders(msg.DestArg(FieldHeaders))
 	if err != nil {
-		return nil, err
+		return &notification.SentMessage{
+			State:        notification.StateFailedPerm,
+			StateDetails: "invalid headers: " + err.Error(),
+		}, nil
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, time.Second*3)
+	ctx, cancel := context.WithTimeout(ctx, SendTimeout)
 	defer cancel()
 
-	webURL := msg.DestArg(FieldWebhookURL)
 	if !cfg.ValidWebhookURL(webURL) {
 		// fail permanently if the URL is not currently valid/allowed
 		return &notification.SentMessage{
@@ -193,12 +235,48 @@ func (s *Sender) SendMessage(ctx context.Context, msg notification.Message) (*no
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", "application/json")
+	if contentType == "" {
+		contentType = "application/json"
+	}
+	req.Header.Add("Content-Type", contentType)
+	for name, values := range hdr {
+		// custom headers may override the content type
+		req.Header[name] = values
+	}
 
-	_, err = http.DefaultClient.Do(req)
+	if s.Secrets != nil {
+		secret, err := s.Secrets.signingSecret(ctx, webURL)
+		if err != nil {
+			return nil, fmt.Errorf("lookup signing secret: %w", err)
+		}
+		if secret != "" {
+			signature.SetHeaders(req.Header, secret, time.Now(), data)
+		}
+	}
+
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return &notification.SentMessage{State: notification.StateSent}, nil
+	case resp.StatusCode == http.StatusTooManyRequests, resp.StatusCode >= 500:
+		return &notification.SentMessage{
+			State:        notification.StateFailedTemp,
+			StateDetails: resp.Status,
+		}, nil
+	}
 
-	return &notification.SentMessage{State: notification.StateSent}, nil
+	return &notification.SentMessage{
+		State:        notification.StateFailedPerm,
+		StateDetails: resp.Status,
+	}, nil
 }
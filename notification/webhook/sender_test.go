@@ -0,0 +1,70 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/config"
+	"github.com/target/goalert/notification"
+	"github.com/target/goalert/notification/nfymsg"
+)
+
+func TestSender_SendMessage(t *testing.T) {
+	var status int
+	var gotBody, gotType, gotAuth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		data, _ := io.ReadAll(req.Body)
+		gotBody = string(data)
+		gotType = req.Header.Get("Content-Type")
+		gotAuth = req.Header.Get("Authorization")
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+
+	var cfg config.Config
+	cfg.Webhook.Enable = true
+	ctx := cfg.Context(context.Background())
+
+	s := NewSender(ctx, srv.Client(), nil)
+	msg := notification.SignalMessage{
+		Base:   nfymsg.Base{Dest: NewWebhookDest(srv.URL)},
+		Params: map[string]string{ParamBody: "hello", ParamContentType: "text/plain"},
+	}
+
+	check := func(code int, exp notification.State) {
+		t.Helper()
+		status = code
+		res, err := s.SendMessage(ctx, msg)
+		require.NoError(t, err)
+		assert.Equal(t, exp, res.State, "status %d", code)
+	}
+
+	check(http.StatusOK, notification.StateSent)
+	assert.Equal(t, "hello", gotBody, "signal body should be sent as-is")
+	assert.Equal(t, "text/plain", gotType)
+
+	check(http.StatusNoContent, notification.StateSent)
+	check(http.StatusTooManyRequests, notification.StateFailedTemp)
+	check(http.StatusBadGateway, notification.StateFailedTemp)
+	check(http.StatusNotFound, notification.StateFailedPerm)
+
+	dest := NewWebhookDest(srv.URL)
+	dest.SetArg(FieldBodyTemplate, `{"text": {{json .Summary}}}`)
+	dest.SetArg(FieldHeaders, "Authorization: Bearer abc\nContent-Type: application/vnd.example+json")
+	status = http.StatusOK
+	res, err := s.SendMessage(ctx, notification.Alert{
+		Base:    nfymsg.Base{Dest: dest},
+		AlertID: 1,
+		Summary: "disk full",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, notification.StateSent, res.State)
+	assert.Equal(t, `{"text": "disk full"}`, gotBody, "body should be rendered from template")
+	assert.Equal(t, "Bearer abc", gotAuth)
+	assert.Equal(t, "application/vnd.example+json", gotType, "custom header should override content type")
+}
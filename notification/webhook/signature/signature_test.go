@@ -0,0 +1,59 @@
+package signature
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSign(t *testing.T) {
+	// known value, so receivers in other languages can check their implementation
+	sig := Sign("secret", time.Unix(1700000000, 0), []byte(`{"hello":"world"}`))
+	assert.Equal(t, "v1=ecd478f118f332f48e64b6547a70d5853106d7ac4ebd0dbb8829ce28378b8ac1", sig)
+}
+
+func TestVerify(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	body := []byte(`{"hello":"world"}`)
+
+	h := make(http.Header)
+	SetHeaders(h, "secret", now, body)
+
+	assert.NoError(t, Verify("secret", h, body, now, DefaultTolerance))
+	assert.NoError(t, Verify("secret", h, body, now.Add(DefaultTolerance), DefaultTolerance), "at tolerance")
+	assert.ErrorIs(t, Verify("secret", h, body, now.Add(DefaultTolerance+time.Second), DefaultTolerance), ErrExpired, "replayed later")
+	assert.ErrorIs(t, Verify("secret", h, body, now.Add(-DefaultTolerance-time.Second), DefaultTolerance), ErrExpired, "from the future")
+	assert.ErrorIs(t, Verify("other", h, body, now, DefaultTolerance), ErrInvalid, "wrong secret")
+	assert.ErrorIs(t, Verify("secret", h, []byte(`{"hello":"there"}`), now, DefaultTolerance), ErrInvalid, "modified body")
+	assert.ErrorIs(t, Verify("secret", make(http.Header), body, now, DefaultTolerance), ErrMissing)
+
+	// timestamp is covered by the signature
+	moved := h.Clone()
+	moved.Set(HeaderTimestamp, strconv.FormatInt(now.Unix()+1, 10))
+	assert.ErrorIs(t, Verify("secret", moved, body, now, DefaultTolerance), ErrInvalid, "modified timestamp")
+
+	multi := h.Clone()
+	multi.Set(HeaderSignature, "v1=deadbeef, "+h.Get(HeaderSignature))
+	assert.NoError(t, Verify("secret", multi, body, now, DefaultTolerance), "any matching signature")
+}
+
+func TestVerifyRequest(t *testing.T) {
+	body := `{"hello":"world"}`
+	req, err := http.NewRequest("POST", "http://example.com", strings.NewReader(body))
+	require.NoError(t, err)
+	SetHeaders(req.Header, "secret", time.Now(), []byte(body))
+
+	data, err := VerifyRequest("secret", req, DefaultTolerance)
+	require.NoError(t, err)
+	assert.Equal(t, body, string(data))
+
+	data, err = io.ReadAll(req.Body)
+	require.NoError(t, err)
+	assert.Equal(t, body, string(data), "body should be readable again")
+}
@@ -0,0 +1,114 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"text/template"
+
+	"github.com/target/goalert/validation"
+	"golang.org/x/net/http/httpguts"
+)
+
+// MaxTemplateLength is the maximum length of a body template.
+const MaxTemplateLength = 16 * 1024
+
+var templateFuncs = template.FuncMap{
+	"json": func(v any) (string, error) {
+		data, err := json.Marshal(v)
+		return string(data), err
+	},
+}
+
+// samplePayload is used to check that a body template can be executed.
+var samplePayload = POSTDataAlert{
+	AppName:     "GoAlert",
+	Type:        "Alert",
+	AlertID:     1,
+	Summary:     "Example Summary",
+	Details:     "Example Details",
+	ServiceID:   "00000000-0000-0000-0000-000000000000",
+	ServiceName: "Example Service",
+	Meta:        map[string]string{"example": "value"},
+	GoAlertURL:  "https://goalert.example.com/alerts/1",
+}
+
+func parseBodyTemplate(s string) (*template.Template, error) {
+	return template.New("body").Funcs(templateFuncs).Parse(s)
+}
+
+// renderBody executes the body template with the default payload for the message.
+//
+// The payload is passed as a map of its JSON fields, so a template can be shared across
+// message types; fields that are not present for a type are empty.
+func renderBody(tmplStr string, payload any) ([]byte, error) {
+	tmpl, err := parseBodyTemplate(tmplStr)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]any
+	err = json.Unmarshal(data, &fields)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func validateBodyTemplate(s string) error {
+	if s == "" {
+		return nil
+	}
+	if len(s) > MaxTemplateLength {
+		return validation.NewGenericError(fmt.Sprintf("must be at most %d characters", MaxTemplateLength))
+	}
+
+	_, err := renderBody(s, samplePayload)
+	if err != nil {
+		return validation.NewGenericError(err.Error())
+	}
+
+	return nil
+}
+
+// parseHeaders parses additional request headers, one `Name: value` per line.
+//
+// Headers used by GoAlert for signing, and those managed by the HTTP client, are not allowed.
+func parseHeaders(s string) (http.Header, error) {
+	h := make(http.Header)
+	for i, line := range strings.Split(s, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		name, value, ok := strings.Cut(line, ":")
+		name = strings.TrimSpace(name)
+		value = strings.TrimSpace(value)
+		if !ok || !httpguts.ValidHeaderFieldName(name) || !httpguts.ValidHeaderFieldValue(value) {
+			return nil, validation.NewGenericError(fmt.Sprintf("line %d: must be in the form 'Name: value'", i+1))
+		}
+
+		name = http.CanonicalHeaderKey(name)
+		if name == "Host" || name == "Content-Length" || strings.HasPrefix(name, "X-Goalert-") {
+			return nil, validation.NewGenericError(fmt.Sprintf("line %d: header '%s' is not allowed", i+1, name))
+		}
+
+		h.Add(name, value)
+	}
+
+	return h, nil
+}
@@ -0,0 +1,47 @@
+package webhook
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderBody(t *testing.T) {
+	data, err := renderBody(`{"text": {{json .Summary}}, "id": {{.AlertID}}, "log": {{json .LogEntry}}}`, POSTDataAlert{
+		AlertID: 123,
+		Summary: `disk "full"`,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `{"text": "disk \"full\"", "id": 123, "log": null}`, string(data), "missing fields should be empty")
+
+	_, err = renderBody(`{{.Summary.Foo}}`, POSTDataAlert{Summary: "foo"})
+	assert.Error(t, err)
+}
+
+func TestValidateBodyTemplate(t *testing.T) {
+	assert.NoError(t, validateBodyTemplate(""))
+	assert.NoError(t, validateBodyTemplate(`{"content": {{json .Summary}}}`))
+	assert.Error(t, validateBodyTemplate(`{{.Summary`), "parse error")
+	assert.Error(t, validateBodyTemplate(`{{nope .Summary}}`), "unknown function")
+	assert.Error(t, validateBodyTemplate(`{{.Meta.example.foo}}`), "execution error")
+}
+
+func TestParseHeaders(t *testing.T) {
+	h, err := parseHeaders("Authorization: Bearer abc\n\n  x-custom:  one \nX-Custom: two\n")
+	require.NoError(t, err)
+	assert.Equal(t, http.Header{
+		"Authorization": {"Bearer abc"},
+		"X-Custom":      {"one", "two"},
+	}, h)
+
+	_, err = parseHeaders("no colon")
+	assert.Error(t, err)
+	_, err = parseHeaders("bad name: value")
+	assert.Error(t, err)
+	_, err = parseHeaders("X-GoAlert-Signature: v1=abc")
+	assert.Error(t, err, "signing headers are reserved")
+	_, err = parseHeaders("Host: example.com")
+	assert.Error(t, err)
+}
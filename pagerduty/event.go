@@ -0,0 +1,206 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/target/goalert/alert"
+	"github.com/target/goalert/validation"
+	"github.com/target/goalert/validation/validate"
+)
+
+/* Example payload (PagerDuty Events API v2)
+
+```
+{
+  "routing_key": "9fa1b4b2b2b24c1ab2c4f8e3f5a7d6c1",
+  "event_action": "trigger",
+  "dedup_key": "srv01/mysql",
+  "payload": {
+    "summary": "DISK at 99% on machine prod-datapipe03.example.com",
+    "source": "prod-datapipe03.example.com",
+    "severity": "critical",
+    "component": "mysql",
+    "group": "prod-datapipe",
+    "class": "disk",
+    "custom_details": {
+      "free space": "1%"
+    }
+  },
+  "links": [{
+    "href": "https://example.com/",
+    "text": "Link text"
+  }],
+  "client": "Sample Monitoring Service",
+  "client_url": "https://monitoring.example.com"
+}
+```
+*/
+
+// Event actions
+const (
+	ActionTrigger     = "trigger"
+	ActionAcknowledge = "acknowledge"
+	ActionResolve     = "resolve"
+)
+
+// Event is a PagerDuty Events API v2 event.
+type Event struct {
+	RoutingKey  string `json:"routing_key"`
+	EventAction string `json:"event_action"`
+	DedupKey    string `json:"dedup_key"`
+
+	Payload struct {
+		Summary       string          `json:"summary"`
+		Source        string          `json:"source"`
+		Severity      string          `json:"severity"`
+		Timestamp     string          `json:"timestamp"`
+		Component     string          `json:"component"`
+		Group         string          `json:"group"`
+		Class         string          `json:"class"`
+		CustomDetails json.RawMessage `json:"custom_details"`
+	} `json:"payload"`
+
+	Links []struct {
+		Href string `json:"href"`
+		Text string `json:"text"`
+	} `json:"links"`
+
+	Client    string `json:"client"`
+	ClientURL string `json:"client_url"`
+}
+
+// Validate will check that the event has the fields required for its action.
+//
+// A missing dedup_key on a trigger event is not an error.
+func (e Event) Validate() error {
+	var errs []error
+	switch e.EventAction {
+	case ActionTrigger:
+		errs = append(errs,
+			validate.RequiredText("payload.summary", e.Payload.Summary, 1, 1024),
+			validate.RequiredText("payload.source", e.Payload.Source, 1, 255),
+			validate.OneOf("payload.severity", e.Payload.Severity, "critical", "error", "warning", "info"),
+		)
+	case ActionAcknowledge, ActionResolve:
+		errs = append(errs, validate.RequiredText("dedup_key", e.DedupKey, 1, 255))
+	default:
+		errs = append(errs, validation.NewFieldError("event_action", "must be one of trigger, acknowledge, or resolve"))
+	}
+
+	return validate.Many(errs...)
+}
+
+// Status returns the alert status for the event action.
+func (e Event) Status() alert.Status {
+	switch e.EventAction {
+	case ActionAcknowledge:
+		return alert.StatusActive
+	case ActionResolve:
+		return alert.StatusClosed
+	}
+
+	return alert.StatusTriggered
+}
+
+// Severity maps the PagerDuty severity to the closest alert severity.
+func (e Event) Severity() alert.Severity {
+	switch e.Payload.Severity {
+	case "critical":
+		return alert.SeverityCritical
+	case "error":
+		return alert.SeverityHigh
+	case "warning":
+		return alert.SeverityLow
+	case "info":
+		return alert.SeverityInfo
+	}
+
+	return ""
+}
+
+// Details returns a markdown description of the event.
+func (e Event) Details() string {
+	var s strings.Builder
+	if e.Client != "" {
+		if validate.AbsoluteURL("client_url", e.ClientURL) == nil {
+			fmt.Fprintf(&s, "[%s](%s)\n\n", e.Client, e.ClientURL)
+		} else {
+			s.WriteString(e.Client + "\n\n")
+		}
+	}
+
+	fields := []struct{ Name, Value string }{
+		{"Source", e.Payload.Source},
+		{"Component", e.Payload.Component},
+		{"Group", e.Payload.Group},
+		{"Class", e.Payload.Class},
+		{"Timestamp", e.Payload.Timestamp},
+	}
+	for _, f := range fields {
+		if f.Value == "" {
+			continue
+		}
+		fmt.Fprintf(&s, "- **%s**: %s\n", f.Name, f.Value)
+	}
+
+	for _, l := range e.Links {
+		if validate.AbsoluteURL("links.href", l.Href) != nil {
+			continue
+		}
+		text := l.Text
+		if text == "" {
+			text = l.Href
+		}
+		fmt.Fprintf(&s, "- [%s](%s)\n", text, l.Href)
+	}
+
+	if len(e.Payload.CustomDetails) > 0 && string(e.Payload.CustomDetails) != "null" {
+		data, err := json.MarshalIndent(e.Payload.CustomDetails, "", "  ")
+		if err != nil {
+			data = e.Payload.CustomDetails
+		}
+		fmt.Fprintf(&s, "\n## Custom Details\n\n```json\n%s\n```\n", data)
+	}
+
+	return s.String()
+}
+
+// Meta returns alert metadata for the event, including any string values from custom_details.
+func (e Event) Meta() map[string]string {
+	meta := make(map[string]string)
+	add := func(key, val string) {
+		if val == "" || validate.ASCII("key", key, 1, 255) != nil {
+			return
+		}
+		meta[key] = val
+	}
+	add("pagerduty/source", e.Payload.Source)
+	add("pagerduty/component", e.Payload.Component)
+	add("pagerduty/group", e.Payload.Group)
+	add("pagerduty/class", e.Payload.Class)
+
+	var custom map[string]any
+	_ = json.Unmarshal(e.Payload.CustomDetails, &custom)
+	keys := make([]string, 0, len(custom))
+	for k := range custom {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if str, ok := custom[k].(string); ok {
+			add(k, str)
+		}
+	}
+
+	if alert.ValidateMetadata(meta) != nil {
+		// too large; keep only the standard fields
+		for _, k := range keys {
+			delete(meta, k)
+		}
+	}
+
+	return meta
+}
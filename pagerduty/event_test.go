@@ -0,0 +1,74 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/alert"
+)
+
+func TestEvent(t *testing.T) {
+	const data = `{
+		"routing_key": "9fa1b4b2b2b24c1ab2c4f8e3f5a7d6c1",
+		"event_action": "trigger",
+		"payload": {
+			"summary": "DISK at 99%",
+			"source": "prod-datapipe03",
+			"severity": "warning",
+			"component": "mysql",
+			"custom_details": {"free space": "1%", "count": 3}
+		},
+		"links": [{"href": "https://example.com/", "text": "Runbook"}]
+	}`
+
+	var e Event
+	require.NoError(t, json.Unmarshal([]byte(data), &e))
+	require.NoError(t, e.Validate())
+
+	assert.Empty(t, e.DedupKey, "validate should not modify the event")
+	assert.Equal(t, alert.StatusTriggered, e.Status())
+	assert.Equal(t, alert.SeverityLow, e.Severity())
+	assert.Contains(t, e.Details(), "- **Component**: mysql")
+	assert.Contains(t, e.Details(), "[Runbook](https://example.com/)")
+	assert.Contains(t, e.Details(), `"free space": "1%"`)
+	assert.Equal(t, map[string]string{
+		"pagerduty/source":    "prod-datapipe03",
+		"pagerduty/component": "mysql",
+		"free space":          "1%",
+	}, e.Meta())
+}
+
+func TestEvent_Validate(t *testing.T) {
+	check := func(name string, valid bool, e Event) {
+		t.Run(name, func(t *testing.T) {
+			err := e.Validate()
+			if valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+
+	var e Event
+	e.EventAction = ActionResolve
+	e.DedupKey = "foo"
+	check("resolve", true, e)
+
+	e.DedupKey = ""
+	check("resolve-no-dedup", false, e)
+
+	e.EventAction = "bogus"
+	check("bad-action", false, e)
+
+	e.EventAction = ActionTrigger
+	e.Payload.Summary = "foo"
+	e.Payload.Source = "bar"
+	e.Payload.Severity = "urgent"
+	check("bad-severity", false, e)
+
+	e.Payload.Severity = "critical"
+	check("trigger", true, e)
+}
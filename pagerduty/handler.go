@@ -0,0 +1,132 @@
+package pagerduty
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/target/goalert/alert"
+	"github.com/target/goalert/auth/authtoken"
+	"github.com/target/goalert/integrationkey"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/retry"
+	"github.com/target/goalert/util/errutil"
+	"github.com/target/goalert/util/log"
+	"github.com/target/goalert/validation"
+	"github.com/target/goalert/validation/validate"
+)
+
+type response struct {
+	Status   string   `json:"status"`
+	Message  string   `json:"message"`
+	DedupKey string   `json:"dedup_key,omitempty"`
+	Errors   []string `json:"errors,omitempty"`
+}
+
+func writeJSON(w http.ResponseWriter, code int, resp response) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func invalidEvent(w http.ResponseWriter, err error) {
+	writeJSON(w, http.StatusBadRequest, response{
+		Status:  "invalid event",
+		Message: "Event object is invalid",
+		Errors:  []string{err.Error()},
+	})
+}
+
+// defaultDedupKey returns the dedup key for a trigger event that did not provide one.
+//
+// It is derived from the alert content, so a retried event de-duplicates to the same alert.
+func defaultDedupKey(a *alert.Alert) string {
+	sum := sha256.Sum256([]byte(a.Description()))
+	return hex.EncodeToString(sum[:])
+}
+
+// EventsAPIv2 returns a handler compatible with the PagerDuty Events API v2 enqueue endpoint.
+//
+// The routing_key of each event is the ID of a GoAlert integration key of type pagerduty. If it is
+// omitted, the `token` query parameter is used instead. The dedup_key is used to de-duplicate, acknowledge,
+// and close alerts; trigger events without one are given a key derived from the alert content.
+func EventsAPIv2(aDB *alert.Store, intDB *integrationkey.Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		var e Event
+		err := json.NewDecoder(r.Body).Decode(&e)
+		if err != nil {
+			log.Logf(ctx, "bad request from pagerduty events api: %v", err)
+			invalidEvent(w, err)
+			return
+		}
+		if e.RoutingKey == "" {
+			e.RoutingKey = r.URL.Query().Get("token")
+		}
+		keyID, err := uuid.Parse(e.RoutingKey)
+		if err != nil {
+			invalidEvent(w, validation.NewFieldError("routing_key", "invalid routing key"))
+			return
+		}
+		err = e.Validate()
+		if err != nil {
+			invalidEvent(w, err)
+			return
+		}
+
+		ctx = log.WithFields(ctx, log.Fields{
+			"IntegrationKey": keyID.String(),
+			"EventAction":    e.EventAction,
+		})
+
+		ctx, err = intDB.Authorize(ctx, authtoken.Token{ID: keyID}, integrationkey.TypePagerDuty)
+		if errutil.HTTPError(ctx, w, err) {
+			return
+		}
+
+		summary := validate.SanitizeText(e.Payload.Summary, alert.MaxSummaryLength)
+		msg := &alert.Alert{
+			Summary:   summary,
+			Details:   validate.SanitizeText(e.Details(), alert.MaxDetailsLength),
+			Status:    e.Status(),
+			Source:    alert.SourcePagerDuty,
+			Severity:  e.Severity(),
+			ServiceID: permission.ServiceID(ctx),
+		}
+		if e.DedupKey == "" {
+			// Validate only allows this for trigger events
+			e.DedupKey = defaultDedupKey(msg)
+		}
+		msg.Dedup = alert.NewUserDedup(e.DedupKey)
+		var meta map[string]string
+		if msg.Status == alert.StatusTriggered {
+			meta = e.Meta()
+		}
+		if len(meta) == 0 {
+			meta = nil
+		}
+
+		err = retry.DoTemporaryError(func(int) error {
+			_, _, err = aDB.CreateOrUpdateWithMeta(ctx, msg, meta)
+			return err
+		},
+			retry.Log(ctx),
+			retry.Limit(10),
+			retry.FibBackoff(time.Second),
+		)
+		if errutil.HTTPError(ctx, w, errors.Wrap(err, "create or update alert for pagerduty events api")) {
+			return
+		}
+
+		writeJSON(w, http.StatusAccepted, response{
+			Status:   "success",
+			Message:  "Event processed",
+			DedupKey: e.DedupKey,
+		})
+	}
+}
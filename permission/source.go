@@ -30,8 +30,13 @@ const (
 	// SourceTypeGQLAPIKey is set when a context is authorized for use of the GraphQL API.
 	SourceTypeGQLAPIKey
 
-	// SourceTypeUIK is set when a context is authorized for use of a universal integration key.
+	// SourceTypeUIK is set when a context is authorized for use of a rule-based (universal or OTLP) integration key.
 	SourceTypeUIK
+
+	// SourceTypeAuthSubject is set when a context is authorized via a linked auth subject (e.g., a Slack user running a command).
+	//
+	// The ID is the provider ID of the subject (e.g., `slack:<team ID>`).
+	SourceTypeAuthSubject
 )
 
 // SourceInfo provides information about the source of a context's authorization.
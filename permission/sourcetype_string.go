@@ -17,11 +17,12 @@ func _() {
 	_ = x[SourceTypeCalendarSubscription-6]
 	_ = x[SourceTypeGQLAPIKey-7]
 	_ = x[SourceTypeUIK-8]
+	_ = x[SourceTypeAuthSubject-9]
 }
 
-const _SourceType_name = "SourceTypeNotificationCallbackSourceTypeIntegrationKeySourceTypeAuthProviderSourceTypeContactMethodSourceTypeHeartbeatSourceTypeNotificationChannelSourceTypeCalendarSubscriptionSourceTypeGQLAPIKeySourceTypeUIK"
+const _SourceType_name = "SourceTypeNotificationCallbackSourceTypeIntegrationKeySourceTypeAuthProviderSourceTypeContactMethodSourceTypeHeartbeatSourceTypeNotificationChannelSourceTypeCalendarSubscriptionSourceTypeGQLAPIKeySourceTypeUIKSourceTypeAuthSubject"
 
-var _SourceType_index = [...]uint8{0, 30, 54, 76, 99, 118, 147, 177, 196, 209}
+var _SourceType_index = [...]uint8{0, 30, 54, 76, 99, 118, 147, 177, 196, 209, 230}
 
 func (i SourceType) String() string {
 	idx := int(i) - 0
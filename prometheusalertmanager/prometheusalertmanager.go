@@ -91,6 +91,7 @@ type postBody struct {
 	CommonLabels struct {
 		Instance  string
 		AlertName string `json:"alertname"`
+		Severity  string
 	}
 
 	CommonAnnotations struct {
@@ -102,6 +103,7 @@ type postBodyAlert struct {
 	Labels struct {
 		AlertName string
 		Instance  string
+		Severity  string
 	}
 	Annotations struct {
 		Summary string
@@ -153,6 +155,22 @@ func (b postBody) Summary() string {
 	return b.CommonLabels.AlertName + " " + strings.Join(instances, ",")
 }
 
+// Severity returns the most urgent severity from the `severity` label of all alerts.
+func (b postBody) Severity() alert.Severity {
+	sev, _ := alert.ParseSeverity(b.CommonLabels.Severity)
+	for _, a := range b.Alerts {
+		s, ok := alert.ParseSeverity(a.Labels.Severity)
+		if !ok {
+			continue
+		}
+		if sev == "" || s.Rank() < sev.Rank() {
+			sev = s
+		}
+	}
+
+	return sev
+}
+
 func (b postBody) Details(payload string) string {
 	var s strings.Builder
 	if b.ExternalURL != "" {
@@ -226,6 +244,7 @@ func PrometheusAlertmanagerEventsAPI(aDB *alert.Store, intDB *integrationkey.Sto
 			Details:   validate.SanitizeText(body.Details(string(data)), alert.MaxDetailsLength),
 			Status:    status,
 			Source:    alert.SourcePrometheusAlertmanager,
+			Severity:  body.Severity(),
 			ServiceID: serviceID,
 			Dedup:     alert.NewUserDedup(summary),
 		}
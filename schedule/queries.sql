@@ -104,3 +104,20 @@ WHERE s.id = ANY($1::uuid[]);
 DELETE FROM schedules
 WHERE id = ANY($1::uuid[]);
 
+
+-- name: SchedSyncedUserGroupChannels :many
+-- Returns the IDs of the given notification channels that update a Slack user group whose membership is synced by GoAlert.
+SELECT
+    nc.id
+FROM
+    notification_channels nc
+WHERE
+    nc.id = ANY (@channel_ids::uuid[])
+    AND nc.dest ->> 'Type' = 'builtin-slack-usergroup'
+    AND EXISTS (
+        SELECT
+            1
+        FROM
+            slack_usergroup_syncs s
+        WHERE
+            s.usergroup_id = nc.dest -> 'Args' ->> 'slack_usergroup_id');
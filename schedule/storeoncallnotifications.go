@@ -4,8 +4,10 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"slices"
 
 	"github.com/google/uuid"
+	"github.com/target/goalert/gadb"
 	"github.com/target/goalert/permission"
 	"github.com/target/goalert/util/timeutil"
 	"github.com/target/goalert/validation"
@@ -60,6 +62,25 @@ func (store *Store) SetOnCallNotificationRules(ctx context.Context, tx *sql.Tx,
 		m[key] = struct{}{}
 	}
 
+	// A user group synced with a rotation or escalation policy step would be overwritten on every on-call change.
+	db := gadb.New(store.db)
+	if tx != nil {
+		db = db.WithTx(tx)
+	}
+	chanIDs := make([]uuid.UUID, len(rules))
+	for i, r := range rules {
+		chanIDs[i] = r.ChannelID
+	}
+	synced, err := db.SchedSyncedUserGroupChannels(ctx, chanIDs)
+	if err != nil {
+		return err
+	}
+	for i, r := range rules {
+		if slices.Contains(synced, r.ChannelID) {
+			return validation.NewFieldError(fmt.Sprintf("Rules[%d]", i), "User group membership is already synced from a rotation or escalation policy step.")
+		}
+	}
+
 	ids := make([]bool, onCallNotificationRuleLimit)
 	for i, r := range rules {
 		if !r.ID.valid {
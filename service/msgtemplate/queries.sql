@@ -0,0 +1,37 @@
+-- name: MsgTemplateFindAll :many
+-- Returns all message templates for a service.
+SELECT
+    *
+FROM
+    service_message_templates
+WHERE
+    service_id = @service_id
+ORDER BY
+    dest_type;
+
+-- name: MsgTemplateFindForDest :one
+-- Returns the message template for a service and destination type, falling back to the default (empty dest_type) template.
+SELECT
+    *
+FROM
+    service_message_templates
+WHERE
+    service_id = @service_id
+    AND dest_type IN (@dest_type, '')
+ORDER BY
+    dest_type DESC
+LIMIT 1;
+
+-- name: MsgTemplateSet :exec
+-- Creates or updates the message template for a service and destination type.
+INSERT INTO service_message_templates(service_id, dest_type, summary_template, details_template)
+    VALUES (@service_id, @dest_type, @summary_template, @details_template)
+ON CONFLICT (service_id, dest_type)
+    DO UPDATE SET
+        summary_template = excluded.summary_template, details_template = excluded.details_template, updated_at = now();
+
+-- name: MsgTemplateDelete :exec
+-- Deletes the message template for a service and destination type.
+DELETE FROM service_message_templates
+WHERE service_id = @service_id
+    AND dest_type = @dest_type;
@@ -0,0 +1,112 @@
+package msgtemplate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/validation/validate"
+)
+
+// Store manages per-service message templates.
+type Store struct {
+	db *sql.DB
+}
+
+// NewStore creates a new Store.
+func NewStore(ctx context.Context, db *sql.DB) (*Store, error) {
+	return &Store{db: db}, nil
+}
+
+func fromDB(t gadb.ServiceMessageTemplate) Template {
+	return Template{
+		ServiceID:       t.ServiceID.String(),
+		DestType:        t.DestType,
+		SummaryTemplate: t.SummaryTemplate,
+		DetailsTemplate: t.DetailsTemplate,
+	}
+}
+
+// FindAll returns all message templates for the given service.
+func (s *Store) FindAll(ctx context.Context, serviceID string) ([]Template, error) {
+	err := permission.LimitCheckAny(ctx, permission.System, permission.User)
+	if err != nil {
+		return nil, err
+	}
+	id, err := validate.ParseUUID("ServiceID", serviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := gadb.New(s.db).MsgTemplateFindAll(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Template, len(rows))
+	for i, r := range rows {
+		result[i] = fromDB(r)
+	}
+
+	return result, nil
+}
+
+// FindForDest returns the template to use for the given service and destination type,
+// falling back to the service default template. If neither exist, nil is returned.
+func (s *Store) FindForDest(ctx context.Context, serviceID, destType string) (*Template, error) {
+	err := permission.LimitCheckAny(ctx, permission.System, permission.User)
+	if err != nil {
+		return nil, err
+	}
+	id, err := validate.ParseUUID("ServiceID", serviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := gadb.New(s.db).MsgTemplateFindForDest(ctx, gadb.MsgTemplateFindForDestParams{
+		ServiceID: id,
+		DestType:  destType,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	t := fromDB(row)
+	return &t, nil
+}
+
+// Set will create, update, or (if both templates are empty) delete the message template
+// for a service and destination type.
+func (s *Store) Set(ctx context.Context, t Template) error {
+	err := permission.LimitCheckAny(ctx, permission.Admin, permission.User)
+	if err != nil {
+		return err
+	}
+
+	n, err := t.Normalize()
+	if err != nil {
+		return err
+	}
+
+	q := gadb.New(s.db)
+	id := uuid.MustParse(n.ServiceID) // already validated in Normalize
+	if n.IsEmpty() {
+		return q.MsgTemplateDelete(ctx, gadb.MsgTemplateDeleteParams{
+			ServiceID: id,
+			DestType:  n.DestType,
+		})
+	}
+
+	return q.MsgTemplateSet(ctx, gadb.MsgTemplateSetParams{
+		ServiceID:       id,
+		DestType:        n.DestType,
+		SummaryTemplate: n.SummaryTemplate,
+		DetailsTemplate: n.DetailsTemplate,
+	})
+}
@@ -0,0 +1,125 @@
+package msgtemplate
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/target/goalert/validation"
+	"github.com/target/goalert/validation/validate"
+)
+
+// MaxTemplateLength is the maximum length of a single summary or details template.
+const MaxTemplateLength = 8192
+
+// Template is a per-service message template used to shape alert notifications
+// for a particular destination type.
+type Template struct {
+	ServiceID string
+
+	// DestType is the destination type (e.g., builtin-twilio-sms) the template applies to.
+	// An empty DestType is used as the default for any destination type without its own template.
+	DestType string
+
+	// SummaryTemplate, if set, replaces the alert summary in notifications.
+	SummaryTemplate string
+
+	// DetailsTemplate, if set, replaces the alert details in notifications.
+	DetailsTemplate string
+}
+
+// Data is the data available to message templates.
+type Data struct {
+	AlertID     int
+	Summary     string
+	Details     string
+	ServiceID   string
+	ServiceName string
+	Meta        map[string]string
+}
+
+// Rendered contains the result of rendering a Template.
+type Rendered struct {
+	Summary string
+	Details string
+}
+
+// Normalize will validate and return a normalized Template.
+func (t Template) Normalize() (*Template, error) {
+	t.SummaryTemplate = strings.TrimSpace(t.SummaryTemplate)
+	t.DetailsTemplate = strings.TrimSpace(t.DetailsTemplate)
+
+	err := validate.Many(
+		validate.UUID("ServiceID", t.ServiceID),
+		validate.Text("DestType", t.DestType, 1, 255),
+		validate.Text("SummaryTemplate", t.SummaryTemplate, 1, MaxTemplateLength),
+		validate.Text("DetailsTemplate", t.DetailsTemplate, 1, MaxTemplateLength),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = parse("SummaryTemplate", t.SummaryTemplate)
+	if err != nil {
+		return nil, err
+	}
+	_, err = parse("DetailsTemplate", t.DetailsTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+// IsEmpty returns true if neither the summary nor details are templated.
+func (t Template) IsEmpty() bool { return t.SummaryTemplate == "" && t.DetailsTemplate == "" }
+
+func parse(fieldName, text string) (*template.Template, error) {
+	tmpl, err := template.New(fieldName).Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return nil, validation.NewFieldError(fieldName, err.Error())
+	}
+
+	return tmpl, nil
+}
+
+func render(fieldName, text string, data Data) (string, error) {
+	if text == "" {
+		return "", nil
+	}
+
+	tmpl, err := parse(fieldName, text)
+	if err != nil {
+		return "", err
+	}
+
+	var buf strings.Builder
+	err = tmpl.Execute(&buf, data)
+	if err != nil {
+		return "", validation.NewFieldError(fieldName, fmt.Sprintf("render: %v", err))
+	}
+
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// Render will execute the template with the provided data. If either the summary or details
+// template is empty, or renders to an empty string, the original value from data is used.
+func (t Template) Render(data Data) (*Rendered, error) {
+	summary, err := render("SummaryTemplate", t.SummaryTemplate, data)
+	if err != nil {
+		return nil, err
+	}
+	details, err := render("DetailsTemplate", t.DetailsTemplate, data)
+	if err != nil {
+		return nil, err
+	}
+
+	if summary == "" {
+		summary = data.Summary
+	}
+	if details == "" {
+		details = data.Details
+	}
+
+	return &Rendered{Summary: summary, Details: details}, nil
+}
@@ -0,0 +1,46 @@
+package msgtemplate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplate_Normalize(t *testing.T) {
+	const svcID = "e93facc0-4764-012d-7bfb-002500d5d1a6"
+
+	_, err := Template{ServiceID: svcID, SummaryTemplate: "{{.Summary}}"}.Normalize()
+	assert.NoError(t, err)
+
+	_, err = Template{ServiceID: svcID, DestType: "builtin-twilio-sms", DetailsTemplate: "{{.Meta.cluster}}"}.Normalize()
+	assert.NoError(t, err)
+
+	_, err = Template{ServiceID: svcID, SummaryTemplate: "{{.Summary"}.Normalize()
+	assert.Error(t, err, "parse error")
+
+	_, err = Template{ServiceID: "invalid", SummaryTemplate: "{{.Summary}}"}.Normalize()
+	assert.Error(t, err, "invalid service ID")
+}
+
+func TestTemplate_Render(t *testing.T) {
+	data := Data{
+		AlertID:     123,
+		Summary:     "CPU high",
+		Details:     "CPU is at 99%",
+		ServiceName: "Web",
+		Meta:        map[string]string{"cluster": "prod-1"},
+	}
+
+	r, err := Template{SummaryTemplate: "[{{.Meta.cluster}}] {{.ServiceName}}: {{.Summary}}"}.Render(data)
+	require.NoError(t, err)
+	assert.Equal(t, "[prod-1] Web: CPU high", r.Summary)
+	assert.Equal(t, "CPU is at 99%", r.Details, "details unchanged when not templated")
+
+	r, err = Template{DetailsTemplate: "{{.Meta.missing}}"}.Render(data)
+	require.NoError(t, err)
+	assert.Equal(t, "CPU is at 99%", r.Details, "empty render falls back to original")
+
+	_, err = Template{SummaryTemplate: "{{.Nope}}"}.Render(data)
+	assert.Error(t, err, "unknown field")
+}
@@ -13,6 +13,12 @@ type Service struct {
 	EscalationPolicyID   string
 	MaintenanceExpiresAt time.Time
 
+	// ConferenceNumber is an optional phone number (e.g., a conference bridge) that
+	// voice notification recipients can be connected to from the call menu.
+	//
+	// It is only written by Store.SetConferenceNumberTx, which requires admin.
+	ConferenceNumber string
+
 	epName         string
 	isUserFavorite bool
 }
@@ -43,6 +49,9 @@ func (s Service) Normalize() (*Service, error) {
 		validate.UUID("EscalationPolicyID", s.EscalationPolicyID),
 		validate.Duration("MaintenanceExpiresAt", dur, 0, 24*time.Hour+5*time.Minute),
 	)
+	if s.ConferenceNumber != "" {
+		err = validate.Many(err, validate.Phone("ConferenceNumber", s.ConferenceNumber))
+	}
 	if err != nil {
 		return nil, err
 	}
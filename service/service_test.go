@@ -23,9 +23,11 @@ func TestService_Normalize(t *testing.T) {
 
 	valid := []Service{
 		{Name: "Sample Service", Description: "Sample Service", EscalationPolicyID: "A035FD3C-73C8-4F72-BECD-36B027AE1374"},
+		{Name: "Sample Service", Description: "Sample Service", EscalationPolicyID: "A035FD3C-73C8-4F72-BECD-36B027AE1374", ConferenceNumber: "+17633757777"},
 	}
 	invalid := []Service{
 		{},
+		{Name: "Sample Service", Description: "Sample Service", EscalationPolicyID: "A035FD3C-73C8-4F72-BECD-36B027AE1374", ConferenceNumber: "555-1234"},
 	}
 	for _, s := range valid {
 		test(true, s)
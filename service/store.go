@@ -22,6 +22,8 @@ type Store struct {
 	insert      *sql.Stmt
 	update      *sql.Stmt
 	delete      *sql.Stmt
+
+	setConfNumber *sql.Stmt
 }
 
 func NewStore(ctx context.Context, db *sql.DB) (*Store, error) {
@@ -37,7 +39,8 @@ func NewStore(ctx context.Context, db *sql.DB) (*Store, error) {
 			s.escalation_policy_id,
 			e.name,
 			fav	is distinct from null,
-			s.maintenance_expires_at
+			s.maintenance_expires_at,
+			s.conference_number
 		FROM
 			services s
 		JOIN escalation_policies e ON e.id = s.escalation_policy_id
@@ -50,7 +53,8 @@ func NewStore(ctx context.Context, db *sql.DB) (*Store, error) {
 			s.id,
 			s.name,
 			s.description,
-			s.escalation_policy_id
+			s.escalation_policy_id,
+			s.conference_number
 		FROM services s
 		WHERE s.id = $1
 		FOR UPDATE
@@ -63,7 +67,8 @@ func NewStore(ctx context.Context, db *sql.DB) (*Store, error) {
 			s.escalation_policy_id,
 			e.name,
 			fav	is distinct from null,
-			s.maintenance_expires_at
+			s.maintenance_expires_at,
+			s.conference_number
 		FROM
 			services s
 		JOIN escalation_policies e ON e.id = s.escalation_policy_id
@@ -80,7 +85,8 @@ func NewStore(ctx context.Context, db *sql.DB) (*Store, error) {
 			s.escalation_policy_id,
 			e.name,
 			false,
-			s.maintenance_expires_at
+			s.maintenance_expires_at,
+			s.conference_number
 		FROM
 			services s,
 			escalation_policies e
@@ -91,6 +97,7 @@ func NewStore(ctx context.Context, db *sql.DB) (*Store, error) {
 	s.insert = p(`INSERT INTO services (id,name,description,escalation_policy_id) VALUES ($1,$2,$3,$4)`)
 	s.update = p(`UPDATE services SET name = $2, description = $3, escalation_policy_id = $4, maintenance_expires_at = $5 WHERE id = $1`)
 	s.delete = p(`DELETE FROM services WHERE id = any($1)`)
+	s.setConfNumber = p(`UPDATE services SET conference_number = $2 WHERE id = $1`)
 
 	return s, prep.Err
 }
@@ -105,10 +112,12 @@ func (s *Store) FindOneForUpdate(ctx context.Context, tx *sql.Tx, id string) (*S
 		return nil, err
 	}
 	var svc Service
-	err = tx.StmtContext(ctx, s.findOneUp).QueryRowContext(ctx, id).Scan(&svc.ID, &svc.Name, &svc.Description, &svc.EscalationPolicyID)
+	var confNumber sql.NullString
+	err = tx.StmtContext(ctx, s.findOneUp).QueryRowContext(ctx, id).Scan(&svc.ID, &svc.Name, &svc.Description, &svc.EscalationPolicyID, &confNumber)
 	if err != nil {
 		return nil, err
 	}
+	svc.ConferenceNumber = confNumber.String
 	return &svc, nil
 }
 
@@ -207,6 +216,28 @@ func (s *Store) UpdateTx(ctx context.Context, tx *sql.Tx, svc *Service) error {
 	return err
 }
 
+// SetConferenceNumberTx will set (or clear, if empty) the conference number of a service.
+//
+// The number is dialed directly from voice calls, so only admins may change it; CreateServiceTx
+// and UpdateTx ignore the ConferenceNumber field.
+func (s *Store) SetConferenceNumberTx(ctx context.Context, tx *sql.Tx, id, number string) error {
+	err := permission.LimitCheckAny(ctx, permission.System, permission.Admin)
+	if err != nil {
+		return err
+	}
+
+	err = validate.UUID("ServiceID", id)
+	if number != "" {
+		err = validate.Many(err, validate.Phone("ConferenceNumber", number))
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = wrap(tx, s.setConfNumber).ExecContext(ctx, id, sql.NullString{String: number, Valid: number != ""})
+	return err
+}
+
 func (s *Store) FindOneForUser(ctx context.Context, userID, serviceID string) (*Service, error) {
 	err := validate.UUID("ServiceID", serviceID)
 	if err != nil {
@@ -248,11 +279,13 @@ func (s *Store) FindOne(ctx context.Context, id string) (*Service, error) {
 
 func scanFrom(s *Service, f func(args ...interface{}) error) error {
 	var maintExpiresAt sql.NullTime
-	err := f(&s.ID, &s.Name, &s.Description, &s.EscalationPolicyID, &s.epName, &s.isUserFavorite, &maintExpiresAt)
+	var confNumber sql.NullString
+	err := f(&s.ID, &s.Name, &s.Description, &s.EscalationPolicyID, &s.epName, &s.isUserFavorite, &maintExpiresAt, &confNumber)
 	if err != nil {
 		return err
 	}
 	s.MaintenanceExpiresAt = maintExpiresAt.Time
+	s.ConferenceNumber = confNumber.String
 	return nil
 }
 
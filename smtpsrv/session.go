@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"net/mail"
+	"net/textproto"
 	"strings"
 	"time"
 
@@ -17,6 +18,9 @@ import (
 	"github.com/target/goalert/validation/validate"
 )
 
+// SeverityHeader is the email header used to set the severity of created alerts.
+const SeverityHeader = "X-GoAlert-Severity"
+
 // Session implements an SMTP session that creates alerts.
 type Session struct {
 	cfg Config
@@ -156,6 +160,7 @@ func (s *Session) Data(r io.Reader) error {
 	if s.dedup != "" {
 		dedup = alert.NewUserDedup(s.dedup)
 	}
+	sev, _ := alert.ParseSeverity(textproto.MIMEHeader(email.Headers.ExtraHeaders).Get(SeverityHeader))
 
 	for _, authCtx := range s.authCtx {
 		newAlert := &alert.Alert{
@@ -164,6 +169,7 @@ func (s *Session) Data(r io.Reader) error {
 			ServiceID: permission.ServiceID(authCtx),
 			Status:    alert.StatusTriggered,
 			Source:    alert.SourceEmail,
+			Severity:  sev,
 			Dedup:     dedup,
 		}
 
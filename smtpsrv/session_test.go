@@ -97,4 +97,16 @@ func TestSession_Data(t *testing.T) {
 	err = sess.Data(strings.NewReader("Subject: test\r\n\r\nHello, world!"))
 	assert.NoError(t, err)
 	assert.True(t, createdAlert, "CreateAlertFunc not called")
+
+	createdAlert = false
+	sess.cfg.CreateAlertFunc = func(ctx context.Context, a *alert.Alert) error {
+		t.Helper()
+		createdAlert = true
+		assert.Equal(t, alert.SeverityLow, a.Severity)
+		return nil
+	}
+
+	err = sess.Data(strings.NewReader("Subject: test\r\nX-GoAlert-Severity: warning\r\n\r\nHello, world!"))
+	assert.NoError(t, err)
+	assert.True(t, createdAlert, "CreateAlertFunc not called")
 }
@@ -0,0 +1,64 @@
+import { test, expect } from '@playwright/test'
+import { userSessionFile } from './lib'
+import Chance from 'chance'
+import { createService } from './lib/service'
+const c = new Chance()
+
+test.describe.configure({ mode: 'parallel' })
+test.use({ storageState: userSessionFile })
+
+// Links in chat messages (e.g., Teams buttons) open the alert with an `action`
+// param; the action should only happen after it is confirmed in the UI.
+test('alert action links', async ({ page, isMobile }) => {
+  const name = 'pw-service ' + c.name()
+  await createService(page, name, c.sentence())
+
+  await page
+    .getByRole('link', {
+      name: 'Alerts Manage alerts specific to this service',
+    })
+    .click()
+
+  const summary = c.sentence({ words: 3 })
+  await page.getByRole('button', { name: 'Create Alert' }).click()
+  await page.getByLabel('Alert Summary').fill(summary)
+  await page.getByRole('button', { name: 'Next' }).click()
+  if (isMobile) {
+    await expect(page.getByText('Selected Services (1)' + name)).toBeVisible()
+  } else {
+    await expect(
+      page.getByRole('dialog', { name: 'Create New Alert' }).getByText(name),
+    ).toBeVisible()
+  }
+  await page.getByRole('button', { name: 'Submit' }).click()
+  await page.getByRole('button', { name: 'Done' }).click()
+
+  await page.getByRole('link', { name: ' UNACKNOWLEDGED ' + summary }).click()
+  await page.waitForURL(/\/alerts\/[0-9]+$/)
+  const alertURL = page.url()
+  const status = page.locator('[data-cy=alert-status]')
+  await expect(status).toHaveText('UNACKNOWLEDGED')
+
+  // cancelling should leave the alert unchanged
+  await page.goto(alertURL + '?action=ack')
+  await expect(page.getByRole('dialog')).toContainText('Acknowledge alert #')
+  await page.getByRole('button', { name: 'Cancel' }).click()
+  await expect(page.getByRole('dialog')).toBeHidden()
+  await expect(page).toHaveURL(alertURL)
+  await expect(status).toHaveText('UNACKNOWLEDGED')
+
+  await page.goto(alertURL + '?action=ack')
+  await page.getByRole('button', { name: 'Confirm' }).click()
+  await expect(page.getByRole('dialog')).toBeHidden()
+  await expect(status).toHaveText('ACKNOWLEDGED')
+
+  // no prompt if the alert is already acknowledged
+  await page.goto(alertURL + '?action=ack')
+  await expect(status).toHaveText('ACKNOWLEDGED')
+  await expect(page.getByRole('dialog')).toBeHidden()
+
+  await page.goto(alertURL + '?action=close')
+  await expect(page.getByRole('dialog')).toContainText('Close alert #')
+  await page.getByRole('button', { name: 'Confirm' }).click()
+  await expect(status).toHaveText('CLOSED')
+})
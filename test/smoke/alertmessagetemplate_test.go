@@ -0,0 +1,69 @@
+package smoke
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/test/smoke/harness"
+)
+
+// TestAlertMessageTemplate checks that a service message template is used to render
+// alert notifications, including alert metadata, and that templates can be previewed.
+func TestAlertMessageTemplate(t *testing.T) {
+	t.Parallel()
+
+	const sql = `
+	insert into users (id, name, email) 
+	values 
+		({{uuid "user"}}, 'bob', 'joe');
+	insert into user_contact_methods (id, user_id, name, type, value) 
+	values
+		({{uuid "cm1"}}, {{uuid "user"}}, 'personal', 'SMS', {{phone "1"}});
+	insert into user_notification_rules (user_id, contact_method_id, delay_minutes) 
+	values
+		({{uuid "user"}}, {{uuid "cm1"}}, 0);
+	insert into escalation_policies (id, name) 
+	values
+		({{uuid "eid"}}, 'esc policy');
+	insert into escalation_policy_steps (id, escalation_policy_id) 
+	values
+		({{uuid "esid"}}, {{uuid "eid"}});
+	insert into escalation_policy_actions (escalation_policy_step_id, user_id) 
+	values 
+		({{uuid "esid"}}, {{uuid "user"}});
+	insert into services (id, escalation_policy_id, name) 
+	values
+		({{uuid "sid"}}, {{uuid "eid"}}, 'web');
+	`
+
+	h := harness.NewHarness(t, sql, "")
+	defer h.Close()
+
+	h.GraphQLQueryT(t, `mutation{setServiceMessageTemplate(input:{
+		serviceID: "`+h.UUID("sid")+`",
+		destType: "builtin-twilio-sms",
+		summaryTemplate: "[{{.Meta.cluster}}] {{.ServiceName}}: {{.Summary}}",
+		detailsTemplate: ""
+	})}`)
+
+	res := h.GraphQLQueryT(t, `mutation{renderServiceMessageTemplate(input:{
+		serviceID: "`+h.UUID("sid")+`",
+		meta: [{key: "cluster", value: "prod-1"}],
+		summaryTemplate: "[{{.Meta.cluster}}] {{.ServiceName}}: {{.Summary}}",
+		detailsTemplate: ""
+	}){summary details}}`)
+	var preview struct {
+		RenderServiceMessageTemplate struct {
+			Summary string
+			Details string
+		}
+	}
+	require.NoError(t, json.Unmarshal(res.Data, &preview))
+	require.Equal(t, "[prod-1] web: Example alert summary", preview.RenderServiceMessageTemplate.Summary)
+	require.Equal(t, "Example alert details.", preview.RenderServiceMessageTemplate.Details)
+
+	h.GraphQLQueryT(t, `mutation{createAlert(input:{serviceID:"`+h.UUID("sid")+`",summary:"cpu high",meta:[{key:"cluster", value: "prod-2"}]}){id}}`)
+
+	h.Twilio(t).Device(h.Phone("1")).ExpectSMS("[prod-2] web: cpu high")
+}
@@ -0,0 +1,106 @@
+package smoke
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/test/smoke/harness"
+)
+
+// TestGenericAPIAck checks that `action=ack` acknowledges a de-duplicated alert,
+// stopping further notifications, and that the log entry is attributed to the key.
+func TestGenericAPIAck(t *testing.T) {
+	t.Parallel()
+
+	const sql = `
+	insert into users (id, name, email)
+	values
+		({{uuid "user"}}, 'bob', 'joe');
+
+	insert into user_contact_methods (id, user_id, name, type, value)
+	values
+		({{uuid "cm1"}}, {{uuid "user"}}, 'personal', 'SMS', {{phone "1"}});
+
+	insert into user_notification_rules (user_id, contact_method_id, delay_minutes)
+	values
+		({{uuid "user"}}, {{uuid "cm1"}}, 0),
+		({{uuid "user"}}, {{uuid "cm1"}}, 30);
+
+	insert into escalation_policies (id, name)
+	values
+		({{uuid "eid"}}, 'esc policy');
+
+	insert into escalation_policy_steps (id, escalation_policy_id)
+	values
+		({{uuid "esid"}}, {{uuid "eid"}});
+
+	insert into escalation_policy_actions (escalation_policy_step_id, user_id)
+	values
+		({{uuid "esid"}}, {{uuid "user"}});
+
+	insert into services (id, escalation_policy_id, name)
+	values
+		({{uuid "sid"}}, {{uuid "eid"}}, 'service');
+
+	insert into integration_keys (id, type, name, service_id)
+	values
+		({{uuid "int_key"}}, 'generic', 'my key', {{uuid "sid"}});
+`
+	h := harness.NewHarness(t, sql, "add-generic-integration-key")
+	defer h.Close()
+
+	fire := func(summary, action string) {
+		t.Helper()
+		v := make(url.Values)
+		v.Set("summary", summary)
+		v.Set("dedup", "my-dedup")
+		if action != "" {
+			v.Set("action", action)
+		}
+		resp, err := http.Post(h.URL()+"/v1/api/alerts?key="+h.UUID("int_key"), "application/x-www-form-urlencoded", bytes.NewBufferString(v.Encode()))
+		require.NoError(t, err)
+		require.Equal(t, 2, resp.StatusCode/100, "non-2xx response: %s", resp.Status)
+		resp.Body.Close()
+	}
+
+	d := h.Twilio(t).Device(h.Phone("1"))
+
+	fire("test1", "")
+	d.ExpectSMS("test1")
+
+	fire("", "ack")
+
+	h.FastForward(30 * time.Minute)
+	// acknowledged, no further SMS
+
+	var logs struct {
+		Alerts struct {
+			Nodes []struct {
+				Status       string
+				RecentEvents struct {
+					Nodes []struct{ Message string }
+				}
+			}
+		}
+	}
+	res := h.GraphQLQuery2(`{alerts{nodes{status recentEvents(input:{}){nodes{message}}}}}`)
+	require.Empty(t, res.Errors)
+	require.NoError(t, json.Unmarshal(res.Data, &logs))
+	require.Len(t, logs.Alerts.Nodes, 1)
+	assert.Equal(t, "StatusAcknowledged", logs.Alerts.Nodes[0].Status)
+
+	var found bool
+	for _, n := range logs.Alerts.Nodes[0].RecentEvents.Nodes {
+		if strings.HasPrefix(n.Message, "Acknowledged") && strings.Contains(n.Message, "my key") {
+			found = true
+		}
+	}
+	assert.True(t, found, "expected acknowledged log entry attributed to integration key")
+}
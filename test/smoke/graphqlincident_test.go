@@ -0,0 +1,108 @@
+package smoke
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/target/goalert/test/smoke/harness"
+)
+
+// TestGraphQLIncident checks that alerts can be grouped into an incident and
+// acknowledged/closed as a unit.
+func TestGraphQLIncident(t *testing.T) {
+	t.Parallel()
+
+	sql := `
+	insert into users (id, name, email, role) 
+	values 
+		({{uuid "user"}}, 'bob', 'joe', 'user');
+	insert into user_contact_methods (id, user_id, name, type, value) 
+	values
+		({{uuid "cm1"}}, {{uuid "user"}}, 'personal', 'SMS', {{phone "1"}});
+
+	insert into user_notification_rules (user_id, contact_method_id, delay_minutes) 
+	values
+		({{uuid "user"}}, {{uuid "cm1"}}, 0);
+
+	insert into escalation_policies (id, name) 
+	values
+		({{uuid "eid"}}, 'esc policy');
+	insert into escalation_policy_steps (id, escalation_policy_id) 
+	values
+		({{uuid "esid"}}, {{uuid "eid"}});
+	insert into escalation_policy_actions (escalation_policy_step_id, user_id) 
+	values 
+		({{uuid "esid"}}, {{uuid "user"}});
+
+	insert into services (id, escalation_policy_id, name) 
+	values
+		({{uuid "sid"}}, {{uuid "eid"}}, 'service');
+
+	insert into alerts (id, service_id, description) 
+	values
+		(1, {{uuid "sid"}}, 'first'),
+		(2, {{uuid "sid"}}, 'second');
+`
+	h := harness.NewHarness(t, sql, "ids-to-uuids")
+	defer h.Close()
+
+	d1 := h.Twilio(t).Device(h.Phone("1"))
+	d1.ExpectSMS("first")
+	d1.ExpectSMS("second")
+
+	var created struct {
+		CreateIncident struct {
+			ID int
+		}
+	}
+	resp := h.GraphQLQueryT(t, `mutation{createIncident(input:{title: "outage", alertIDs: [1, 2]}){id}}`)
+	err := json.Unmarshal(resp.Data, &created)
+	if err != nil {
+		t.Fatal("parse response:", err)
+	}
+	id := created.CreateIncident.ID
+
+	type incidentResp struct {
+		Incident struct {
+			Status string
+			Alerts []struct {
+				Status string
+			}
+			Updates []struct {
+				Message string
+			}
+		}
+	}
+	check := func(wantStatus string) {
+		t.Helper()
+		var res incidentResp
+		resp := h.GraphQLQueryT(t, fmt.Sprintf(`query{incident(id: %d){status alerts{status} updates{message}}}`, id))
+		err := json.Unmarshal(resp.Data, &res)
+		if err != nil {
+			t.Fatal("parse response:", err)
+		}
+		if res.Incident.Status != wantStatus {
+			t.Errorf("incident status = %s; want %s", res.Incident.Status, wantStatus)
+		}
+		if len(res.Incident.Alerts) != 2 {
+			t.Fatalf("got %d alerts; want 2", len(res.Incident.Alerts))
+		}
+		for _, a := range res.Incident.Alerts {
+			if a.Status != wantStatus {
+				t.Errorf("alert status = %s; want %s", a.Status, wantStatus)
+			}
+		}
+		if len(res.Incident.Updates) == 0 {
+			t.Error("expected incident updates")
+		}
+	}
+
+	check("StatusUnacknowledged")
+
+	h.GraphQLQueryT(t, fmt.Sprintf(`mutation{updateIncident(input:{id: %d, newStatus: StatusAcknowledged})}`, id))
+	check("StatusAcknowledged")
+
+	h.GraphQLQueryT(t, fmt.Sprintf(`mutation{updateIncident(input:{id: %d, newStatus: StatusClosed})}`, id))
+	check("StatusClosed")
+}
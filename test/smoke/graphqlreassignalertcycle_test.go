@@ -0,0 +1,69 @@
+package smoke
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/target/goalert/test/smoke/harness"
+)
+
+// TestGraphQLReassignAlertCycle checks that users notified by the previous escalation policy
+// stop being notified after an alert is reassigned.
+func TestGraphQLReassignAlertCycle(t *testing.T) {
+	t.Parallel()
+
+	sql := `
+	insert into users (id, name, email, role)
+	values
+		({{uuid "u1"}}, 'bob', 'bob@test.com', 'user'),
+		({{uuid "u2"}}, 'joe', 'joe@test.com', 'user');
+	insert into user_contact_methods (id, user_id, name, type, value)
+	values
+		({{uuid "cm1"}}, {{uuid "u1"}}, 'personal', 'SMS', {{phone "1"}}),
+		({{uuid "cm2"}}, {{uuid "u2"}}, 'personal', 'SMS', {{phone "2"}});
+
+	insert into user_notification_rules (user_id, contact_method_id, delay_minutes)
+	values
+		({{uuid "u1"}}, {{uuid "cm1"}}, 0),
+		({{uuid "u1"}}, {{uuid "cm1"}}, 5),
+		({{uuid "u2"}}, {{uuid "cm2"}}, 0);
+
+	insert into escalation_policies (id, name)
+	values
+		({{uuid "ep1"}}, 'esc policy 1'),
+		({{uuid "ep2"}}, 'esc policy 2');
+	insert into escalation_policy_steps (id, escalation_policy_id)
+	values
+		({{uuid "es1"}}, {{uuid "ep1"}}),
+		({{uuid "es2"}}, {{uuid "ep2"}});
+	insert into escalation_policy_actions (escalation_policy_step_id, user_id)
+	values
+		({{uuid "es1"}}, {{uuid "u1"}}),
+		({{uuid "es2"}}, {{uuid "u2"}});
+
+	insert into services (id, escalation_policy_id, name)
+	values
+		({{uuid "s1"}}, {{uuid "ep1"}}, 'service 1'),
+		({{uuid "s2"}}, {{uuid "ep2"}}, 'service 2');
+
+	insert into alerts (id, service_id, description)
+	values
+		(1, {{uuid "s1"}}, 'testing');
+`
+	h := harness.NewHarness(t, sql, "ids-to-uuids")
+	defer h.Close()
+
+	tw := h.Twilio(t)
+	d1 := tw.Device(h.Phone("1"))
+	d2 := tw.Device(h.Phone("2"))
+
+	d1.ExpectSMS("testing")
+
+	h.GraphQLQueryT(t, fmt.Sprintf(`mutation{reassignAlert(input:{alertID: 1, serviceID: "%s"}){id}}`, h.UUID("s2")))
+
+	d2.ExpectSMS("testing")
+
+	// bob's 5 minute rule should not fire, since the alert no longer belongs to ep1
+	h.FastForward(10 * time.Minute)
+}
@@ -0,0 +1,63 @@
+package smoke
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/test/smoke/harness"
+)
+
+// TestGraphQLServiceConferenceNumber checks that only admins can change the conference number of a service.
+func TestGraphQLServiceConferenceNumber(t *testing.T) {
+	t.Parallel()
+
+	sql := `
+	insert into users (id, name, email, role)
+	values
+		({{uuid "user"}}, 'bob', 'joe', 'user');
+
+	insert into escalation_policies (id, name)
+	values
+		({{uuid "eid"}}, 'esc policy');
+
+	insert into services (id, escalation_policy_id, name)
+	values
+		({{uuid "sid"}}, {{uuid "eid"}}, 'service');
+`
+	h := harness.NewHarness(t, sql, "ids-to-uuids")
+	defer h.Close()
+
+	update := func(userID, fields string) *harness.QLResponse {
+		t.Helper()
+		return h.GraphQLQueryUserT(t, userID, fmt.Sprintf(`mutation{updateService(input:{id: "%s", %s})}`, h.UUID("sid"), fields))
+	}
+	number := func() string {
+		t.Helper()
+		resp := h.GraphQLQueryT(t, fmt.Sprintf(`query{service(id: "%s"){conferenceNumber}}`, h.UUID("sid")))
+		require.Empty(t, resp.Errors)
+		var res struct {
+			Service struct{ ConferenceNumber string }
+		}
+		require.NoError(t, json.Unmarshal(resp.Data, &res))
+		return res.Service.ConferenceNumber
+	}
+
+	resp := update(h.UUID("user"), fmt.Sprintf(`conferenceNumber: "%s"`, h.Phone("1")))
+	assert.NotEmpty(t, resp.Errors, "non-admin should not be able to set conference number")
+	assert.Empty(t, number())
+
+	resp = update(harness.DefaultGraphQLAdminUserID, fmt.Sprintf(`conferenceNumber: "%s"`, h.Phone("1")))
+	require.Empty(t, resp.Errors)
+	assert.Equal(t, h.Phone("1"), number())
+
+	// unchanged number should not prevent non-admins from updating other fields
+	resp = update(h.UUID("user"), fmt.Sprintf(`description: "new", conferenceNumber: "%s"`, h.Phone("1")))
+	require.Empty(t, resp.Errors)
+
+	resp = update(h.UUID("user"), `conferenceNumber: ""`)
+	assert.NotEmpty(t, resp.Errors, "non-admin should not be able to clear conference number")
+	assert.Equal(t, h.Phone("1"), number())
+}
@@ -352,7 +352,7 @@ func (h *Harness) StartWithAppCfgHook(fn func(*app.Config)) {
 		h.t.Fatalf("failed to start backend: %v", err)
 	}
 	h.TwilioNumber("") // register default number
-	h.slack.SetActionURL(h.slackApp.ClientID, h.backend.URL()+"/api/v2/slack/message-action")
+	h.setSlackURLs()
 
 	go func() {
 		assert.NoError(h.t, h.backend.Run(context.Background())) // can't use require.NoError because we're in the background
@@ -363,6 +363,13 @@ func (h *Harness) StartWithAppCfgHook(fn func(*app.Config)) {
 	}
 }
 
+// setSlackURLs will point the Slack app's interaction endpoints at the current backend.
+func (h *Harness) setSlackURLs() {
+	h.slack.SetActionURL(h.slackApp.ClientID, h.backend.URL()+"/api/v2/slack/message-action")
+	h.slack.SetOptionsURL(h.slackApp.ClientID, h.backend.URL()+"/api/v2/slack/menu-options")
+	h.slack.SetCommandURL(h.slackApp.ClientID, h.backend.URL()+"/api/v2/slack/command")
+}
+
 // RestartGoAlertWithConfig will restart the backend with the provided config.
 func (h *Harness) RestartGoAlertWithConfig(cfg config.Config) {
 	h.t.Helper()
@@ -390,7 +397,7 @@ func (h *Harness) RestartGoAlertWithAppCfgHook(reconfig func(*app.Config)) {
 	if err != nil {
 		h.t.Fatalf("failed to start backend: %v", err)
 	}
-	h.slack.SetActionURL(h.slackApp.ClientID, h.backend.URL()+"/api/v2/slack/message-action")
+	h.setSlackURLs()
 
 	go func() {
 		assert.NoError(h.t, h.backend.Run(context.Background())) // can't use require.NoError because we're in the background
@@ -485,6 +492,7 @@ func (h *Harness) execQuery(sql string, data interface{}) {
 		"slackChannelID":   func(name string) string { return fmt.Sprintf("'%s'", h.Slack().Channel(name).ID()) },
 		"slackUserID":      func(name string) string { return fmt.Sprintf("'%s'", h.Slack().User(name).ID()) },
 		"slackUserGroupID": func(name string) string { return fmt.Sprintf("'%s'", h.Slack().UserGroup(name).ID()) },
+		"slackTeamID":      func() string { return fmt.Sprintf("'%s'", h.slack.TeamID()) },
 	})
 	_, err := t.Parse(sql)
 	if err != nil {
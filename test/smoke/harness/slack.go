@@ -1,15 +1,18 @@
 package harness
 
 import (
+	"context"
 	"fmt"
 	"net/http/httptest"
 	"sort"
 	"strings"
 	"time"
 
+	"github.com/riverqueue/river"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/target/goalert/devtools/mockslack"
+	"github.com/target/goalert/engine/usergroupmanager"
 )
 
 const (
@@ -29,6 +32,24 @@ type SlackUser interface {
 	Name() string
 
 	ExpectMessage(keywords ...string) SlackMessage
+
+	// Command runs the `/goalert` slash command as the user in the given channel, and returns the
+	// response, or nil if there was none (e.g., a modal was opened instead).
+	Command(ch SlackChannel, text string) SlackMessageState
+
+	// ExpectModal waits and asserts that a modal with the given title is opened for the user.
+	ExpectModal(title string) SlackModal
+}
+
+type SlackModal interface {
+	// Options returns the text of the options for the select input with the given label, filtered by query.
+	Options(label, query string) []string
+
+	// Submit will submit the modal with the given values, keyed by input label. For selects, the value is
+	// the value of the selected option.
+	//
+	// The response body is returned, which is empty if the modal was closed.
+	Submit(values map[string]string) string
 }
 
 type SlackChannel interface {
@@ -44,6 +65,12 @@ type SlackUserGroup interface {
 	Name() string
 	ErrorChannel() SlackChannel
 
+	// UserGroupID returns the Slack ID of the user group, without the error channel.
+	UserGroupID() string
+
+	// SetUsers will replace the users of the user group, as if changed outside of GoAlert.
+	SetUsers(names ...string)
+
 	ExpectUsers(names ...string)
 	ExpectUserIDs(ids ...string)
 }
@@ -160,6 +187,89 @@ func (a *slackAction) Click() {
 
 func (h *Harness) Slack() SlackServer { return h.slack }
 
+func (ch *slackChannel) Command(cmdCh SlackChannel, text string) SlackMessageState {
+	ch.h.t.Helper()
+
+	ch.h.t.Logf("running slash command as %s in %s: /goalert %s", ch.name, cmdCh.Name(), text)
+	resp, err := ch.h.slack.SlashCommandAs(ch.id, mockslack.SlashCommand{
+		AppID:     ch.h.slackApp.ClientID,
+		ChannelID: cmdCh.ID(),
+		Command:   "/goalert",
+		Text:      text,
+	})
+	require.NoError(ch.h.t, err, "run slash command")
+	if resp == nil {
+		return nil
+	}
+
+	ch.h.t.Logf("slash command response (%s): %s", resp.Type, resp.Text)
+	c, _ := cmdCh.(*slackChannel)
+	return &slackMessage{h: ch.h, channel: c, Message: resp.Message}
+}
+
+type slackModal struct {
+	h    *Harness
+	user *slackChannel
+	mockslack.View
+}
+
+func (ch *slackChannel) ExpectModal(title string) SlackModal {
+	ch.h.t.Helper()
+
+	var found *mockslack.View
+	require.Eventually(ch.h.t, func() bool {
+		for _, v := range ch.h.slack.UserViews(ch.id) {
+			if v.Title == title {
+				found = &v
+				return true
+			}
+		}
+		return false
+	}, 15*time.Second, 100*time.Millisecond, "expected to find Slack modal: User=%s; Title=%s", ch.name, title)
+
+	return &slackModal{h: ch.h, user: ch, View: *found}
+}
+
+// actionID returns the action ID of the input with the given label.
+func (m *slackModal) actionID(label string) string {
+	m.h.t.Helper()
+
+	for _, in := range m.Inputs {
+		if in.Label == label {
+			return in.ActionID
+		}
+	}
+
+	require.FailNowf(m.h.t, "unknown modal input", "Label=%s; Inputs=%#v", label, m.Inputs)
+	return ""
+}
+
+func (m *slackModal) Options(label, query string) []string {
+	m.h.t.Helper()
+
+	opts, err := m.h.slack.MenuOptionsAs(m.user.id, m.ID, m.actionID(label), query)
+	require.NoError(m.h.t, err, "load Slack menu options")
+
+	text := make([]string, len(opts))
+	for i, o := range opts {
+		text[i] = o.Text
+	}
+	return text
+}
+
+func (m *slackModal) Submit(values map[string]string) string {
+	m.h.t.Helper()
+
+	byAction := make(map[string]string, len(values))
+	for label, val := range values {
+		byAction[m.actionID(label)] = val
+	}
+
+	data, err := m.h.slack.SubmitViewAs(m.user.id, m.ID, byAction)
+	require.NoError(m.h.t, err, "submit Slack modal")
+	return string(data)
+}
+
 func (s *slackServer) WaitAndAssert() {
 	s.h.t.Helper()
 	timeout := time.NewTimer(15 * time.Second)
@@ -235,6 +345,15 @@ func (s *slackServer) UserGroup(name string) SlackUserGroup {
 func (ug *slackUserGroup) ID() string                 { return ug.ugID + ":" + ug.channel.ID() }
 func (ug *slackUserGroup) Name() string               { return ug.name }
 func (ug *slackUserGroup) ErrorChannel() SlackChannel { return ug.channel }
+func (ug *slackUserGroup) UserGroupID() string        { return ug.ugID }
+
+func (ug *slackUserGroup) SetUsers(names ...string) {
+	var ids []string
+	for _, name := range names {
+		ids = append(ids, ug.h.Slack().User(name).ID())
+	}
+	ug.h.slack.SetUserGroupUserIDs(ug.ugID, ids)
+}
 
 func (ug *slackUserGroup) ExpectUsers(names ...string) {
 	ug.h.t.Helper()
@@ -377,6 +496,15 @@ func (msg *slackMessage) ExpectBroadcastReply(keywords ...string) {
 	assert.True(msg.h.t, reply.Broadcast, "expected thread reply to be broadcast")
 }
 
+// SyncSlackUserGroups will queue a sync of all Slack user groups kept in sync with rotations and escalation policy steps.
+//
+// Syncs otherwise only run periodically.
+func (h *Harness) SyncSlackUserGroups() {
+	h.t.Helper()
+	_, err := h.backend.River.Insert(context.Background(), usergroupmanager.SyncArgs{}, &river.InsertOpts{Queue: usergroupmanager.QueueName})
+	require.NoError(h.t, err, "queue user group sync")
+}
+
 func (h *Harness) initSlack() {
 	h.slack = &slackServer{
 		h:        h,
@@ -0,0 +1,68 @@
+package smoke
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/test/smoke/harness"
+)
+
+// TestIntKeyAutoClose verifies that alerts from an integration key with auto-close enabled
+// are closed once no new or duplicate events have been received for the configured time.
+func TestIntKeyAutoClose(t *testing.T) {
+	t.Parallel()
+
+	const sql = `
+	insert into escalation_policies (id, name)
+	values
+		({{uuid "eid"}}, 'esc policy');
+	insert into services (id, escalation_policy_id, name)
+	values
+		({{uuid "sid"}}, {{uuid "eid"}}, 'service');
+	insert into integration_keys (id, type, name, service_id, auto_close_minutes)
+	values
+		({{uuid "int_key"}}, 'generic', 'my key', {{uuid "sid"}}, 10);
+`
+	h := harness.NewHarness(t, sql, "add-generic-integration-key")
+	defer h.Close()
+
+	fire := func() {
+		t.Helper()
+		v := make(url.Values)
+		v.Set("summary", "cpu high")
+		resp, err := http.Post(h.URL()+"/v1/api/alerts?key="+h.UUID("int_key"), "application/x-www-form-urlencoded", bytes.NewBufferString(v.Encode()))
+		require.NoError(t, err)
+		require.Equal(t, 2, resp.StatusCode/100, "non-2xx response: %s", resp.Status)
+		resp.Body.Close()
+	}
+	status := func() string {
+		t.Helper()
+		var data struct {
+			Alerts struct {
+				Nodes []struct{ Status string }
+			}
+		}
+		res := h.GraphQLQuery2(`{alerts{nodes{status}}}`)
+		require.Empty(t, res.Errors)
+		require.NoError(t, json.Unmarshal(res.Data, &data))
+		require.Len(t, data.Alerts.Nodes, 1)
+		return data.Alerts.Nodes[0].Status
+	}
+
+	fire()
+	h.FastForward(8 * time.Minute)
+	fire() // duplicate resets the timer
+	h.FastForward(8 * time.Minute)
+	h.Trigger()
+	assert.Equal(t, "StatusUnacknowledged", status(), "duplicate should keep alert open")
+
+	h.FastForward(3 * time.Minute)
+	h.Trigger()
+	assert.Equal(t, "StatusClosed", status())
+}
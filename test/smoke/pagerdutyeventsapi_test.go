@@ -0,0 +1,95 @@
+package smoke
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/test/smoke/harness"
+)
+
+// TestPagerDutyEventsAPI checks that events sent to the PagerDuty Events API v2 compatible
+// endpoint create, de-duplicate, and resolve alerts by dedup_key.
+func TestPagerDutyEventsAPI(t *testing.T) {
+	t.Parallel()
+
+	const sql = `
+	insert into users (id, name, email)
+	values
+		({{uuid "user"}}, 'bob', 'joe');
+	insert into user_contact_methods (id, user_id, name, type, value)
+	values
+		({{uuid "cm1"}}, {{uuid "user"}}, 'personal', 'SMS', {{phone "1"}});
+	insert into user_notification_rules (user_id, contact_method_id, delay_minutes)
+	values
+		({{uuid "user"}}, {{uuid "cm1"}}, 0);
+	insert into escalation_policies (id, name)
+	values
+		({{uuid "eid"}}, 'esc policy');
+	insert into escalation_policy_steps (id, escalation_policy_id)
+	values
+		({{uuid "esid"}}, {{uuid "eid"}});
+	insert into escalation_policy_actions (escalation_policy_step_id, user_id)
+	values
+		({{uuid "esid"}}, {{uuid "user"}});
+	insert into services (id, escalation_policy_id, name)
+	values
+		({{uuid "sid"}}, {{uuid "eid"}}, 'service');
+	insert into integration_keys (id, type, name, service_id)
+	values
+		({{uuid "int_key"}}, 'pagerduty', 'my key', {{uuid "sid"}});
+`
+	h := harness.NewHarness(t, sql, "")
+	defer h.Close()
+
+	send := func(action, summary, dedup string) string {
+		t.Helper()
+		ev := map[string]any{
+			"routing_key":  h.UUID("int_key"),
+			"event_action": action,
+			"dedup_key":    dedup,
+			"payload": map[string]any{
+				"summary":  summary,
+				"source":   "test",
+				"severity": "critical",
+			},
+		}
+		data, err := json.Marshal(ev)
+		require.NoError(t, err)
+		resp, err := http.Post(h.URL()+"/v2/enqueue", "application/json", bytes.NewReader(data))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusAccepted, resp.StatusCode)
+
+		var body struct {
+			Status   string `json:"status"`
+			DedupKey string `json:"dedup_key"`
+		}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+		require.Equal(t, "success", body.Status)
+		if dedup == "" {
+			require.NotEmpty(t, body.DedupKey)
+		} else {
+			require.Equal(t, dedup, body.DedupKey)
+		}
+		return body.DedupKey
+	}
+
+	d1 := h.Twilio(t).Device(h.Phone("1"))
+
+	send("trigger", "disk full", "srv01/disk")
+	send("trigger", "disk full again", "srv01/disk") // de-duplicated
+	d1.ExpectSMS("disk full")
+
+	send("resolve", "", "srv01/disk")
+	send("trigger", "disk full", "srv01/disk")
+	d1.ExpectSMS("disk full") // new alert after resolve
+
+	// a retried trigger without a dedup_key should get the same generated key
+	key := send("trigger", "cpu high", "")
+	require.Equal(t, key, send("trigger", "cpu high", ""))
+	d1.ExpectSMS("cpu high")
+	send("resolve", "", key)
+}
@@ -0,0 +1,98 @@
+package smoke
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/test/smoke/harness"
+)
+
+// TestSlackCommand checks that the `/goalert` slash command and the create alert modal work for linked
+// users, and that unlinked users are prompted to link their account.
+func TestSlackCommand(t *testing.T) {
+	t.Parallel()
+
+	const sql = `
+	insert into users (id, name, email, role)
+	values
+		({{uuid "bob"}}, 'bob', 'bob@example.com', 'user');
+
+	insert into auth_subjects (provider_id, subject_id, user_id)
+	values
+		('slack:' || {{slackTeamID}}, {{slackUserID "bob"}}, {{uuid "bob"}});
+
+	insert into escalation_policies (id, name)
+	values
+		({{uuid "eid"}}, 'esc policy');
+
+	insert into services (id, escalation_policy_id, name)
+	values
+		({{uuid "sid"}}, {{uuid "eid"}}, 'Widget Service'),
+		({{uuid "other"}}, {{uuid "eid"}}, 'Other Service');
+
+	insert into alerts (id, service_id, summary, status)
+	values
+		(1234, {{uuid "sid"}}, 'first alert', 'triggered'),
+		(1235, {{uuid "sid"}}, 'done alert', 'closed');
+`
+	h := harness.NewHarness(t, sql, "")
+	defer h.Close()
+
+	h.SetConfigValue("Slack.InteractiveMessages", "true")
+
+	ch := h.Slack().Channel("test")
+	bob := h.Slack().User("bob")
+	joe := h.Slack().User("joe")
+
+	t.Run("bad signature", func(t *testing.T) {
+		v := url.Values{"command": {"/goalert"}, "text": {"ack 1234"}, "user_id": {bob.ID()}}
+		resp, err := http.Post(h.URL()+"/api/v2/slack/command", "application/x-www-form-urlencoded", strings.NewReader(v.Encode()))
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	// unlinked users should get a link prompt, that will ack the alert once linked
+	msg := joe.Command(ch, "ack 1234")
+	require.NotNil(t, msg)
+	msg.AssertText("link", "Slack", "account")
+	u, err := url.Parse(msg.Action("Link Account").URL())
+	require.NoError(t, err)
+
+	resp := h.GraphQLQuery2(fmt.Sprintf(`query{linkAccountInfo(token: "%s"){alertID alertNewStatus}}`, u.Query().Get("authLinkToken")))
+	require.Empty(t, resp.Errors)
+	var info struct {
+		LinkAccountInfo struct {
+			AlertID        int
+			AlertNewStatus string
+		}
+	}
+	require.NoError(t, json.Unmarshal(resp.Data, &info))
+	assert.Equal(t, 1234, info.LinkAccountInfo.AlertID)
+	assert.Equal(t, "StatusAcknowledged", info.LinkAccountInfo.AlertNewStatus)
+
+	bob.Command(ch, "help").AssertText("Usage:")
+	bob.Command(ch, "ack 1234").AssertText("Acknowledged", "first alert")
+	bob.Command(ch, "ack 1234").AssertText("already acknowledged")
+	bob.Command(ch, "escalate 1235").AssertText("already closed", "done alert")
+
+	require.Nil(t, bob.Command(ch, "create alert"), "modal should be opened instead")
+	modal := bob.ExpectModal("Create Alert")
+	assert.Equal(t, []string{"Widget Service"}, modal.Options("Service", "widget"))
+	assert.ElementsMatch(t, []string{"Widget Service", "Other Service"}, modal.Options("Service", ""))
+
+	res := modal.Submit(map[string]string{
+		"Service": h.UUID("sid"),
+		"Summary": "from slack",
+		"Details": "some details",
+	})
+	assert.Empty(t, res, "modal should be closed")
+
+	ch.ExpectEphemeralMessage("Created", "from slack")
+}
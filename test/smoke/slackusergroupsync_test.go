@@ -0,0 +1,101 @@
+package smoke
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/test/smoke/harness"
+)
+
+// TestSlackUserGroupSync tests that a synced Slack user group is kept up to date with the on-call users
+// of an escalation policy step, that outside changes are reverted and recorded as drift, and that a
+// user group updated by a schedule can't also be synced.
+func TestSlackUserGroupSync(t *testing.T) {
+	t.Parallel()
+
+	const sql = `
+	insert into users (id, name, email)
+	values
+		({{uuid "bob"}}, 'bob', 'bob@example.com'),
+		({{uuid "joe"}}, 'joe', 'joe@example.com');
+
+	insert into auth_subjects (provider_id, subject_id, user_id)
+	values
+		('slack:' || {{slackTeamID}}, {{slackUserID "bob"}}, {{uuid "bob"}});
+
+	insert into escalation_policies (id, name)
+	values
+		({{uuid "ep"}}, 'esc policy');
+	insert into escalation_policy_steps (id, escalation_policy_id)
+	values
+		({{uuid "step"}}, {{uuid "ep"}});
+	insert into escalation_policy_actions (escalation_policy_step_id, user_id)
+	values
+		({{uuid "step"}}, {{uuid "bob"}}),
+		({{uuid "step"}}, {{uuid "joe"}});
+
+	insert into schedules (id, name, time_zone)
+	values
+		({{uuid "sid"}}, 'testschedule', 'UTC');
+	insert into notification_channels (id, type, name, value)
+	values
+		({{uuid "ug"}}, 'SLACK_USER_GROUP', '@sched (#test)', {{slackUserGroupID "sched"}});
+	insert into schedule_data (schedule_id, data)
+	values
+		({{uuid "sid"}}, '{"V1":{"OnCallNotificationRules": [{"ChannelID": {{uuidJSON "ug"}}}]}}');
+`
+	h := harness.NewHarness(t, sql, "")
+	defer h.Close()
+
+	ug := h.Slack().UserGroup("oncall")
+	resp := h.GraphQLQuery2(fmt.Sprintf(`mutation{createSlackUserGroupSync(input:{userGroupID: "%s", escalationPolicyStepID: "%s"}){id}}`, ug.UserGroupID(), h.UUID("step")))
+	require.Empty(t, resp.Errors, "create sync")
+
+	resp = h.GraphQLQuery2(fmt.Sprintf(`mutation{createSlackUserGroupSync(input:{userGroupID: "%s", escalationPolicyStepID: "%s"}){id}}`, h.Slack().UserGroup("sched").UserGroupID(), h.UUID("step")))
+	require.NotEmpty(t, resp.Errors, "user group already updated by a schedule")
+
+	type syncState struct {
+		LastError       string
+		DriftDetectedAt *time.Time
+	}
+	getSync := func() (s syncState) {
+		t.Helper()
+		resp := h.GraphQLQuery2(fmt.Sprintf(`{escalationPolicy(id: "%s"){steps{slackUserGroupSyncs{lastError, driftDetectedAt}}}}`, h.UUID("ep")))
+		require.Empty(t, resp.Errors)
+
+		var data struct {
+			EscalationPolicy struct {
+				Steps []struct {
+					SlackUserGroupSyncs []syncState
+				}
+			}
+		}
+		require.NoError(t, json.Unmarshal(resp.Data, &data))
+		require.Len(t, data.EscalationPolicy.Steps, 1)
+		require.Len(t, data.EscalationPolicy.Steps[0].SlackUserGroupSyncs, 1)
+		return data.EscalationPolicy.Steps[0].SlackUserGroupSyncs[0]
+	}
+
+	// update on-call users for the step before syncing
+	h.Trigger()
+	h.SyncSlackUserGroups()
+
+	// joe hasn't linked their Slack account, but bob should still be synced
+	ug.ExpectUsers("bob")
+	assert.EventuallyWithT(t, func(t *assert.CollectT) {
+		assert.Contains(t, getSync().LastError, "1 on-call user(s) have not linked their Slack account")
+	}, 15*time.Second, 100*time.Millisecond)
+	assert.Nil(t, getSync().DriftDetectedAt, "no drift on the first sync")
+
+	// changes made in Slack should be reverted and recorded
+	ug.SetUsers("joe")
+	h.SyncSlackUserGroups()
+	ug.ExpectUsers("bob")
+	assert.EventuallyWithT(t, func(t *assert.CollectT) {
+		assert.NotNil(t, getSync().DriftDetectedAt)
+	}, 15*time.Second, 100*time.Millisecond)
+}
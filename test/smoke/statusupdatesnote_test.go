@@ -0,0 +1,61 @@
+package smoke
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/target/goalert/test/smoke/harness"
+)
+
+// TestStatusUpdatesNote checks that alert notes are sent to subscribers only when requested.
+func TestStatusUpdatesNote(t *testing.T) {
+	t.Parallel()
+
+	sql := `
+	insert into users (id, name, email, role) 
+	values 
+		({{uuid "user"}}, 'bob', 'joe@test.com', 'user');
+	insert into user_contact_methods (id, user_id, name, type, value, enable_status_updates) 
+	values
+		({{uuid "cm1"}}, {{uuid "user"}}, 'personal', 'SMS', {{phone "1"}}, true);
+
+	insert into user_notification_rules (user_id, contact_method_id, delay_minutes) 
+	values
+		({{uuid "user"}}, {{uuid "cm1"}}, 0);
+
+	insert into escalation_policies (id, name) 
+	values
+		({{uuid "eid"}}, 'esc policy');
+	insert into escalation_policy_steps (id, escalation_policy_id) 
+	values
+		({{uuid "esid"}}, {{uuid "eid"}});
+	insert into escalation_policy_actions (escalation_policy_step_id, user_id) 
+	values 
+		({{uuid "esid"}}, {{uuid "user"}});
+
+	insert into services (id, escalation_policy_id, name) 
+	values
+		({{uuid "sid"}}, {{uuid "eid"}}, 'service');
+
+	insert into alerts (id, service_id, description) 
+	values
+		(1, {{uuid "sid"}}, 'testing');
+`
+	h := harness.NewHarness(t, sql, "alert-status-updates")
+	defer h.Close()
+
+	addNote := func(note string, notify bool) {
+		h.GraphQLQueryT(t, fmt.Sprintf(`mutation{addAlertNote(input:{alertID: 1, note: %q, notifySubscribers: %t})}`, note, notify))
+	}
+
+	tw := h.Twilio(t)
+	d1 := tw.Device(h.Phone("1"))
+
+	d1.ExpectSMS("testing")
+
+	addNote("quiet note", false)
+	h.Trigger()
+
+	addNote("looking into it", true)
+	d1.ExpectSMS("looking into it")
+}
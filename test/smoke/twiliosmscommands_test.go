@@ -0,0 +1,72 @@
+package smoke
+
+import (
+	"testing"
+	"time"
+
+	"github.com/target/goalert/test/smoke/harness"
+)
+
+// TestTwilioSMSCommands checks that SMS commands (help, oncall, esc, and snooze with a duration) are processed.
+func TestTwilioSMSCommands(t *testing.T) {
+	t.Parallel()
+
+	sql := `
+	insert into users (id, name, email, role)
+	values
+		({{uuid "user"}}, 'bob', 'joe', 'user');
+	insert into user_contact_methods (id, user_id, name, type, value)
+	values
+		({{uuid "cm1"}}, {{uuid "user"}}, 'personal', 'SMS', {{phone "1"}});
+
+	insert into user_notification_rules (user_id, contact_method_id, delay_minutes)
+	values
+		({{uuid "user"}}, {{uuid "cm1"}}, 0);
+
+	insert into escalation_policies (id, name)
+	values
+		({{uuid "eid"}}, 'esc policy');
+	insert into escalation_policy_steps (id, escalation_policy_id)
+	values
+		({{uuid "esid"}}, {{uuid "eid"}});
+	insert into escalation_policy_actions (escalation_policy_step_id, user_id)
+	values
+		({{uuid "esid"}}, {{uuid "user"}});
+
+	insert into services (id, escalation_policy_id, name)
+	values
+		({{uuid "sid"}}, {{uuid "eid"}}, 'my service');
+
+	insert into user_favorites (user_id, tgt_service_id)
+	values
+		({{uuid "user"}}, {{uuid "sid"}});
+
+	insert into alerts (id, service_id, description)
+	values
+		(198, {{uuid "sid"}}, 'testing');
+`
+	h := harness.NewHarness(t, sql, "ids-to-uuids")
+	defer h.Close()
+
+	d1 := h.Twilio(t).Device(h.Phone("1"))
+
+	d1.ExpectSMS("testing").
+		ThenReply("help").
+		ThenExpect("commands", "oncall")
+
+	d1.SendSMS("oncall")
+	d1.ExpectSMS("my service", "bob")
+
+	d1.SendSMS("esc 198")
+	d1.ExpectSMS("escalation requested", "198")
+	d1.ExpectSMS("testing")
+
+	d1.SendSMS("snooze 198 2h")
+	d1.ExpectSMS("snoozed", "198", "2 hours")
+
+	h.FastForward(90 * time.Minute)
+	h.Trigger()
+
+	h.FastForward(30 * time.Minute)
+	d1.ExpectSMS("testing")
+}
@@ -0,0 +1,65 @@
+package smoke
+
+import (
+	"testing"
+
+	"github.com/target/goalert/test/smoke/harness"
+)
+
+// TestTwilioSMSPause checks that alert notifications are skipped while paused via SMS (e.g., `stop 1h`), and
+// resume after START.
+func TestTwilioSMSPause(t *testing.T) {
+	t.Parallel()
+
+	sql := `
+	insert into users (id, name, email)
+	values
+		({{uuid "user"}}, 'bob', 'joe');
+	insert into user_contact_methods (id, user_id, name, type, value)
+	values
+		({{uuid "cm1"}}, {{uuid "user"}}, 'personal', 'SMS', {{phone "1"}}),
+		({{uuid "cm2"}}, {{uuid "user"}}, 'personal', 'VOICE', {{phone "1"}});
+
+	insert into user_notification_rules (user_id, contact_method_id, delay_minutes)
+	values
+		({{uuid "user"}}, {{uuid "cm1"}}, 0),
+		({{uuid "user"}}, {{uuid "cm2"}}, 0);
+
+	insert into escalation_policies (id, name)
+	values
+		({{uuid "eid"}}, 'esc policy');
+	insert into escalation_policy_steps (id, escalation_policy_id)
+	values
+		({{uuid "esid"}}, {{uuid "eid"}});
+	insert into escalation_policy_actions (escalation_policy_step_id, user_id)
+	values
+		({{uuid "esid"}}, {{uuid "user"}});
+
+	insert into services (id, escalation_policy_id, name)
+	values
+		({{uuid "sid"}}, {{uuid "eid"}}, 'service');
+
+	insert into alerts (id, service_id, description)
+	values
+		(1234, {{uuid "sid"}}, 'testing');
+`
+	h := harness.NewHarness(t, sql, "ids-to-uuids")
+	defer h.Close()
+
+	d1 := h.Twilio(t).Device(h.Phone("1"))
+	d1.ExpectVoice("testing").Hangup()
+	d1.ExpectSMS("testing").
+		ThenReply("stop 1h").
+		ThenExpect("paused", "1 hour")
+
+	// only VOICE should be notified while SMS is paused
+	h.Escalate(1234, 0)
+	d1.ExpectVoice("testing").Hangup()
+
+	// resume SMS
+	d1.SendSMS("start")
+
+	h.Escalate(1234, 0)
+	d1.ExpectSMS("testing")
+	d1.ExpectVoice("testing")
+}
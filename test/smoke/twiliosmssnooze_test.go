@@ -0,0 +1,62 @@
+package smoke
+
+import (
+	"testing"
+	"time"
+
+	"github.com/target/goalert/test/smoke/harness"
+)
+
+// TestTwilioSMSSnooze checks that an SMS snooze reply acknowledges the alert, and that it
+// is re-triggered (restarting escalation) once the snooze expires.
+func TestTwilioSMSSnooze(t *testing.T) {
+	t.Parallel()
+
+	sql := `
+	insert into users (id, name, email, role) 
+	values 
+		({{uuid "user"}}, 'bob', 'joe', 'user');
+	insert into user_contact_methods (id, user_id, name, type, value) 
+	values
+		({{uuid "cm1"}}, {{uuid "user"}}, 'personal', 'SMS', {{phone "1"}});
+
+	insert into user_notification_rules (user_id, contact_method_id, delay_minutes) 
+	values
+		({{uuid "user"}}, {{uuid "cm1"}}, 0);
+
+	insert into escalation_policies (id, name) 
+	values
+		({{uuid "eid"}}, 'esc policy');
+	insert into escalation_policy_steps (id, escalation_policy_id) 
+	values
+		({{uuid "esid"}}, {{uuid "eid"}});
+	insert into escalation_policy_actions (escalation_policy_step_id, user_id) 
+	values 
+		({{uuid "esid"}}, {{uuid "user"}});
+
+	insert into services (id, escalation_policy_id, name) 
+	values
+		({{uuid "sid"}}, {{uuid "eid"}}, 'service');
+
+	insert into alerts (id, service_id, description) 
+	values
+		(198, {{uuid "sid"}}, 'testing');
+
+`
+	h := harness.NewHarness(t, sql, "ids-to-uuids")
+	defer h.Close()
+
+	tw := h.Twilio(t)
+	d1 := tw.Device(h.Phone("1"))
+
+	d1.ExpectSMS("testing").
+		ThenReply("snooze198").
+		ThenExpect("snoozed", "198")
+
+	h.FastForward(30 * time.Minute)
+	h.Trigger()
+
+	// default snooze is 60 minutes
+	h.FastForward(30 * time.Minute)
+	d1.ExpectSMS("testing")
+}
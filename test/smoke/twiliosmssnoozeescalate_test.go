@@ -0,0 +1,66 @@
+package smoke
+
+import (
+	"testing"
+	"time"
+
+	"github.com/target/goalert/test/smoke/harness"
+)
+
+// TestTwilioSMSSnoozeEscalate checks that a snooze is cleared when a snoozed alert is escalated,
+// so acknowledging it afterwards is not undone when the old snooze would have expired.
+func TestTwilioSMSSnoozeEscalate(t *testing.T) {
+	t.Parallel()
+
+	sql := `
+	insert into users (id, name, email, role)
+	values
+		({{uuid "user"}}, 'bob', 'joe', 'user');
+	insert into user_contact_methods (id, user_id, name, type, value)
+	values
+		({{uuid "cm1"}}, {{uuid "user"}}, 'personal', 'SMS', {{phone "1"}});
+
+	insert into user_notification_rules (user_id, contact_method_id, delay_minutes)
+	values
+		({{uuid "user"}}, {{uuid "cm1"}}, 0);
+
+	insert into escalation_policies (id, name)
+	values
+		({{uuid "eid"}}, 'esc policy');
+	insert into escalation_policy_steps (id, escalation_policy_id, step_number)
+	values
+		({{uuid "esid1"}}, {{uuid "eid"}}, 0),
+		({{uuid "esid2"}}, {{uuid "eid"}}, 1);
+	insert into escalation_policy_actions (escalation_policy_step_id, user_id)
+	values
+		({{uuid "esid1"}}, {{uuid "user"}}),
+		({{uuid "esid2"}}, {{uuid "user"}});
+
+	insert into services (id, escalation_policy_id, name)
+	values
+		({{uuid "sid"}}, {{uuid "eid"}}, 'service');
+
+	insert into alerts (id, service_id, description)
+	values
+		(198, {{uuid "sid"}}, 'testing');
+
+`
+	h := harness.NewHarness(t, sql, "ids-to-uuids")
+	defer h.Close()
+
+	tw := h.Twilio(t)
+	d1 := tw.Device(h.Phone("1"))
+
+	d1.ExpectSMS("testing").
+		ThenReply("snooze198").
+		ThenExpect("snoozed", "198")
+
+	h.Escalate(198, 0)
+	d1.ExpectSMS("testing").
+		ThenReply("ack198").
+		ThenExpect("acknowledged")
+
+	// the snooze would have expired here, the alert should stay acknowledged
+	h.FastForward(time.Hour)
+	h.Trigger()
+}
@@ -0,0 +1,112 @@
+package smoke
+
+import (
+	"testing"
+
+	"github.com/target/goalert/test/smoke/harness"
+)
+
+// TestTwilioVoiceMenu checks that the alert details and conference line options work from a voice call.
+func TestTwilioVoiceMenu(t *testing.T) {
+	t.Parallel()
+
+	sql := `
+	insert into users (id, name, email, role)
+	values
+		({{uuid "user"}}, 'bob', 'joe', 'user');
+	insert into user_contact_methods (id, user_id, name, type, value)
+	values
+		({{uuid "cm1"}}, {{uuid "user"}}, 'personal', 'VOICE', {{phone "1"}});
+
+	insert into user_notification_rules (user_id, contact_method_id, delay_minutes)
+	values
+		({{uuid "user"}}, {{uuid "cm1"}}, 0);
+
+	insert into escalation_policies (id, name)
+	values
+		({{uuid "eid"}}, 'esc policy');
+	insert into escalation_policy_steps (id, escalation_policy_id)
+	values
+		({{uuid "esid"}}, {{uuid "eid"}});
+	insert into escalation_policy_actions (escalation_policy_step_id, user_id)
+	values
+		({{uuid "esid"}}, {{uuid "user"}});
+
+	insert into services (id, escalation_policy_id, name, conference_number)
+	values
+		({{uuid "sid"}}, {{uuid "eid"}}, 'service', {{phone "bridge"}});
+
+	insert into alerts (service_id, summary, details)
+	values
+		({{uuid "sid"}}, 'testing', 'disk is full');
+	insert into alert_data (alert_id, metadata)
+	select id, '{"Type": "alert_meta_v1", "AlertMetaV1": {"host": "web1"}}'
+	from alerts;
+`
+	h := harness.NewHarness(t, sql, "ids-to-uuids")
+	defer h.Close()
+
+	tw := h.Twilio(t)
+	d1 := tw.Device(h.Phone("1"))
+
+	call := d1.ExpectVoice("testing", "details, press 2", "conference line").
+		ThenPress("2").
+		ThenExpect("disk is full", "host: web1", "previous menu").
+		ThenPress("1").
+		ThenExpect("testing", "acknowledge").
+		ThenPress("0").
+		ThenExpect("connecting")
+
+	call.Hangup()
+}
+
+// TestTwilioVoiceMenuEscalate checks that an alert can be escalated from a voice call with 3, as well as the previous digit 5.
+func TestTwilioVoiceMenuEscalate(t *testing.T) {
+	t.Parallel()
+
+	sql := `
+	insert into users (id, name, email, role)
+	values
+		({{uuid "user"}}, 'bob', 'joe', 'user');
+	insert into user_contact_methods (id, user_id, name, type, value)
+	values
+		({{uuid "cm1"}}, {{uuid "user"}}, 'personal', 'VOICE', {{phone "1"}});
+
+	insert into user_notification_rules (user_id, contact_method_id, delay_minutes)
+	values
+		({{uuid "user"}}, {{uuid "cm1"}}, 0);
+
+	insert into escalation_policies (id, name)
+	values
+		({{uuid "eid"}}, 'esc policy');
+	insert into escalation_policy_steps (id, escalation_policy_id, step_number)
+	values
+		({{uuid "esid"}}, {{uuid "eid"}}, 0),
+		({{uuid "esid2"}}, {{uuid "eid"}}, 1);
+	insert into escalation_policy_actions (escalation_policy_step_id, user_id)
+	values
+		({{uuid "esid"}}, {{uuid "user"}});
+
+	insert into services (id, escalation_policy_id, name)
+	values
+		({{uuid "sid"}}, {{uuid "eid"}}, 'service');
+
+	insert into alerts (service_id, summary)
+	values
+		({{uuid "sid"}}, 'first'),
+		({{uuid "sid"}}, 'second');
+`
+	h := harness.NewHarness(t, sql, "ids-to-uuids")
+	defer h.Close()
+
+	tw := h.Twilio(t)
+	d1 := tw.Device(h.Phone("1"))
+
+	d1.ExpectVoice("first", "escalate, press 3").
+		ThenPress("3").
+		ThenExpect("escalation requested")
+
+	d1.ExpectVoice("second", "escalate, press 3").
+		ThenPress("5").
+		ThenExpect("escalation requested")
+}
@@ -0,0 +1,94 @@
+package smoke
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/expflag"
+	"github.com/target/goalert/test/smoke/harness"
+)
+
+// TestUIKResponse checks that a universal key returns a JSON summary of what happened when
+// requested, and that a matched rule can set the response status code.
+func TestUIKResponse(t *testing.T) {
+	t.Parallel()
+
+	const sql = `
+	insert into escalation_policies (id, name)
+	values
+		({{uuid "eid"}}, 'esc policy');
+	insert into services (id, escalation_policy_id, name)
+	values
+		({{uuid "sid"}}, {{uuid "eid"}}, 'service');
+	insert into integration_keys (id, service_id, name, type)
+	values
+		({{uuid "key"}}, {{uuid "sid"}}, 'key', 'universal');
+`
+
+	h := harness.NewHarnessWithFlags(t, sql, "", expflag.FlagSet{expflag.UnivKeys})
+	defer h.Close()
+
+	resp := h.GraphQLQueryUserVarsT(t, harness.DefaultGraphQLAdminUserID, `
+		mutation ($input: UpdateKeyConfigInput!) {
+			updateKeyConfig(input: $input)
+		}
+	`, "", map[string]any{"input": map[string]any{
+		"keyID": h.UUID("key"),
+		"rules": []map[string]any{{
+			"name":               "alert",
+			"description":        "",
+			"conditionExpr":      `req.body.status == "firing"`,
+			"continueAfterMatch": false,
+			"responseStatus":     202,
+			"actions": []map[string]any{{
+				"dest":   map[string]any{"type": "builtin-alert", "args": map[string]string{}},
+				"params": map[string]string{"summary": "req.body.summary"},
+			}},
+		}},
+	}})
+	require.Empty(t, resp.Errors)
+
+	resp = h.GraphQLQueryUserVarsT(t, harness.DefaultGraphQLAdminUserID, `
+		mutation ($id: ID!) {
+			generateKeyToken(id: $id)
+		}
+	`, "", map[string]any{"id": h.UUID("key")})
+	require.Empty(t, resp.Errors)
+	var tok struct{ GenerateKeyToken string }
+	require.NoError(t, json.Unmarshal(resp.Data, &tok))
+
+	send := func(body string, accept string) *http.Response {
+		t.Helper()
+		req, err := http.NewRequest("POST", h.URL()+"/api/v2/uik", strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer "+tok.GenerateKeyToken)
+		req.Header.Set("Content-Type", "application/json")
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = res.Body.Close() })
+		return res
+	}
+
+	res := send(`{"status": "resolved"}`, "")
+	require.Equal(t, http.StatusNoContent, res.StatusCode, "no rule matched, default status")
+
+	res = send(`{"status": "firing", "summary": "disk full"}`, "application/json")
+	require.Equal(t, http.StatusAccepted, res.StatusCode, "status set by rule")
+
+	var body struct {
+		MatchedRules    []string
+		CreatedAlertIDs []int
+		UpdatedAlertIDs []int
+		Signals         int
+	}
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&body))
+	require.Len(t, body.MatchedRules, 1)
+	require.Len(t, body.CreatedAlertIDs, 1)
+	require.Empty(t, body.UpdatedAlertIDs)
+}
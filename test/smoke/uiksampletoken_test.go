@@ -0,0 +1,67 @@
+package smoke
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/expflag"
+	"github.com/target/goalert/test/smoke/harness"
+)
+
+// TestUIKSampleToken checks that a universal key token passed as a query parameter is not recorded
+// with the request sample.
+func TestUIKSampleToken(t *testing.T) {
+	t.Parallel()
+
+	const sql = `
+	insert into escalation_policies (id, name)
+	values
+		({{uuid "eid"}}, 'esc policy');
+	insert into services (id, escalation_policy_id, name)
+	values
+		({{uuid "sid"}}, {{uuid "eid"}}, 'service');
+	insert into integration_keys (id, service_id, name, type)
+	values
+		({{uuid "key"}}, {{uuid "sid"}}, 'key', 'universal');
+`
+
+	h := harness.NewHarnessWithFlags(t, sql, "", expflag.FlagSet{expflag.UnivKeys})
+	defer h.Close()
+
+	resp := h.GraphQLQueryUserVarsT(t, harness.DefaultGraphQLAdminUserID, `
+		mutation ($id: ID!) {
+			generateKeyToken(id: $id)
+		}
+	`, "", map[string]any{"id": h.UUID("key")})
+	require.Empty(t, resp.Errors)
+	var tok struct{ GenerateKeyToken string }
+	require.NoError(t, json.Unmarshal(resp.Data, &tok))
+
+	q := url.Values{"token": {tok.GenerateKeyToken}, "env": {"prod"}}
+	res, err := http.Post(h.URL()+"/api/v2/uik?"+q.Encode(), "application/json", strings.NewReader(`{"status": "firing"}`))
+	require.NoError(t, err)
+	require.NoError(t, res.Body.Close())
+	require.Equal(t, http.StatusNoContent, res.StatusCode)
+
+	resp = h.GraphQLQueryUserVarsT(t, harness.DefaultGraphQLAdminUserID, `
+		query ($id: ID!) {
+			integrationKey(id: $id) {
+				samples { query body }
+			}
+		}
+	`, "", map[string]any{"id": h.UUID("key")})
+	require.Empty(t, resp.Errors)
+	var data struct {
+		IntegrationKey struct {
+			Samples []struct{ Query, Body string }
+		}
+	}
+	require.NoError(t, json.Unmarshal(resp.Data, &data))
+	require.Len(t, data.IntegrationKey.Samples, 1)
+	require.Equal(t, "env=prod", data.IntegrationKey.Samples[0].Query)
+	require.NotContains(t, data.IntegrationKey.Samples[0].Query, tok.GenerateKeyToken)
+}
@@ -0,0 +1,119 @@
+package contactmethod
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/sqlc-dev/pqtype"
+	"github.com/target/goalert/schedule/rule"
+	"github.com/target/goalert/util/timeutil"
+	"github.com/target/goalert/validation"
+)
+
+// ActiveWindow restricts the times a contact method may be notified (e.g., quiet hours).
+type ActiveWindow struct {
+	// Days indicates which days of the week the window applies to.
+	Days  timeutil.WeekdayFilter `json:"days"`
+	Start timeutil.Clock         `json:"start"`
+	End   timeutil.Clock         `json:"end"`
+
+	// TimeZone is the IANA time zone name the window is evaluated in.
+	TimeZone string `json:"tz"`
+
+	// Defer, if true, will hold notifications until the window opens instead of dropping them.
+	Defer bool `json:"defer,omitempty"`
+}
+
+func (w ActiveWindow) rule() rule.Rule {
+	return rule.Rule{WeekdayFilter: w.Days, Start: w.Start, End: w.End}
+}
+
+// Location returns the time zone of the window, falling back to UTC if it is invalid.
+func (w ActiveWindow) Location() *time.Location {
+	loc, err := time.LoadLocation(w.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+
+	return loc
+}
+
+// IsActive returns true if notifications may be sent at time t.
+func (w ActiveWindow) IsActive(t time.Time) bool { return w.rule().IsActive(t.In(w.Location())) }
+
+// NextStart returns the next time the window will open. If the window is currently
+// active, or will never open, zero time is returned.
+func (w ActiveWindow) NextStart(t time.Time) time.Time {
+	r := w.rule()
+	t = t.In(w.Location())
+	if r.NeverActive() || r.IsActive(t) {
+		return time.Time{}
+	}
+
+	return r.StartTime(t)
+}
+
+// Normalize will validate the ActiveWindow. A nil value is returned if the window
+// is always active, since it places no restriction on the contact method.
+func (w ActiveWindow) Normalize() (*ActiveWindow, error) {
+	if w.TimeZone == "" {
+		return nil, validation.NewFieldError("ActiveWindow.TimeZone", "required")
+	}
+	_, err := time.LoadLocation(w.TimeZone)
+	if err != nil {
+		return nil, validation.NewFieldError("ActiveWindow.TimeZone", "unknown time zone "+w.TimeZone)
+	}
+	if w.Days.IsNever() {
+		return nil, validation.NewFieldError("ActiveWindow.Days", "must include at least one day")
+	}
+
+	w.Start = timeutil.Clock(time.Duration(w.Start).Truncate(time.Minute))
+	w.End = timeutil.Clock(time.Duration(w.End).Truncate(time.Minute))
+	if w.rule().AlwaysActive() {
+		return nil, nil
+	}
+
+	return &w, nil
+}
+
+// ParseActiveWindow will parse an ActiveWindow from its DB representation. A nil
+// value is returned if there is no window set.
+func ParseActiveWindow(data []byte) (*ActiveWindow, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+
+	var w ActiveWindow
+	err := json.Unmarshal(data, &w)
+	if err != nil {
+		return nil, err
+	}
+
+	return &w, nil
+}
+
+func activeWindowValue(w *ActiveWindow) (pqtype.NullRawMessage, error) {
+	if w == nil {
+		return pqtype.NullRawMessage{}, nil
+	}
+
+	data, err := json.Marshal(w)
+	if err != nil {
+		return pqtype.NullRawMessage{}, err
+	}
+
+	return pqtype.NullRawMessage{Valid: true, RawMessage: data}, nil
+}
+
+func parseActiveWindow(data pqtype.NullRawMessage) *ActiveWindow {
+	if !data.Valid {
+		return nil
+	}
+	w, err := ParseActiveWindow(data.RawMessage)
+	if err != nil {
+		// invalid data should never be stored, treat as unrestricted
+		return nil
+	}
+
+	return w
+}
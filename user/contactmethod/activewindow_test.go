@@ -0,0 +1,56 @@
+package contactmethod
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/util/timeutil"
+)
+
+func TestActiveWindow(t *testing.T) {
+	w := ActiveWindow{
+		Days:     timeutil.WeekdayFilter{0, 1, 1, 1, 1, 1, 0},
+		Start:    timeutil.NewClock(22, 0),
+		End:      timeutil.NewClock(6, 0),
+		TimeZone: "America/Chicago",
+	}
+	n, err := w.Normalize()
+	require.NoError(t, err)
+	require.NotNil(t, n)
+
+	loc, err := time.LoadLocation("America/Chicago")
+	require.NoError(t, err)
+
+	// Monday 11pm local time
+	assert.True(t, n.IsActive(time.Date(2024, 1, 1, 23, 0, 0, 0, loc)))
+	assert.True(t, n.NextStart(time.Date(2024, 1, 1, 23, 0, 0, 0, loc)).IsZero())
+
+	// Monday 11pm UTC is 5pm local time
+	assert.False(t, n.IsActive(time.Date(2024, 1, 1, 23, 0, 0, 0, time.UTC)))
+	assert.Equal(t, time.Date(2024, 1, 1, 22, 0, 0, 0, loc), n.NextStart(time.Date(2024, 1, 1, 23, 0, 0, 0, time.UTC)))
+
+	// Saturday night is not enabled
+	assert.False(t, n.IsActive(time.Date(2024, 1, 6, 23, 0, 0, 0, loc)))
+
+	t.Run("always active", func(t *testing.T) {
+		n, err := ActiveWindow{Days: timeutil.EveryDay(), TimeZone: "UTC"}.Normalize()
+		require.NoError(t, err)
+		assert.Nil(t, n, "always active window should be removed")
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := ActiveWindow{Days: timeutil.EveryDay(), TimeZone: "Not/AZone"}.Normalize()
+		assert.Error(t, err)
+
+		_, err = ActiveWindow{TimeZone: "UTC", Start: timeutil.NewClock(1, 0)}.Normalize()
+		assert.Error(t, err, "no days")
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		data, err := activeWindowValue(n)
+		require.NoError(t, err)
+		assert.Equal(t, n, parseActiveWindow(data))
+	})
+}
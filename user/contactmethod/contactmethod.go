@@ -23,6 +23,12 @@ type ContactMethod struct {
 
 	StatusUpdates bool
 
+	// ActiveWindow, if set, restricts when the contact method will be notified.
+	ActiveWindow *ActiveWindow
+
+	// PausedUntil, if set, is the time until which alert notifications to the contact method are paused.
+	PausedUntil time.Time
+
 	lastTestVerifyAt sql.NullTime
 }
 
@@ -60,5 +66,12 @@ func (c ContactMethod) Normalize(ctx context.Context, reg *nfydest.Registry) (*C
 		return nil, err
 	}
 
+	if c.ActiveWindow != nil {
+		c.ActiveWindow, err = c.ActiveWindow.Normalize()
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &c, nil
 }
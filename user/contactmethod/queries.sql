@@ -1,6 +1,6 @@
 -- name: ContactMethodAdd :exec
-INSERT INTO user_contact_methods(id, name, dest, disabled, user_id, enable_status_updates)
-    VALUES ($1, $2, $3, $4, $5, $6);
+INSERT INTO user_contact_methods(id, name, dest, disabled, user_id, enable_status_updates, active_window)
+    VALUES ($1, $2, $3, $4, $5, $6, $7);
 
 -- name: ContactMethodUpdate :exec
 UPDATE
@@ -8,7 +8,8 @@ UPDATE
 SET
     name = $2,
     disabled = $3,
-    enable_status_updates = $4
+    enable_status_updates = $4,
+    active_window = $5
 WHERE
     id = $1;
 
@@ -61,7 +62,8 @@ WHERE
 UPDATE
     user_contact_methods
 SET
-    disabled = $2
+    disabled = $2,
+    paused_until = NULL
 WHERE
     dest = $1
 RETURNING
@@ -84,3 +86,24 @@ SET
 WHERE
     dest = $1;
 
+
+-- name: ContactMethodFindOneByDest :one
+SELECT
+    *
+FROM
+    user_contact_methods
+WHERE
+    dest = $1;
+
+-- name: ContactMethodPause :exec
+-- Pauses alert notifications to a contact method for the given number of minutes, or resumes them if zero.
+UPDATE
+    user_contact_methods
+SET
+    paused_until = CASE WHEN @pause_minutes::int > 0 THEN
+        now() + '1 minute'::interval * @pause_minutes::int
+    ELSE
+        NULL
+    END
+WHERE
+    id = @id;
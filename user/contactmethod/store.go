@@ -3,6 +3,7 @@ package contactmethod
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/target/goalert/gadb"
@@ -134,6 +135,11 @@ func (s *Store) Create(ctx context.Context, dbtx gadb.DBTX, c *ContactMethod) (*
 		return nil, err
 	}
 
+	win, err := activeWindowValue(n.ActiveWindow)
+	if err != nil {
+		return nil, err
+	}
+
 	err = gadb.New(dbtx).ContactMethodAdd(ctx, gadb.ContactMethodAddParams{
 		ID:                  n.ID,
 		Name:                n.Name,
@@ -141,6 +147,7 @@ func (s *Store) Create(ctx context.Context, dbtx gadb.DBTX, c *ContactMethod) (*
 		Disabled:            n.Disabled,
 		UserID:              uuid.MustParse(n.UserID),
 		EnableStatusUpdates: n.StatusUpdates,
+		ActiveWindow:        win,
 	})
 	if err != nil {
 		return nil, err
@@ -201,20 +208,50 @@ func (s *Store) FindOne(ctx context.Context, dbtx gadb.DBTX, id uuid.UUID) (*Con
 		return nil, err
 	}
 
-	c := ContactMethod{
-		ID:               row.ID,
-		Name:             row.Name,
-		Dest:             row.Dest.DestV1,
-		Disabled:         row.Disabled,
-		UserID:           row.UserID.String(),
-		Pending:          row.Pending,
-		StatusUpdates:    row.EnableStatusUpdates,
-		lastTestVerifyAt: row.LastTestVerifyAt,
+	c := fromDB(row)
+	return &c, nil
+}
+
+// FindOneByDest finds the contact method with the provided destination.
+func (s *Store) FindOneByDest(ctx context.Context, dbtx gadb.DBTX, dest gadb.DestV1) (*ContactMethod, error) {
+	err := permission.LimitCheckAny(ctx, permission.System)
+	if err != nil {
+		return nil, err
 	}
 
+	row, err := gadb.New(dbtx).ContactMethodFindOneByDest(ctx, gadb.NullDestV1{Valid: true, DestV1: dest})
+	if err != nil {
+		return nil, err
+	}
+
+	c := fromDB(row)
 	return &c, nil
 }
 
+// Pause will pause alert notifications to the contact method for the provided duration. A zero
+// duration will resume notifications immediately.
+func (s *Store) Pause(ctx context.Context, dbtx gadb.DBTX, id uuid.UUID, dur time.Duration) error {
+	err := permission.LimitCheckAny(ctx, permission.Admin, permission.User)
+	if err != nil {
+		return err
+	}
+
+	cm, err := s.FindOne(ctx, dbtx, id)
+	if err != nil {
+		return err
+	}
+
+	err = permission.LimitCheckAny(ctx, permission.Admin, permission.MatchUser(cm.UserID))
+	if err != nil {
+		return err
+	}
+
+	return gadb.New(dbtx).ContactMethodPause(ctx, gadb.ContactMethodPauseParams{
+		ID:           id,
+		PauseMinutes: int32(dur / time.Minute),
+	})
+}
+
 // UpdateTx updates the contact method with the newly provided values within a transaction.
 func (s *Store) Update(ctx context.Context, dbtx gadb.DBTX, c *ContactMethod) error {
 	err := permission.LimitCheckAny(ctx, permission.Admin, permission.User)
@@ -238,8 +275,13 @@ func (s *Store) Update(ctx context.Context, dbtx gadb.DBTX, c *ContactMethod) er
 		return validation.NewFieldError("UserID", "cannot update owner of contact method")
 	}
 
+	win, err := activeWindowValue(n.ActiveWindow)
+	if err != nil {
+		return err
+	}
+
 	if permission.Admin(ctx) {
-		err = gadb.New(dbtx).ContactMethodUpdate(ctx, gadb.ContactMethodUpdateParams{ID: n.ID, Name: n.Name, Disabled: n.Disabled, EnableStatusUpdates: n.StatusUpdates})
+		err = gadb.New(dbtx).ContactMethodUpdate(ctx, gadb.ContactMethodUpdateParams{ID: n.ID, Name: n.Name, Disabled: n.Disabled, EnableStatusUpdates: n.StatusUpdates, ActiveWindow: win})
 		return err
 	}
 
@@ -248,7 +290,7 @@ func (s *Store) Update(ctx context.Context, dbtx gadb.DBTX, c *ContactMethod) er
 		return err
 	}
 
-	err = gadb.New(dbtx).ContactMethodUpdate(ctx, gadb.ContactMethodUpdateParams{ID: n.ID, Name: n.Name, Disabled: n.Disabled, EnableStatusUpdates: n.StatusUpdates})
+	err = gadb.New(dbtx).ContactMethodUpdate(ctx, gadb.ContactMethodUpdateParams{ID: n.ID, Name: n.Name, Disabled: n.Disabled, EnableStatusUpdates: n.StatusUpdates, ActiveWindow: win})
 
 	return err
 }
@@ -272,16 +314,7 @@ func (s *Store) FindMany(ctx context.Context, dbtx gadb.DBTX, ids []string) ([]C
 
 	cms := make([]ContactMethod, len(rows))
 	for i, row := range rows {
-		cms[i] = ContactMethod{
-			ID:               row.ID,
-			Name:             row.Name,
-			Dest:             row.Dest.DestV1,
-			Disabled:         row.Disabled,
-			UserID:           row.UserID.String(),
-			Pending:          row.Pending,
-			StatusUpdates:    row.EnableStatusUpdates,
-			lastTestVerifyAt: row.LastTestVerifyAt,
-		}
+		cms[i] = fromDB(row)
 	}
 
 	return cms, nil
@@ -306,17 +339,24 @@ func (s *Store) FindAll(ctx context.Context, dbtx gadb.DBTX, userID string) ([]C
 
 	cms := make([]ContactMethod, len(rows))
 	for i, row := range rows {
-		cms[i] = ContactMethod{
-			ID:               row.ID,
-			Name:             row.Name,
-			Dest:             row.Dest.DestV1,
-			Disabled:         row.Disabled,
-			UserID:           row.UserID.String(),
-			Pending:          row.Pending,
-			StatusUpdates:    row.EnableStatusUpdates,
-			lastTestVerifyAt: row.LastTestVerifyAt,
-		}
+		cms[i] = fromDB(row)
 	}
 
 	return cms, nil
 }
+
+// fromDB converts a contact method row to a ContactMethod.
+func fromDB(row gadb.UserContactMethod) ContactMethod {
+	return ContactMethod{
+		ID:               row.ID,
+		Name:             row.Name,
+		Dest:             row.Dest.DestV1,
+		Disabled:         row.Disabled,
+		UserID:           row.UserID.String(),
+		Pending:          row.Pending,
+		StatusUpdates:    row.EnableStatusUpdates,
+		ActiveWindow:     parseActiveWindow(row.ActiveWindow),
+		PausedUntil:      row.PausedUntil.Time,
+		lastTestVerifyAt: row.LastTestVerifyAt,
+	}
+}
@@ -2,6 +2,8 @@ package notificationrule
 
 import (
 	"github.com/google/uuid"
+	"github.com/target/goalert/alert"
+	"github.com/target/goalert/validation"
 	"github.com/target/goalert/validation/validate"
 )
 
@@ -10,6 +12,51 @@ type NotificationRule struct {
 	UserID          string    `json:"-"`
 	DelayMinutes    int       `json:"delay"`
 	ContactMethodID uuid.UUID `json:"contact_method_id"`
+
+	// Filter, if set, restricts the rule to matching alerts.
+	Filter Filter `json:"filter"`
+}
+
+// Filter restricts a NotificationRule to a subset of alerts.
+//
+// All set fields must match for the rule to apply. The zero value matches all alerts.
+type Filter struct {
+	// MinSeverity will match alerts of at least the given severity.
+	MinSeverity alert.Severity `json:"min_severity,omitempty"`
+
+	// ServiceID will match alerts from the given service.
+	ServiceID string `json:"service_id,omitempty"`
+
+	// LabelKey and LabelValue will match alerts from services with the given label.
+	LabelKey   string `json:"label_key,omitempty"`
+	LabelValue string `json:"label_value,omitempty"`
+}
+
+// IsZero returns true if the filter matches all alerts.
+func (f Filter) IsZero() bool { return f == Filter{} }
+
+func (f Filter) Normalize() (*Filter, error) {
+	var err error
+	if f.MinSeverity != "" {
+		err = validate.OneOf("Filter.MinSeverity", f.MinSeverity, alert.SeverityCritical, alert.SeverityHigh, alert.SeverityLow, alert.SeverityInfo)
+	}
+	if f.ServiceID != "" {
+		err = validate.Many(err, validate.UUID("Filter.ServiceID", f.ServiceID))
+	}
+	if f.LabelKey != "" || f.LabelValue != "" {
+		err = validate.Many(err,
+			validate.LabelKey("Filter.LabelKey", f.LabelKey),
+			validate.LabelValue("Filter.LabelValue", f.LabelValue),
+		)
+		if f.LabelValue == "" {
+			err = validate.Many(err, validation.NewFieldError("Filter.LabelValue", "required when LabelKey is set"))
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &f, nil
 }
 
 func validateDelay(d int) error {
@@ -29,5 +76,11 @@ func (n NotificationRule) Normalize(update bool) (*NotificationRule, error) {
 		return nil, err
 	}
 
+	f, err := n.Filter.Normalize()
+	if err != nil {
+		return nil, err
+	}
+	n.Filter = *f
+
 	return &n, nil
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
+	"github.com/target/goalert/alert"
 )
 
 func TestNotificationRule_Normalize(t *testing.T) {
@@ -25,9 +26,14 @@ func TestNotificationRule_Normalize(t *testing.T) {
 
 	valid := []NotificationRule{
 		{DelayMinutes: 5, ContactMethodID: uuid.MustParse("ececacc0-4764-012d-7bfb-002500d5dece"), UserID: "bcefacc0-4764-012d-7bfb-002500d5decb"},
+		{DelayMinutes: 5, ContactMethodID: uuid.MustParse("ececacc0-4764-012d-7bfb-002500d5dece"), UserID: "bcefacc0-4764-012d-7bfb-002500d5decb", Filter: Filter{MinSeverity: alert.SeverityCritical}},
+		{DelayMinutes: 0, ContactMethodID: uuid.MustParse("ececacc0-4764-012d-7bfb-002500d5dece"), UserID: "bcefacc0-4764-012d-7bfb-002500d5decb", Filter: Filter{ServiceID: "e93facc0-4764-012d-7bfb-002500d5d1a6", LabelKey: "example.com/team", LabelValue: "ops"}},
 	}
 	invalid := []NotificationRule{
 		{},
+		{DelayMinutes: 5, ContactMethodID: uuid.MustParse("ececacc0-4764-012d-7bfb-002500d5dece"), UserID: "bcefacc0-4764-012d-7bfb-002500d5decb", Filter: Filter{MinSeverity: "urgent"}},
+		{DelayMinutes: 5, ContactMethodID: uuid.MustParse("ececacc0-4764-012d-7bfb-002500d5dece"), UserID: "bcefacc0-4764-012d-7bfb-002500d5decb", Filter: Filter{ServiceID: "not-a-uuid"}},
+		{DelayMinutes: 5, ContactMethodID: uuid.MustParse("ececacc0-4764-012d-7bfb-002500d5dece"), UserID: "bcefacc0-4764-012d-7bfb-002500d5decb", Filter: Filter{LabelKey: "example.com/team"}},
 	}
 	for _, nr := range valid {
 		test(true, nr)
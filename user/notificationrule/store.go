@@ -5,6 +5,7 @@ import (
 	"database/sql"
 
 	"github.com/google/uuid"
+	"github.com/target/goalert/alert"
 	"github.com/target/goalert/permission"
 	"github.com/target/goalert/util"
 	"github.com/target/goalert/util/sqlutil"
@@ -27,8 +28,19 @@ func NewStore(ctx context.Context, db *sql.DB) (*Store, error) {
 	p := prep.P
 	s := &Store{db: db}
 
-	s.insert = p("INSERT INTO user_notification_rules (id,user_id,delay_minutes,contact_method_id) VALUES ($1,$2,$3,$4)")
-	s.findAll = p("SELECT id,user_id,delay_minutes,contact_method_id FROM user_notification_rules WHERE user_id = $1")
+	s.insert = p(`
+		INSERT INTO user_notification_rules (
+			id, user_id, delay_minutes, contact_method_id,
+			filter_min_severity, filter_service_id, filter_label_key, filter_label_value
+		) VALUES ($1,$2,$3,$4,$5,$6,$7,$8)
+	`)
+	s.findAll = p(`
+		SELECT
+			id, user_id, delay_minutes, contact_method_id,
+			filter_min_severity, filter_service_id, filter_label_key, filter_label_value
+		FROM user_notification_rules
+		WHERE user_id = $1
+	`)
 	s.delete = p("DELETE FROM user_notification_rules WHERE id = any($1)")
 	s.lookupUserID = p("SELECT user_id FROM user_notification_rules WHERE id = any($1)")
 
@@ -56,7 +68,12 @@ func (s *Store) CreateTx(ctx context.Context, tx *sql.Tx, n *NotificationRule) (
 
 	n.ID = uuid.New().String()
 
-	_, err = wrapTx(ctx, tx, s.insert).ExecContext(ctx, n.ID, n.UserID, n.DelayMinutes, n.ContactMethodID)
+	_, err = wrapTx(ctx, tx, s.insert).ExecContext(ctx, n.ID, n.UserID, n.DelayMinutes, n.ContactMethodID,
+		sql.NullString{String: string(n.Filter.MinSeverity), Valid: n.Filter.MinSeverity != ""},
+		sql.NullString{String: n.Filter.ServiceID, Valid: n.Filter.ServiceID != ""},
+		sql.NullString{String: n.Filter.LabelKey, Valid: n.Filter.LabelKey != ""},
+		sql.NullString{String: n.Filter.LabelValue, Valid: n.Filter.LabelKey != ""},
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -138,10 +155,15 @@ func (s *Store) FindAll(ctx context.Context, userID string) ([]NotificationRule,
 	notificationrules := []NotificationRule{}
 	for rows.Next() {
 		var n NotificationRule
-		err = rows.Scan(&n.ID, &n.UserID, &n.DelayMinutes, &n.ContactMethodID)
+		var minSev, svcID, labelKey, labelValue sql.NullString
+		err = rows.Scan(&n.ID, &n.UserID, &n.DelayMinutes, &n.ContactMethodID, &minSev, &svcID, &labelKey, &labelValue)
 		if err != nil {
 			return nil, err
 		}
+		n.Filter.MinSeverity = alert.Severity(minSev.String)
+		n.Filter.ServiceID = svcID.String
+		n.Filter.LabelKey = labelKey.String
+		n.Filter.LabelValue = labelValue.String
 		notificationrules = append(notificationrules, n)
 	}
 
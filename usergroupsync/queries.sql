@@ -0,0 +1,53 @@
+-- name: UGSyncCreate :exec
+-- Creates a new Slack user group sync for a rotation or escalation policy step.
+INSERT INTO slack_usergroup_syncs(id, usergroup_id, rotation_id, ep_step_id)
+    VALUES (@id, @usergroup_id, @rotation_id, @ep_step_id);
+
+-- name: UGSyncDelete :exec
+-- Deletes a Slack user group sync.
+DELETE FROM slack_usergroup_syncs
+WHERE id = @id;
+
+-- name: UGSyncFindManyByRotation :many
+-- Returns all Slack user group syncs for a rotation.
+SELECT
+    *
+FROM
+    slack_usergroup_syncs
+WHERE
+    rotation_id = @rotation_id
+ORDER BY
+    created_at,
+    id;
+
+-- name: UGSyncFindManyByEPStep :many
+-- Returns all Slack user group syncs for an escalation policy step.
+SELECT
+    *
+FROM
+    slack_usergroup_syncs
+WHERE
+    ep_step_id = @ep_step_id
+ORDER BY
+    created_at,
+    id;
+
+-- name: UGSyncScheduleRuleExists :one
+-- Returns true if a schedule on-call notification rule updates the user group.
+SELECT
+    EXISTS (
+        SELECT
+            1
+        FROM
+            schedule_data sd
+            CROSS JOIN LATERAL jsonb_array_elements(
+                CASE jsonb_typeof(sd.data -> 'V1' -> 'OnCallNotificationRules')
+                WHEN 'array' THEN
+                    sd.data -> 'V1' -> 'OnCallNotificationRules'
+                ELSE
+                    '[]'::jsonb
+                END) r
+            JOIN notification_channels nc ON nc.id =(r ->> 'ChannelID')::uuid
+        WHERE
+            nc.dest ->> 'Type' = 'builtin-slack-usergroup'
+            AND nc.dest -> 'Args' ->> 'slack_usergroup_id' = @usergroup_id::text);
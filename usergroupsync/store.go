@@ -0,0 +1,132 @@
+package usergroupsync
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/validation"
+	"github.com/target/goalert/validation/validate"
+)
+
+// Store manages Slack user group syncs for rotations and escalation policy steps.
+//
+// Membership is kept up to date by the engine (see engine/usergroupmanager).
+type Store struct {
+	db *sql.DB
+}
+
+// NewStore creates a new Store.
+func NewStore(ctx context.Context, db *sql.DB) (*Store, error) {
+	return &Store{db: db}, nil
+}
+
+// Create will create a new Sync. The caller is responsible for validating
+// that the user group exists.
+//
+// A user group can't be both synced and updated by schedule on-call notifications.
+func (s *Store) Create(ctx context.Context, sync Sync) (*Sync, error) {
+	err := permission.LimitCheckAny(ctx, permission.Admin, permission.User)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := sync.Normalize()
+	if err != nil {
+		return nil, err
+	}
+
+	// Schedule on-call notifications would overwrite the membership on every on-call change.
+	conflict, err := gadb.New(s.db).UGSyncScheduleRuleExists(ctx, n.UserGroupID)
+	if err != nil {
+		return nil, err
+	}
+	if conflict {
+		return nil, validation.NewFieldError("UserGroupID", "user group is already updated by a schedule on-call notification rule")
+	}
+
+	n.ID = uuid.NewString()
+	var rotID, stepID uuid.NullUUID
+	if n.RotationID != "" {
+		rotID = uuid.NullUUID{UUID: uuid.MustParse(n.RotationID), Valid: true}
+	}
+	if n.EPStepID != "" {
+		stepID = uuid.NullUUID{UUID: uuid.MustParse(n.EPStepID), Valid: true}
+	}
+
+	err = gadb.New(s.db).UGSyncCreate(ctx, gadb.UGSyncCreateParams{
+		ID:          uuid.MustParse(n.ID),
+		UsergroupID: n.UserGroupID,
+		RotationID:  rotID,
+		EpStepID:    stepID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return n, nil
+}
+
+// Delete will delete the Sync with the given ID.
+func (s *Store) Delete(ctx context.Context, id string) error {
+	err := permission.LimitCheckAny(ctx, permission.Admin, permission.User)
+	if err != nil {
+		return err
+	}
+	syncID, err := validate.ParseUUID("ID", id)
+	if err != nil {
+		return err
+	}
+
+	return gadb.New(s.db).UGSyncDelete(ctx, syncID)
+}
+
+// FindManyByRotation returns all syncs for the given rotation.
+func (s *Store) FindManyByRotation(ctx context.Context, rotationID string) ([]Sync, error) {
+	err := permission.LimitCheckAny(ctx, permission.System, permission.User)
+	if err != nil {
+		return nil, err
+	}
+	id, err := validate.ParseUUID("RotationID", rotationID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := gadb.New(s.db).UGSyncFindManyByRotation(ctx, uuid.NullUUID{UUID: id, Valid: true})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Sync, len(rows))
+	for i, r := range rows {
+		result[i] = fromDB(r)
+	}
+
+	return result, nil
+}
+
+// FindManyByEPStep returns all syncs for the given escalation policy step.
+func (s *Store) FindManyByEPStep(ctx context.Context, stepID string) ([]Sync, error) {
+	err := permission.LimitCheckAny(ctx, permission.System, permission.User)
+	if err != nil {
+		return nil, err
+	}
+	id, err := validate.ParseUUID("EPStepID", stepID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := gadb.New(s.db).UGSyncFindManyByEPStep(ctx, uuid.NullUUID{UUID: id, Valid: true})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Sync, len(rows))
+	for i, r := range rows {
+		result[i] = fromDB(r)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,67 @@
+package usergroupsync
+
+import (
+	"time"
+
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/validation"
+	"github.com/target/goalert/validation/validate"
+)
+
+// A Sync keeps the members of a Slack user group in sync with the users
+// on-call for a rotation or escalation policy step.
+type Sync struct {
+	ID          string
+	UserGroupID string
+
+	// Exactly one of RotationID or EPStepID is set.
+	RotationID string
+	EPStepID   string
+
+	LastSyncAt time.Time
+	LastError  string
+
+	// DriftDetectedAt is the last time the user group was found to have been
+	// changed outside of GoAlert.
+	DriftDetectedAt time.Time
+	DriftDetails    string
+}
+
+// Normalize will validate and normalize the Sync.
+func (s Sync) Normalize() (*Sync, error) {
+	err := validate.SubjectID("UserGroupID", s.UserGroupID)
+	switch {
+	case s.RotationID == "" && s.EPStepID == "":
+		err = validate.Many(err, validation.NewGenericError("one of RotationID or EPStepID is required"))
+	case s.RotationID != "" && s.EPStepID != "":
+		err = validate.Many(err, validation.NewGenericError("only one of RotationID or EPStepID may be set"))
+	case s.RotationID != "":
+		err = validate.Many(err, validate.UUID("RotationID", s.RotationID))
+	default:
+		err = validate.Many(err, validate.UUID("EPStepID", s.EPStepID))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+func fromDB(s gadb.SlackUsergroupSync) Sync {
+	res := Sync{
+		ID:              s.ID.String(),
+		UserGroupID:     s.UsergroupID,
+		LastSyncAt:      s.LastSyncAt.Time,
+		LastError:       s.LastError,
+		DriftDetectedAt: s.DriftDetectedAt.Time,
+		DriftDetails:    s.DriftDetails,
+	}
+	if s.RotationID.Valid {
+		res.RotationID = s.RotationID.UUID.String()
+	}
+	if s.EpStepID.Valid {
+		res.EPStepID = s.EpStepID.UUID.String()
+	}
+
+	return res
+}
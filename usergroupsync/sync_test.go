@@ -0,0 +1,27 @@
+package usergroupsync
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSync_Normalize(t *testing.T) {
+	const id = "b4d1a1a9-67b3-4a29-8fb1-e1d4f4f0c4f5"
+	check := func(desc string, s Sync, valid bool) {
+		t.Helper()
+		_, err := s.Normalize()
+		if valid {
+			assert.NoError(t, err, desc)
+		} else {
+			assert.Error(t, err, desc)
+		}
+	}
+
+	check("rotation", Sync{UserGroupID: "S0123", RotationID: id}, true)
+	check("ep step", Sync{UserGroupID: "S0123", EPStepID: id}, true)
+	check("no source", Sync{UserGroupID: "S0123"}, false)
+	check("both sources", Sync{UserGroupID: "S0123", RotationID: id, EPStepID: id}, false)
+	check("no user group", Sync{RotationID: id}, false)
+	check("bad rotation ID", Sync{UserGroupID: "S0123", RotationID: "foo"}, false)
+}
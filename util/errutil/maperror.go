@@ -41,6 +41,10 @@ func MapDBError(err error) error {
 			return validation.NewFieldError("UserID", "user does not exist")
 		case "auth_basic_users_user_id_fkey":
 			return validation.NewFieldError("UserID", "user does not exist")
+		case "slack_usergroup_syncs_rotation_id_fkey":
+			return validation.NewFieldError("RotationID", "rotation does not exist")
+		case "slack_usergroup_syncs_ep_step_id_fkey":
+			return validation.NewFieldError("EPStepID", "escalation policy step does not exist")
 		}
 	case "23505": // unique constraint
 		if dbErr.ConstraintName == "idx_int_key_name_svc_ext" {
@@ -79,6 +83,9 @@ func MapDBError(err error) error {
 		if dbErr.ConstraintName == "epa_no_duplicate_channels" {
 			return validation.NewGenericError("same destination cannot be assigned twice to the same step")
 		}
+		if dbErr.ConstraintName == "slack_usergroup_syncs_usergroup_id_key" {
+			return validation.NewFieldError("UserGroupID", "user group is already being synced")
+		}
 	case "23514": // check constraint
 		newErr := mapLimitError(dbErr)
 		if newErr != nil {
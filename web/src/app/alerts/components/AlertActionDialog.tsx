@@ -0,0 +1,53 @@
+import React from 'react'
+import { gql, useMutation } from '@apollo/client'
+import FormDialog from '../../dialogs/FormDialog'
+import { nonFieldErrors } from '../../util/errutil'
+
+const mutation = gql`
+  mutation AlertActionDialogMutation($input: UpdateAlertsInput!) {
+    updateAlerts(input: $input) {
+      id
+      status
+    }
+  }
+`
+
+// AlertAction is passed as the `action` URL param by links to the alert
+// details page (e.g., buttons in Teams messages).
+export type AlertAction = 'ack' | 'close'
+
+interface AlertActionDialogProps {
+  alertID: number
+  action: AlertAction
+  onClose: () => void
+}
+
+// AlertActionDialog confirms an action requested by a link before performing
+// it, so following a link alone never changes an alert.
+export default function AlertActionDialog(
+  props: AlertActionDialogProps,
+): React.JSX.Element {
+  const { alertID, action, onClose } = props
+  const [commit, status] = useMutation(mutation, {
+    variables: {
+      input: {
+        alertIDs: [alertID],
+        newStatus: action === 'ack' ? 'StatusAcknowledged' : 'StatusClosed',
+      },
+    },
+    onCompleted: onClose,
+  })
+
+  const verb = action === 'ack' ? 'Acknowledge' : 'Close'
+
+  return (
+    <FormDialog
+      title={`${verb} alert #${alertID}?`}
+      confirm
+      loading={status.loading}
+      errors={nonFieldErrors(status.error)}
+      onClose={onClose}
+      onSubmit={() => commit()}
+    />
+  )
+}
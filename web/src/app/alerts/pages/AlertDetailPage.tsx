@@ -3,6 +3,8 @@ import { gql, useQuery } from '@apollo/client'
 import { GenericError, ObjectNotFound } from '../../error-pages'
 import Spinner from '../../loading/components/Spinner'
 import AlertDetails from '../components/AlertDetails'
+import AlertActionDialog, { AlertAction } from '../components/AlertActionDialog'
+import { useResetURLParams, useURLParam } from '../../actions/hooks'
 
 const query = gql`
   query AlertDetailsPageQuery($id: Int!) {
@@ -56,12 +58,31 @@ function AlertDetailPage({ alertID }: { alertID: string }): React.JSX.Element {
   const { loading, error, data } = useQuery(query, {
     variables: { id: alertID },
   })
+  const [action] = useURLParam<string>('action', '')
+  const resetAction = useResetURLParams('action')
 
   if (!data && loading) return <Spinner />
   if (error) return <GenericError error={error.message} />
   if (!data.alert) return <ObjectNotFound type='alert' />
 
-  return <AlertDetails data={data.alert} />
+  // only prompt for actions that would change the alert
+  const status = data.alert.status
+  const showAction =
+    (action === 'ack' && status === 'StatusUnacknowledged') ||
+    (action === 'close' && status !== 'StatusClosed')
+
+  return (
+    <React.Fragment>
+      <AlertDetails data={data.alert} />
+      {showAction && (
+        <AlertActionDialog
+          alertID={data.alert.alertID}
+          action={action as AlertAction}
+          onClose={resetAction}
+        />
+      )}
+    </React.Fragment>
+  )
 }
 
 export default AlertDetailPage
@@ -25,12 +25,14 @@ export default function IntegrationKeyCreateDialog(props: {
   const { serviceID, onClose } = props
   const [createKeyStatus, createKey] = useMutation(mutation)
   const hasUnivKeysFlag = useExpFlag('univ-keys')
+  const isRuleBased = (type?: string): boolean =>
+    type === 'universal' || type === 'otlp'
   let caption
-  if (hasUnivKeysFlag && value?.type === 'universal') {
-    caption = 'Submit to configure universal key rules'
+  if (hasUnivKeysFlag && isRuleBased(value?.type)) {
+    caption = 'Submit to configure integration key rules'
   }
 
-  if (createKeyStatus?.data?.createIntegrationKey?.type === 'universal') {
+  if (isRuleBased(createKeyStatus?.data?.createIntegrationKey?.type)) {
     return (
       <Redirect
         to={`/services/${serviceID}/integration-keys/${createKeyStatus.data.createIntegrationKey.id}`}
@@ -51,7 +53,7 @@ export default function IntegrationKeyCreateDialog(props: {
           { input: { serviceID, ...value } },
           { additionalTypenames: ['IntegrationKey', 'Service'] },
         ).then(() => {
-          if (value?.type === 'universal') {
+          if (isRuleBased(value?.type)) {
             return
           }
 
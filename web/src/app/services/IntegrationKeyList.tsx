@@ -117,7 +117,7 @@ export default function IntegrationKeyList(props: {
   const uikActions = (key: IntegrationKey): React.ReactNode => {
     return (
       <Grid container spacing={2} alignItems='center' wrap='nowrap'>
-        {key.type === 'universal' && (
+        {(key.type === 'universal' || key.type === 'otlp') && (
           <Grid item>
             <AppLink to={key.id}>
               <Button
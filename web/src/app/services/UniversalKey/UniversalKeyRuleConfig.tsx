@@ -31,6 +31,7 @@ const query = gql`
           description
           conditionExpr
           continueAfterMatch
+          responseStatus
           actions {
             dest {
               type
@@ -117,6 +118,8 @@ export default function UniversalKeyRuleList(
             </Grid>
             <Grid item xs={12}>
               <b>Finally</b> {rule.continueAfterMatch ? 'continue' : 'stop'}
+              {rule.responseStatus > 0 &&
+                ` and respond with status ${rule.responseStatus}`}
             </Grid>
           </Grid>
         }
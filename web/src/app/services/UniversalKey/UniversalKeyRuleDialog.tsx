@@ -24,6 +24,7 @@ const editQuery = gql`
           description
           conditionExpr
           continueAfterMatch
+          responseStatus
           actions {
             dest {
               type
@@ -70,6 +71,7 @@ export default function UniversalKeyRuleDialog(
     description: rule?.description ?? '',
     conditionExpr: rule?.conditionExpr ?? '',
     continueAfterMatch: rule?.continueAfterMatch ?? false,
+    responseStatus: rule?.responseStatus ?? 0,
     actions: rule?.actions ?? [],
   })
 
@@ -78,6 +80,7 @@ export default function UniversalKeyRuleDialog(
   const unknownErrors = errs.remainingLegacyCallback()
   const nameError = errs.getErrorByField(/Rules.+\.Name/)
   const descError = errs.getErrorByField(/Rules.+\.Description/)
+  const statusError = errs.getErrorByField(/Rules.+\.ResponseStatus/)
   const conditionError = errs.getErrorByPath(
     'updateKeyConfig.input.setRule.conditionExpr',
   )
@@ -117,6 +120,7 @@ export default function UniversalKeyRuleDialog(
           nameError={nameError}
           descriptionError={descError}
           conditionError={conditionError}
+          responseStatusError={statusError}
         />
       }
       PaperProps={{
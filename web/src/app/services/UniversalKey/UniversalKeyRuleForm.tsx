@@ -19,6 +19,7 @@ interface UniversalKeyRuleFormProps {
   nameError?: string
   descriptionError?: string
   conditionError?: string
+  responseStatusError?: string
 }
 
 export default function UniversalKeyRuleForm(
@@ -65,6 +66,26 @@ export default function UniversalKeyRuleForm(
         />
         <HelperText error={props.conditionError} />
       </Grid>
+      <Grid item xs={12}>
+        <TextField
+          fullWidth
+          type='number'
+          label='Response Status'
+          name='responseStatus'
+          value={props.value.responseStatus || ''}
+          onChange={(e) => {
+            props.onChange({
+              ...props.value,
+              responseStatus: parseInt(e.target.value, 10) || 0,
+            })
+          }}
+          error={!!props.responseStatusError}
+          helperText={
+            props.responseStatusError ||
+            'HTTP status code to return to the caller when this rule matches (optional).'
+          }
+        />
+      </Grid>
 
       <Grid item xs={12}>
         <FormControl>
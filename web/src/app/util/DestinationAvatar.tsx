@@ -8,6 +8,7 @@ import {
   Today as ScheduleIcon,
   Webhook as WebhookIcon,
   Email,
+  Forum as ChatIcon,
 } from '@mui/icons-material'
 
 const builtInIcons: { [key: string]: React.ReactNode } = {
@@ -16,6 +17,9 @@ const builtInIcons: { [key: string]: React.ReactNode } = {
   'builtin://schedule': <ScheduleIcon />,
   'builtin://webhook': <WebhookIcon />,
   'builtin://email': <Email />,
+  'builtin://teams': <ChatIcon />,
+  'builtin://discord': <ChatIcon />,
+  'builtin://mattermost': <ChatIcon />,
 }
 
 export type DestinationAvatarProps = {
@@ -10,6 +10,17 @@ export interface ActionInput {
   params: ExprStringMap
 }
 
+export interface AddAlertNoteInput {
+  alertID: number
+  note: string
+  notifySubscribers?: null | boolean
+}
+
+export interface AddIncidentUpdateInput {
+  incidentID: number
+  message: string
+}
+
 export interface Alert {
   alertID: number
   createdAt: ISOTimestamp
@@ -23,6 +34,7 @@ export interface Alert {
   recentEvents: AlertLogEntryConnection
   service?: null | Service
   serviceID: string
+  severity: AlertSeverity
   state?: null | AlertState
   status: AlertStatus
   summary: string
@@ -43,6 +55,7 @@ export interface AlertLogEntry {
   id: number
   message: string
   messageID?: null | string
+  note?: null | string
   state?: null | NotificationState
   timestamp: ISOTimestamp
 }
@@ -90,6 +103,7 @@ export interface AlertSearchOptions {
   createdBefore?: null | ISOTimestamp
   favoritesOnly?: null | boolean
   filterByServiceID?: null | string[]
+  filterBySeverity?: null | AlertSeverity[]
   filterByStatus?: null | AlertStatus[]
   first?: null | number
   includeNotified?: null | boolean
@@ -102,6 +116,12 @@ export interface AlertSearchOptions {
 
 export type AlertSearchSort = 'dateID' | 'dateIDReverse' | 'statusID'
 
+export type AlertSeverity =
+  | 'SeverityCritical'
+  | 'SeverityHigh'
+  | 'SeverityInfo'
+  | 'SeverityLow'
+
 export interface AlertState {
   lastEscalation: ISOTimestamp
   repeatCount: number
@@ -216,6 +236,22 @@ export interface ConfigValueInput {
   value: string
 }
 
+export interface ContactMethodActiveWindow {
+  deferNotifications: boolean
+  end: ClockTime
+  start: ClockTime
+  timeZone: string
+  weekdayFilter: WeekdayFilter
+}
+
+export interface ContactMethodActiveWindowInput {
+  deferNotifications?: null | boolean
+  end: ClockTime
+  start: ClockTime
+  timeZone: string
+  weekdayFilter: WeekdayFilter
+}
+
 export type ContactMethodType =
   | 'EMAIL'
   | 'SLACK_DM'
@@ -229,6 +265,7 @@ export interface CreateAlertInput {
   meta?: null | AlertMetadataInput[]
   sanitize?: null | boolean
   serviceID: string
+  severity?: null | AlertSeverity
   summary: string
 }
 
@@ -272,7 +309,14 @@ export interface CreateHeartbeatMonitorInput {
   timeoutMinutes: number
 }
 
+export interface CreateIncidentInput {
+  alertIDs?: null | number[]
+  description?: null | string
+  title: string
+}
+
 export interface CreateIntegrationKeyInput {
+  autoCloseMinutes?: null | number
   externalSystemName?: null | string
   name: string
   serviceID?: null | string
@@ -302,6 +346,7 @@ export interface CreateScheduleInput {
 }
 
 export interface CreateServiceInput {
+  conferenceNumber?: null | string
   description?: null | string
   escalationPolicyID?: null | string
   favorite?: null | boolean
@@ -312,6 +357,12 @@ export interface CreateServiceInput {
   newIntegrationKeys?: null | CreateIntegrationKeyInput[]
 }
 
+export interface CreateSlackUserGroupSyncInput {
+  escalationPolicyStepID?: null | string
+  rotationID?: null | string
+  userGroupID: string
+}
+
 export interface CreateUserCalendarSubscriptionInput {
   disabled?: null | boolean
   fullSchedule?: null | boolean
@@ -321,6 +372,7 @@ export interface CreateUserCalendarSubscriptionInput {
 }
 
 export interface CreateUserContactMethodInput {
+  activeWindow?: null | ContactMethodActiveWindowInput
   dest?: null | DestinationInput
   enableStatusUpdates?: null | boolean
   name: string
@@ -342,6 +394,7 @@ export interface CreateUserInput {
 export interface CreateUserNotificationRuleInput {
   contactMethodID?: null | string
   delayMinutes: number
+  filter?: null | NotificationRuleFilterInput
   userID?: null | string
 }
 
@@ -528,6 +581,7 @@ export interface EscalationPolicyStep {
   delayMinutes: number
   escalationPolicy?: null | EscalationPolicy
   id: string
+  slackUserGroupSyncs: SlackUserGroupSync[]
   stepNumber: number
   targets: Target[]
 }
@@ -621,6 +675,42 @@ export type ISORInterval = string
 
 export type ISOTimestamp = string
 
+export interface Incident {
+  alerts: Alert[]
+  closedAt?: null | ISOTimestamp
+  createdAt: ISOTimestamp
+  description: string
+  id: number
+  status: AlertStatus
+  title: string
+  updates: IncidentUpdate[]
+}
+
+export interface IncidentAlertsInput {
+  alertIDs: number[]
+  incidentID: number
+}
+
+export interface IncidentConnection {
+  nodes: Incident[]
+  pageInfo: PageInfo
+}
+
+export interface IncidentSearchOptions {
+  after?: null | string
+  filterByStatus?: null | AlertStatus[]
+  first?: null | number
+  omit?: null | number[]
+  search?: null | string
+}
+
+export interface IncidentUpdate {
+  createdAt: ISOTimestamp
+  id: number
+  message: string
+  user?: null | User
+}
+
 export type InlineDisplayInfo =
   | DestinationDisplayInfo
   | DestinationDisplayInfoError
@@ -628,11 +718,13 @@ export type InlineDisplayInfo =
 export type Int = string
 
 export interface IntegrationKey {
+  autoCloseMinutes: number
   config: KeyConfig
   externalSystemName?: null | string
   href: string
   id: string
   name: string
+  samples: KeySample[]
   serviceID: string
   tokenInfo: TokenInfo
   type: IntegrationKeyType
@@ -654,6 +746,8 @@ export type IntegrationKeyType =
   | 'email'
   | 'generic'
   | 'grafana'
+  | 'otlp'
+  | 'pagerduty'
   | 'prometheusAlertmanager'
   | 'site24x7'
   | 'universal'
@@ -671,6 +765,13 @@ export interface KeyConfig {
   rules: KeyRule[]
 }
 
+export interface KeyConfigTestResult {
+  actions: KeyTestAction[]
+  matchedRules: KeyRule[]
+  responseStatus: number
+  usedDefaultActions: boolean
+}
+
 export interface KeyRule {
   actions: Action[]
   conditionExpr: ExprBooleanExpression
@@ -678,6 +779,7 @@ export interface KeyRule {
   description: string
   id: string
   name: string
+  responseStatus: number
 }
 
 export interface KeyRuleActionsInput {
@@ -692,6 +794,21 @@ export interface KeyRuleInput {
   description: string
   id?: null | string
   name: string
+  responseStatus?: null | number
+}
+
+export interface KeySample {
+  body: string
+  id: string
+  query: string
+  receivedAt: ISOTimestamp
+  remoteAddr: string
+  userAgent: string
+}
+
+export interface KeyTestAction {
+  dest: Destination
+  params: StringMap
 }
 
 export interface Label {
@@ -759,7 +876,10 @@ export interface MessageStatusHistory {
 }
 
 export interface Mutation {
+  addAlertNote: boolean
   addAuthSubject: boolean
+  addIncidentAlerts: boolean
+  addIncidentUpdate: boolean
   clearTemporarySchedules: boolean
   closeMatchingAlert: boolean
   createAlert?: null | Alert
@@ -768,10 +888,12 @@ export interface Mutation {
   createEscalationPolicyStep?: null | EscalationPolicyStep
   createGQLAPIKey: CreatedGQLAPIKey
   createHeartbeatMonitor?: null | HeartbeatMonitor
+  createIncident?: null | Incident
   createIntegrationKey?: null | IntegrationKey
   createRotation?: null | Rotation
   createSchedule?: null | Schedule
   createService?: null | Service
+  createSlackUserGroupSync: SlackUserGroupSync
   createUser?: null | User
   createUserCalendarSubscription: UserCalendarSubscription
   createUserContactMethod?: null | UserContactMethod
@@ -783,12 +905,18 @@ export interface Mutation {
   deleteAuthSubject: boolean
   deleteGQLAPIKey: boolean
   deleteSecondaryToken: boolean
+  deleteSlackUserGroupSync: boolean
+  deleteWebhookSigningSecret: boolean
   endAllAuthSessionsByCurrentUser: boolean
   escalateAlerts?: null | Alert[]
   generateKeyToken: string
+  generateWebhookSigningSecret: string
   linkAccount: boolean
   promoteSecondaryToken: boolean
   reEncryptKeyringsAndConfig: boolean
+  reassignAlert?: null | Alert
+  removeIncidentAlerts: boolean
+  renderServiceMessageTemplate: RenderedServiceMessageTemplate
   sendContactMethodVerification: boolean
   sendSignal: boolean
   setAlertNoiseReason: boolean
@@ -796,6 +924,7 @@ export interface Mutation {
   setFavorite: boolean
   setLabel: boolean
   setScheduleOnCallNotificationRules: boolean
+  setServiceMessageTemplate: boolean
   setSystemLimits: boolean
   setTemporarySchedule: boolean
   swoAction: boolean
@@ -807,6 +936,8 @@ export interface Mutation {
   updateEscalationPolicyStep: boolean
   updateGQLAPIKey: boolean
   updateHeartbeatMonitor: boolean
+  updateIncident: boolean
+  updateIntegrationKey: boolean
   updateKeyConfig: boolean
   updateRotation: boolean
   updateSchedule: boolean
@@ -827,6 +958,20 @@ export interface Notice {
 
 export type NoticeType = 'ERROR' | 'INFO' | 'WARNING'
 
+export interface NotificationRuleFilter {
+  labelKey?: null | string
+  labelValue?: null | string
+  minSeverity?: null | AlertSeverity
+  serviceID?: null | string
+}
+
+export interface NotificationRuleFilterInput {
+  labelKey?: null | string
+  labelValue?: null | string
+  minSeverity?: null | AlertSeverity
+  serviceID?: null | string
+}
+
 export interface NotificationState {
   details: string
   formattedSrcValue: string
@@ -910,6 +1055,8 @@ export interface Query {
   generateSlackAppManifest: string
   gqlAPIKeys: GQLAPIKey[]
   heartbeatMonitor?: null | HeartbeatMonitor
+  incident?: null | Incident
+  incidents: IncidentConnection
   integrationKey?: null | IntegrationKey
   integrationKeyTypes: IntegrationKeyTypeInfo[]
   integrationKeys: IntegrationKeyConnection
@@ -932,6 +1079,7 @@ export interface Query {
   slackUserGroups: SlackUserGroupConnection
   swoStatus: SWOStatus
   systemLimits: SystemLimit[]
+  testKeyConfig: KeyConfigTestResult
   timeZones: TimeZoneConnection
   user?: null | User
   userCalendarSubscription?: null | UserCalendarSubscription
@@ -941,6 +1089,24 @@ export interface Query {
   users: UserConnection
 }
 
+export interface ReassignAlertInput {
+  alertID: number
+  serviceID: string
+}
+
+export interface RenderServiceMessageTemplateInput {
+  alertID?: null | number
+  detailsTemplate: string
+  meta?: null | AlertMetadataInput[]
+  serviceID: string
+  summaryTemplate: string
+}
+
+export interface RenderedServiceMessageTemplate {
+  details: string
+  summary: string
+}
+
 export interface Rotation {
   activeUserIndex: number
   description: string
@@ -949,7 +1115,9 @@ export interface Rotation {
   labels: Label[]
   name: string
   nextHandoffTimes: ISOTimestamp[]
+  notices: Notice[]
   shiftLength: number
+  slackUserGroupSyncs: SlackUserGroupSync[]
   start: ISOTimestamp
   timeZone: string
   type: RotationType
@@ -1081,6 +1249,7 @@ export interface SendSignalInput {
 export interface Service {
   alertStats: AlertStats
   alertsByStatus: AlertsByStatus
+  conferenceNumber: string
   description: string
   escalationPolicy?: null | EscalationPolicy
   escalationPolicyID: string
@@ -1090,6 +1259,7 @@ export interface Service {
   isFavorite: boolean
   labels: Label[]
   maintenanceExpiresAt?: null | ISOTimestamp
+  messageTemplates: ServiceMessageTemplate[]
   name: string
   notices: Notice[]
   onCallUsers: ServiceOnCallUser[]
@@ -1107,6 +1277,12 @@ export interface ServiceConnection {
   pageInfo: PageInfo
 }
 
+export interface ServiceMessageTemplate {
+  destType: string
+  detailsTemplate: string
+  summaryTemplate: string
+}
+
 export interface ServiceOnCallUser {
   stepNumber: number
   userID: string
@@ -1150,6 +1326,13 @@ export interface SetScheduleShiftInput {
   userID: string
 }
 
+export interface SetServiceMessageTemplateInput {
+  destType?: null | string
+  detailsTemplate: string
+  serviceID: string
+  summaryTemplate: string
+}
+
 export interface SetTemporaryScheduleInput {
   clearEnd?: null | ISOTimestamp
   clearStart?: null | ISOTimestamp
@@ -1195,6 +1378,14 @@ export interface SlackUserGroupSearchOptions {
   search?: null | string
 }
 
+export interface SlackUserGroupSync {
+  driftDetectedAt?: null | ISOTimestamp
+  id: string
+  lastError: string
+  lastSyncAt?: null | ISOTimestamp
+  userGroupID: string
+}
+
 export type StatusUpdateState =
   | 'DISABLED'
   | 'DISABLED_FORCED'
@@ -1271,6 +1462,15 @@ export interface TemporarySchedule {
   start: ISOTimestamp
 }
 
+export interface TestKeyConfigInput {
+  body?: null | string
+  defaultActions?: null | ActionInput[]
+  keyID: string
+  query?: null | string
+  rules?: null | KeyRuleInput[]
+  sampleID?: null | string
+}
+
 export interface TimeSeriesBucket {
   count: number
   end: ISOTimestamp
@@ -1313,6 +1513,7 @@ export interface UpdateAlertsInput {
   alertIDs: number[]
   newStatus?: null | AlertStatus
   noiseReason?: null | string
+  snoozeMinutes?: null | number
 }
 
 export interface UpdateBasicAuthInput {
@@ -1350,6 +1551,18 @@ export interface UpdateHeartbeatMonitorInput {
   timeoutMinutes?: null | number
 }
 
+export interface UpdateIncidentInput {
+  description?: null | string
+  id: number
+  newStatus?: null | AlertStatus
+  title?: null | string
+}
+
+export interface UpdateIntegrationKeyInput {
+  autoCloseMinutes?: null | number
+  id: string
+}
+
 export interface UpdateKeyConfigInput {
   defaultActions?: null | ActionInput[]
   deleteRule?: null | string
@@ -1380,6 +1593,7 @@ export interface UpdateScheduleInput {
 }
 
 export interface UpdateServiceInput {
+  conferenceNumber?: null | string
   description?: null | string
   escalationPolicyID?: null | string
   id: string
@@ -1396,6 +1610,7 @@ export interface UpdateUserCalendarSubscriptionInput {
 }
 
 export interface UpdateUserContactMethodInput {
+  activeWindow?: null | ContactMethodActiveWindowInput
   enableStatusUpdates?: null | boolean
   id: string
   name?: null | string
@@ -1453,6 +1668,7 @@ export interface UserConnection {
 }
 
 export interface UserContactMethod {
+  activeWindow?: null | ContactMethodActiveWindow
   dest: Destination
   disabled: boolean
   formattedValue: string
@@ -1461,6 +1677,7 @@ export interface UserContactMethod {
   lastTestVerifyAt?: null | ISOTimestamp
   lastVerifyMessageState?: null | NotificationState
   name: string
+  pausedUntil?: null | ISOTimestamp
   pending: boolean
   statusUpdates: StatusUpdateState
   type?: null | ContactMethodType
@@ -1471,6 +1688,7 @@ export interface UserNotificationRule {
   contactMethod?: null | UserContactMethod
   contactMethodID: string
   delayMinutes: number
+  filter?: null | NotificationRuleFilter
   id: string
 }
 
@@ -1636,6 +1854,7 @@ type ConfigID =
   | 'General.DisableSMSLinks'
   | 'General.DisableLabelCreation'
   | 'General.DisableCalendarSubscriptions'
+  | 'General.AlertSnoozeMinutes'
   | 'Services.RequiredLabels'
   | 'Maintenance.AlertCleanupDays'
   | 'Maintenance.AlertAutoCloseDays'
@@ -1671,6 +1890,12 @@ type ConfigID =
   | 'Slack.SigningSecret'
   | 'Slack.InteractiveMessages'
   | 'Slack.DisableBroadcastThreadReplies'
+  | 'Discord.Enable'
+  | 'Mattermost.Enable'
+  | 'Mattermost.URL'
+  | 'Mattermost.BotToken'
+  | 'Teams.Enable'
+  | 'Teams.InteractiveMessages'
   | 'Twilio.Enable'
   | 'Twilio.VoiceName'
   | 'Twilio.VoiceLanguage'
@@ -1680,6 +1905,7 @@ type ConfigID =
   | 'Twilio.FromNumber'
   | 'Twilio.MessagingServiceSID'
   | 'Twilio.RCSSenderID'
+  | 'Twilio.VoiceMinSeverity'
   | 'Twilio.DisableTwoWaySMS'
   | 'Twilio.SMSCarrierLookup'
   | 'Twilio.SMSFromNumberOverride'